@@ -79,6 +79,8 @@ func AnyToAst(any interface{}, caller string) Ast {
 		return StmtSlice{X: node}
 	case []ast.Spec:
 		return SpecSlice{X: node}
+	case r.Type:
+		return ToAst(TypeToExpr(node))
 	case bool:
 		if node {
 			str = "true"
@@ -98,15 +100,12 @@ func AnyToAst(any interface{}, caller string) Ast {
 	case float32, float64:
 		tok = token.FLOAT
 		str = fmt.Sprintf("%g", node)
-	case complex64, complex128:
-		errorf("%s: unimplemented conversion of <%v> to ast.Node: %v", r.TypeOf(any), caller, any)
-		return nil
 	case string:
 		tok = token.STRING
 		str = fmt.Sprintf("%q", node)
 	default:
-		errorf("%s: cannot convert to ast.Node: %v <%v>", caller, any, r.TypeOf(any))
-		return nil
+		// complex numbers, named types, arrays, slices, maps, structs...
+		return ToAst(ValueToExpr(r.ValueOf(any), caller))
 	}
 	return BasicLit{X: &ast.BasicLit{Kind: tok, Value: str}}
 
@@ -0,0 +1,332 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software: you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * ast_lift.go
+ *
+ *  Created on: Apr 14, 2017
+ *      Author: Massimiliano Ghilardi
+ */
+
+package ast2
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	r "reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var typeOfNode = r.TypeOf((*ast.Node)(nil)).Elem()
+
+// AnyToAstSplice converts the value of an unquote_splice to Ast.
+// Slices and arrays of runtime values are converted element by element,
+// so that ~,@values splices one literal for each element
+func AnyToAstSplice(any interface{}, caller string) Ast {
+	v := r.ValueOf(any)
+	if !v.IsValid() {
+		return nil
+	}
+	if _, ok := any.(Ast); ok {
+		return AnyToAst(any, caller)
+	}
+	if k := v.Kind(); k != r.Slice && k != r.Array {
+		return AnyToAst(any, caller)
+	}
+	n := v.Len()
+	if v.Type().Elem().Implements(typeOfNode) {
+		switch any.(type) {
+		case []ast.Node, []*ast.Field, []ast.Decl, []ast.Expr, []*ast.Ident, []ast.Stmt, []ast.Spec:
+			return AnyToAst(any, caller)
+		}
+		nodes := make([]ast.Node, n)
+		for i := 0; i < n; i++ {
+			nodes[i], _ = v.Index(i).Interface().(ast.Node)
+		}
+		return NodeSlice{X: nodes}
+	}
+	exprs := make([]ast.Expr, n)
+	for i := 0; i < n; i++ {
+		exprs[i] = ValueToExpr(v.Index(i), caller)
+	}
+	return ExprSlice{X: exprs}
+}
+
+// ValueToExpr converts a runtime value to an expression that evaluates to it:
+// a literal for basic types, a composite literal for arrays, slices, maps and structs,
+// and a conversion for named types
+func ValueToExpr(v r.Value, caller string) ast.Expr {
+	if !v.IsValid() {
+		return &ast.Ident{Name: "nil"}
+	}
+	t := v.Type()
+	var expr ast.Expr
+	switch k := t.Kind(); k {
+	case r.Bool:
+		expr = &ast.Ident{Name: strconv.FormatBool(v.Bool())}
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+		expr = &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(v.Int(), 10)}
+	case r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr:
+		expr = &ast.BasicLit{Kind: token.INT, Value: strconv.FormatUint(v.Uint(), 10)}
+	case r.Float32, r.Float64:
+		expr = &ast.BasicLit{Kind: token.FLOAT, Value: fmt.Sprintf("%g", v.Float())}
+	case r.Complex64, r.Complex128:
+		c := v.Complex()
+		expr = &ast.CallExpr{
+			Fun: &ast.Ident{Name: "complex"},
+			Args: []ast.Expr{
+				&ast.BasicLit{Kind: token.FLOAT, Value: fmt.Sprintf("%g", real(c))},
+				&ast.BasicLit{Kind: token.FLOAT, Value: fmt.Sprintf("%g", imag(c))},
+			},
+		}
+	case r.String:
+		expr = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(v.String())}
+	case r.Array, r.Slice:
+		if k == r.Slice && v.IsNil() {
+			return nilOfType(t)
+		}
+		n := v.Len()
+		elts := make([]ast.Expr, n)
+		for i := 0; i < n; i++ {
+			elts[i] = ValueToExpr(v.Index(i), caller)
+		}
+		return &ast.CompositeLit{Type: TypeToExpr(t), Elts: elts}
+	case r.Map:
+		if v.IsNil() {
+			return nilOfType(t)
+		}
+		keys := v.MapKeys()
+		sortValues(keys)
+		elts := make([]ast.Expr, len(keys))
+		for i, key := range keys {
+			elts[i] = &ast.KeyValueExpr{
+				Key:   ValueToExpr(key, caller),
+				Value: ValueToExpr(v.MapIndex(key), caller),
+			}
+		}
+		return &ast.CompositeLit{Type: TypeToExpr(t), Elts: elts}
+	case r.Struct:
+		var elts []ast.Expr
+		for i, n := 0, t.NumField(); i < n; i++ {
+			field, fv := t.Field(i), v.Field(i)
+			if isZeroValue(fv) {
+				continue
+			}
+			if len(field.PkgPath) != 0 {
+				errorf("%s: cannot convert to ast.Node: %v <%v> has non-zero unexported field %s",
+					caller, v, t, field.Name)
+			}
+			elts = append(elts, &ast.KeyValueExpr{
+				Key:   &ast.Ident{Name: field.Name},
+				Value: ValueToExpr(fv, caller),
+			})
+		}
+		return &ast.CompositeLit{Type: TypeToExpr(t), Elts: elts}
+	case r.Ptr:
+		if v.IsNil() {
+			return nilOfType(t)
+		}
+		if t.Elem().Kind() != r.Struct {
+			errorf("%s: cannot convert to ast.Node: %v <%v>, only pointers to struct are supported", caller, v, t)
+		}
+		return &ast.UnaryExpr{Op: token.AND, X: ValueToExpr(v.Elem(), caller)}
+	case r.Interface:
+		if v.IsNil() {
+			return &ast.Ident{Name: "nil"}
+		}
+		elem := v.Elem()
+		expr = ValueToExpr(elem, caller)
+		if !isDefaultType(elem.Type()) {
+			// untyped constants would get the default type: add a conversion
+			if _, ok := expr.(*ast.BasicLit); ok {
+				expr = &ast.CallExpr{Fun: TypeToExpr(elem.Type()), Args: []ast.Expr{expr}}
+			}
+		}
+		return expr
+	default:
+		errorf("%s: cannot convert to ast.Node: %v <%v>", caller, v, t)
+		return nil
+	}
+	// basic kinds: add a conversion to named types
+	if len(t.PkgPath()) != 0 {
+		expr = &ast.CallExpr{Fun: TypeToExpr(t), Args: []ast.Expr{expr}}
+	}
+	return expr
+}
+
+// isDefaultType returns true if t is the default type of some untyped constant
+func isDefaultType(t r.Type) bool {
+	switch t.Kind() {
+	case r.Bool, r.Int, r.Float64, r.Complex128, r.String:
+		return len(t.PkgPath()) == 0
+	}
+	return false
+}
+
+// nilOfType returns the expression T(nil)
+func nilOfType(t r.Type) ast.Expr {
+	typ := TypeToExpr(t)
+	if _, ok := typ.(*ast.StarExpr); ok {
+		typ = &ast.ParenExpr{X: typ}
+	}
+	return &ast.CallExpr{Fun: typ, Args: []ast.Expr{&ast.Ident{Name: "nil"}}}
+}
+
+func isZeroValue(v r.Value) bool {
+	switch v.Kind() {
+	case r.Array:
+		for i, n := 0, v.Len(); i < n; i++ {
+			if !isZeroValue(v.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case r.Struct:
+		for i, n := 0, v.NumField(); i < n; i++ {
+			if !isZeroValue(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case r.Chan, r.Func, r.Interface, r.Map, r.Ptr, r.Slice:
+		return v.IsNil()
+	case r.Bool:
+		return !v.Bool()
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+		return v.Int() == 0
+	case r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr:
+		return v.Uint() == 0
+	case r.Float32, r.Float64:
+		return v.Float() == 0
+	case r.Complex64, r.Complex128:
+		return v.Complex() == 0
+	case r.String:
+		return v.Len() == 0
+	case r.UnsafePointer:
+		return v.Pointer() == 0
+	}
+	return false
+}
+
+// sortValues sorts map keys, so that the generated composite literals do not depend
+// on the iteration order of maps
+func sortValues(keys []r.Value) {
+	less := func(a, b r.Value) bool {
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	}
+	if len(keys) != 0 {
+		switch keys[0].Kind() {
+		case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+			less = func(a, b r.Value) bool { return a.Int() < b.Int() }
+		case r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr:
+			less = func(a, b r.Value) bool { return a.Uint() < b.Uint() }
+		case r.Float32, r.Float64:
+			less = func(a, b r.Value) bool { return a.Float() < b.Float() }
+		case r.String:
+			less = func(a, b r.Value) bool { return a.String() < b.String() }
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+}
+
+// TypeToExpr converts a reflect.Type to the corresponding type expression
+func TypeToExpr(t r.Type) ast.Expr {
+	if name := t.Name(); len(name) != 0 {
+		if len(t.PkgPath()) == 0 {
+			// predeclared type
+			return &ast.Ident{Name: name}
+		}
+		str := t.String()
+		if dot := strings.LastIndexByte(str, '.'); dot > 0 {
+			return &ast.SelectorExpr{X: &ast.Ident{Name: str[:dot]}, Sel: &ast.Ident{Name: str[dot+1:]}}
+		}
+		return &ast.Ident{Name: str}
+	}
+	switch t.Kind() {
+	case r.Ptr:
+		return &ast.StarExpr{X: TypeToExpr(t.Elem())}
+	case r.Slice:
+		return &ast.ArrayType{Elt: TypeToExpr(t.Elem())}
+	case r.Array:
+		return &ast.ArrayType{
+			Len: &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(t.Len())},
+			Elt: TypeToExpr(t.Elem()),
+		}
+	case r.Map:
+		return &ast.MapType{Key: TypeToExpr(t.Key()), Value: TypeToExpr(t.Elem())}
+	case r.Chan:
+		var dir ast.ChanDir
+		switch t.ChanDir() {
+		case r.RecvDir:
+			dir = ast.RECV
+		case r.SendDir:
+			dir = ast.SEND
+		default:
+			dir = ast.SEND | ast.RECV
+		}
+		return &ast.ChanType{Dir: dir, Value: TypeToExpr(t.Elem())}
+	case r.Func:
+		return funcTypeToExpr(t)
+	case r.Struct:
+		fields := make([]*ast.Field, t.NumField())
+		for i := range fields {
+			f := t.Field(i)
+			field := &ast.Field{Type: TypeToExpr(f.Type)}
+			if !f.Anonymous {
+				field.Names = []*ast.Ident{{Name: f.Name}}
+			}
+			if len(f.Tag) != 0 {
+				tag := string(f.Tag)
+				if strings.IndexByte(tag, '`') < 0 {
+					tag = "`" + tag + "`"
+				} else {
+					tag = strconv.Quote(tag)
+				}
+				field.Tag = &ast.BasicLit{Kind: token.STRING, Value: tag}
+			}
+			fields[i] = field
+		}
+		return &ast.StructType{Fields: &ast.FieldList{List: fields}}
+	case r.Interface:
+		methods := make([]*ast.Field, t.NumMethod())
+		for i := range methods {
+			m := t.Method(i)
+			methods[i] = &ast.Field{Names: []*ast.Ident{{Name: m.Name}}, Type: funcTypeToExpr(m.Type)}
+		}
+		return &ast.InterfaceType{Methods: &ast.FieldList{List: methods}}
+	}
+	return &ast.Ident{Name: t.String()}
+}
+
+func funcTypeToExpr(t r.Type) *ast.FuncType {
+	params := make([]*ast.Field, t.NumIn())
+	for i := range params {
+		typ := TypeToExpr(t.In(i))
+		if i == len(params)-1 && t.IsVariadic() {
+			typ = &ast.Ellipsis{Elt: TypeToExpr(t.In(i).Elem())}
+		}
+		params[i] = &ast.Field{Type: typ}
+	}
+	results := make([]*ast.Field, t.NumOut())
+	for i := range results {
+		results[i] = &ast.Field{Type: TypeToExpr(t.Out(i))}
+	}
+	return &ast.FuncType{Params: &ast.FieldList{List: params}, Results: &ast.FieldList{List: results}}
+}
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "archive/tar"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_archive_tar
+// +build !gomacro_slim gomacro_archive_tar
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["archive/tar"] = Package{
+	Register("archive/tar", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrFieldTooLong":	ValueOf(&tar.ErrFieldTooLong).Elem(),
 		"ErrHeader":	ValueOf(&tar.ErrHeader).Elem(),
@@ -39,5 +42,21 @@ func init() {
 		"Writer":	TypeOf((*tar.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"TypeBlock":	MakeUntypedConst(Int32, "52"),
+		"TypeChar":	MakeUntypedConst(Int32, "51"),
+		"TypeCont":	MakeUntypedConst(Int32, "55"),
+		"TypeDir":	MakeUntypedConst(Int32, "53"),
+		"TypeFifo":	MakeUntypedConst(Int32, "54"),
+		"TypeGNULongLink":	MakeUntypedConst(Int32, "75"),
+		"TypeGNULongName":	MakeUntypedConst(Int32, "76"),
+		"TypeGNUSparse":	MakeUntypedConst(Int32, "83"),
+		"TypeLink":	MakeUntypedConst(Int32, "49"),
+		"TypeReg":	MakeUntypedConst(Int32, "48"),
+		"TypeRegA":	MakeUntypedConst(Int32, "0"),
+		"TypeSymlink":	MakeUntypedConst(Int32, "50"),
+		"TypeXGlobalHeader":	MakeUntypedConst(Int32, "103"),
+		"TypeXHeader":	MakeUntypedConst(Int32, "120"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "archive/zip"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_archive_zip
+// +build !gomacro_slim gomacro_archive_zip
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["archive/zip"] = Package{
+	Register("archive/zip", func() Package { return Package{
 	Binds: map[string]Value{
 		"Deflate":	ValueOf(zip.Deflate),
 		"ErrAlgorithm":	ValueOf(&zip.ErrAlgorithm).Elem(),
@@ -33,5 +36,5 @@ func init() {
 		"Writer":	TypeOf((*zip.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
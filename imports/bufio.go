@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "bufio"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_bufio
+// +build !gomacro_slim gomacro_bufio
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["bufio"] = Package{
+	Register("bufio", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrAdvanceTooFar":	ValueOf(&bufio.ErrAdvanceTooFar).Elem(),
 		"ErrBufferFull":	ValueOf(&bufio.ErrBufferFull).Elem(),
@@ -39,5 +42,8 @@ func init() {
 		"Writer":	TypeOf((*bufio.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"MaxScanTokenSize":	MakeUntypedConst(Int, "65536"),
+	} } })
 }
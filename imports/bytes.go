@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "bytes"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_bytes
+// +build !gomacro_slim gomacro_bytes
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["bytes"] = Package{
+	Register("bytes", func() Package { return Package{
 	Binds: map[string]Value{
 		"Compare":	ValueOf(bytes.Compare),
 		"Contains":	ValueOf(bytes.Contains),
@@ -67,5 +70,8 @@ func init() {
 		"Reader":	TypeOf((*bytes.Reader)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"MinRead":	MakeUntypedConst(Int, "512"),
+	} } })
 }
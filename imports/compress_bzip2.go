@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "compress/bzip2"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_compress_bzip2
+// +build !gomacro_slim gomacro_compress_bzip2
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["compress/bzip2"] = Package{
+	Register("compress/bzip2", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewReader":	ValueOf(bzip2.NewReader),
 	},
@@ -17,5 +20,5 @@ func init() {
 		"StructuralError":	TypeOf((*bzip2.StructuralError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
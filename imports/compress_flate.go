@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "compress/flate"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_compress_flate
+// +build !gomacro_slim gomacro_compress_flate
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["compress/flate"] = Package{
+	Register("compress/flate", func() Package { return Package{
 	Binds: map[string]Value{
 		"BestCompression":	ValueOf(flate.BestCompression),
 		"BestSpeed":	ValueOf(flate.BestSpeed),
@@ -34,7 +37,14 @@ func init() {
 	Proxies: map[string]Type{
 		"Reader":	TypeOf((*Reader_compress_flate)(nil)).Elem(),
 		"Resetter":	TypeOf((*Resetter_compress_flate)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BestCompression":	MakeUntypedConst(Int, "9"),
+		"BestSpeed":	MakeUntypedConst(Int, "1"),
+		"DefaultCompression":	MakeUntypedConst(Int, "-1"),
+		"HuffmanOnly":	MakeUntypedConst(Int, "-2"),
+		"NoCompression":	MakeUntypedConst(Int, "0"),
+	} } })
 }
 
 // --------------- proxy for compress/flate.Reader ---------------
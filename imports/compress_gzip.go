@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "compress/gzip"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_compress_gzip
+// +build !gomacro_slim gomacro_compress_gzip
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["compress/gzip"] = Package{
+	Register("compress/gzip", func() Package { return Package{
 	Binds: map[string]Value{
 		"BestCompression":	ValueOf(gzip.BestCompression),
 		"BestSpeed":	ValueOf(gzip.BestSpeed),
@@ -28,5 +31,12 @@ func init() {
 		"Writer":	TypeOf((*gzip.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BestCompression":	MakeUntypedConst(Int, "9"),
+		"BestSpeed":	MakeUntypedConst(Int, "1"),
+		"DefaultCompression":	MakeUntypedConst(Int, "-1"),
+		"HuffmanOnly":	MakeUntypedConst(Int, "-2"),
+		"NoCompression":	MakeUntypedConst(Int, "0"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "compress/lzw"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_compress_lzw
+// +build !gomacro_slim gomacro_compress_lzw
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["compress/lzw"] = Package{
+	Register("compress/lzw", func() Package { return Package{
 	Binds: map[string]Value{
 		"LSB":	ValueOf(lzw.LSB),
 		"MSB":	ValueOf(lzw.MSB),
@@ -20,5 +23,5 @@ func init() {
 		"Order":	TypeOf((*lzw.Order)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
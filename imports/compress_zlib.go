@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "compress/zlib"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_compress_zlib
+// +build !gomacro_slim gomacro_compress_zlib
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["compress/zlib"] = Package{
+	Register("compress/zlib", func() Package { return Package{
 	Binds: map[string]Value{
 		"BestCompression":	ValueOf(zlib.BestCompression),
 		"BestSpeed":	ValueOf(zlib.BestSpeed),
@@ -32,7 +35,14 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Resetter":	TypeOf((*Resetter_compress_zlib)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BestCompression":	MakeUntypedConst(Int, "9"),
+		"BestSpeed":	MakeUntypedConst(Int, "1"),
+		"DefaultCompression":	MakeUntypedConst(Int, "-1"),
+		"HuffmanOnly":	MakeUntypedConst(Int, "-2"),
+		"NoCompression":	MakeUntypedConst(Int, "0"),
+	} } })
 }
 
 // --------------- proxy for compress/zlib.Resetter ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "container/heap"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_container_heap
+// +build !gomacro_slim gomacro_container_heap
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["container/heap"] = Package{
+	Register("container/heap", func() Package { return Package{
 	Binds: map[string]Value{
 		"Fix":	ValueOf(heap.Fix),
 		"Init":	ValueOf(heap.Init),
@@ -22,7 +25,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Interface":	TypeOf((*Interface_container_heap)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for container/heap.Interface ---------------
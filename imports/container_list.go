@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "container/list"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_container_list
+// +build !gomacro_slim gomacro_container_list
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["container/list"] = Package{
+	Register("container/list", func() Package { return Package{
 	Binds: map[string]Value{
 		"New":	ValueOf(list.New),
 	},
@@ -18,5 +21,5 @@ func init() {
 		"List":	TypeOf((*list.List)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
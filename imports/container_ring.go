@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "container/ring"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_container_ring
+// +build !gomacro_slim gomacro_container_ring
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["container/ring"] = Package{
+	Register("container/ring", func() Package { return Package{
 	Binds: map[string]Value{
 		"New":	ValueOf(ring.New),
 	},
@@ -17,5 +20,5 @@ func init() {
 		"Ring":	TypeOf((*ring.Ring)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
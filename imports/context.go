@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "context"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_context
+// +build !gomacro_slim gomacro_context
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["context"] = Package{
+	Register("context", func() Package { return Package{
 	Binds: map[string]Value{
 		"Background":	ValueOf(context.Background),
 		"Canceled":	ValueOf(&context.Canceled).Elem(),
@@ -27,7 +30,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Context":	TypeOf((*Context_context)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for context.Context ---------------
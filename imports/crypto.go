@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto
+// +build !gomacro_slim gomacro_crypto
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["crypto"] = Package{
+	Register("crypto", func() Package { return Package{
 	Binds: map[string]Value{
 		"MD4":	ValueOf(crypto.MD4),
 		"MD5":	ValueOf(crypto.MD5),
@@ -45,7 +48,7 @@ func init() {
 		"PublicKey":	TypeOf((*PublicKey_crypto)(nil)).Elem(),
 		"Signer":	TypeOf((*Signer_crypto)(nil)).Elem(),
 		"SignerOpts":	TypeOf((*SignerOpts_crypto)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for crypto.Decrypter ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/aes"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_aes
+// +build !gomacro_slim gomacro_crypto_aes
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/aes"] = Package{
+	Register("crypto/aes", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockSize":	ValueOf(aes.BlockSize),
 		"NewCipher":	ValueOf(aes.NewCipher),
@@ -18,5 +21,8 @@ func init() {
 		"KeySizeError":	TypeOf((*aes.KeySizeError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BlockSize":	MakeUntypedConst(Int, "16"),
+	} } })
 }
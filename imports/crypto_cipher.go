@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/cipher"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_cipher
+// +build !gomacro_slim gomacro_crypto_cipher
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/cipher"] = Package{
+	Register("crypto/cipher", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewCBCDecrypter":	ValueOf(cipher.NewCBCDecrypter),
 		"NewCBCEncrypter":	ValueOf(cipher.NewCBCEncrypter),
@@ -33,7 +36,7 @@ func init() {
 		"Block":	TypeOf((*Block_crypto_cipher)(nil)).Elem(),
 		"BlockMode":	TypeOf((*BlockMode_crypto_cipher)(nil)).Elem(),
 		"Stream":	TypeOf((*Stream_crypto_cipher)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for crypto/cipher.AEAD ---------------
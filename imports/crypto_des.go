@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/des"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_des
+// +build !gomacro_slim gomacro_crypto_des
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/des"] = Package{
+	Register("crypto/des", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockSize":	ValueOf(des.BlockSize),
 		"NewCipher":	ValueOf(des.NewCipher),
@@ -19,5 +22,8 @@ func init() {
 		"KeySizeError":	TypeOf((*des.KeySizeError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BlockSize":	MakeUntypedConst(Int, "8"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/dsa"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_dsa
+// +build !gomacro_slim gomacro_crypto_dsa
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/dsa"] = Package{
+	Register("crypto/dsa", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrInvalidPublicKey":	ValueOf(&dsa.ErrInvalidPublicKey).Elem(),
 		"GenerateKey":	ValueOf(dsa.GenerateKey),
@@ -28,5 +31,5 @@ func init() {
 		"PublicKey":	TypeOf((*dsa.PublicKey)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
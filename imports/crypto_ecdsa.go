@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/ecdsa"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_ecdsa
+// +build !gomacro_slim gomacro_crypto_ecdsa
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/ecdsa"] = Package{
+	Register("crypto/ecdsa", func() Package { return Package{
 	Binds: map[string]Value{
 		"GenerateKey":	ValueOf(ecdsa.GenerateKey),
 		"Sign":	ValueOf(ecdsa.Sign),
@@ -20,5 +23,5 @@ func init() {
 		"PublicKey":	TypeOf((*ecdsa.PublicKey)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/elliptic"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_elliptic
+// +build !gomacro_slim gomacro_crypto_elliptic
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/elliptic"] = Package{
+	Register("crypto/elliptic", func() Package { return Package{
 	Binds: map[string]Value{
 		"GenerateKey":	ValueOf(elliptic.GenerateKey),
 		"Marshal":	ValueOf(elliptic.Marshal),
@@ -26,7 +29,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Curve":	TypeOf((*Curve_crypto_elliptic)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for crypto/elliptic.Curve ---------------
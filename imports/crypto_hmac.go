@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/hmac"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_hmac
+// +build !gomacro_slim gomacro_crypto_hmac
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/hmac"] = Package{
+	Register("crypto/hmac", func() Package { return Package{
 	Binds: map[string]Value{
 		"Equal":	ValueOf(hmac.Equal),
 		"New":	ValueOf(hmac.New),
@@ -17,5 +20,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
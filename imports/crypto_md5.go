@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/md5"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_md5
+// +build !gomacro_slim gomacro_crypto_md5
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/md5"] = Package{
+	Register("crypto/md5", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockSize":	ValueOf(md5.BlockSize),
 		"New":	ValueOf(md5.New),
@@ -19,5 +22,9 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BlockSize":	MakeUntypedConst(Int, "64"),
+		"Size":	MakeUntypedConst(Int, "16"),
+	} } })
 }
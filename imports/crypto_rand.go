@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/rand"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_rand
+// +build !gomacro_slim gomacro_crypto_rand
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/rand"] = Package{
+	Register("crypto/rand", func() Package { return Package{
 	Binds: map[string]Value{
 		"Int":	ValueOf(rand.Int),
 		"Prime":	ValueOf(rand.Prime),
@@ -19,5 +22,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/rc4"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_rc4
+// +build !gomacro_slim gomacro_crypto_rc4
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/rc4"] = Package{
+	Register("crypto/rc4", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewCipher":	ValueOf(rc4.NewCipher),
 	},
@@ -18,5 +21,5 @@ func init() {
 		"KeySizeError":	TypeOf((*rc4.KeySizeError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
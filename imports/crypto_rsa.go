@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/rsa"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_rsa
+// +build !gomacro_slim gomacro_crypto_rsa
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/rsa"] = Package{
+	Register("crypto/rsa", func() Package { return Package{
 	Binds: map[string]Value{
 		"DecryptOAEP":	ValueOf(rsa.DecryptOAEP),
 		"DecryptPKCS1v15":	ValueOf(rsa.DecryptPKCS1v15),
@@ -38,5 +41,9 @@ func init() {
 		"PublicKey":	TypeOf((*rsa.PublicKey)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"PSSSaltLengthAuto":	MakeUntypedConst(Int, "0"),
+		"PSSSaltLengthEqualsHash":	MakeUntypedConst(Int, "-1"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/sha256"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_sha256
+// +build !gomacro_slim gomacro_crypto_sha256
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/sha256"] = Package{
+	Register("crypto/sha256", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockSize":	ValueOf(sha256.BlockSize),
 		"New":	ValueOf(sha256.New),
@@ -22,5 +25,10 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BlockSize":	MakeUntypedConst(Int, "64"),
+		"Size":	MakeUntypedConst(Int, "32"),
+		"Size224":	MakeUntypedConst(Int, "28"),
+	} } })
 }
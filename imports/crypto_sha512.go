@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/sha512"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_sha512
+// +build !gomacro_slim gomacro_crypto_sha512
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/sha512"] = Package{
+	Register("crypto/sha512", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockSize":	ValueOf(sha512.BlockSize),
 		"New":	ValueOf(sha512.New),
@@ -28,5 +31,12 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"BlockSize":	MakeUntypedConst(Int, "128"),
+		"Size":	MakeUntypedConst(Int, "64"),
+		"Size224":	MakeUntypedConst(Int, "28"),
+		"Size256":	MakeUntypedConst(Int, "32"),
+		"Size384":	MakeUntypedConst(Int, "48"),
+	} } })
 }
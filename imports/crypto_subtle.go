@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/subtle"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_subtle
+// +build !gomacro_slim gomacro_crypto_subtle
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/subtle"] = Package{
+	Register("crypto/subtle", func() Package { return Package{
 	Binds: map[string]Value{
 		"ConstantTimeByteEq":	ValueOf(subtle.ConstantTimeByteEq),
 		"ConstantTimeCompare":	ValueOf(subtle.ConstantTimeCompare),
@@ -21,5 +24,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
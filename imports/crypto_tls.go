@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/tls"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_tls
+// +build !gomacro_slim gomacro_crypto_tls
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/tls"] = Package{
+	Register("crypto/tls", func() Package { return Package{
 	Binds: map[string]Value{
 		"Client":	ValueOf(tls.Client),
 		"CurveP256":	ValueOf(tls.CurveP256),
@@ -87,7 +90,13 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"ClientSessionCache":	TypeOf((*ClientSessionCache_crypto_tls)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"VersionSSL30":	MakeUntypedConst(Int, "768"),
+		"VersionTLS10":	MakeUntypedConst(Int, "769"),
+		"VersionTLS11":	MakeUntypedConst(Int, "770"),
+		"VersionTLS12":	MakeUntypedConst(Int, "771"),
+	} } })
 }
 
 // --------------- proxy for crypto/tls.ClientSessionCache ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/x509"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_x509
+// +build !gomacro_slim gomacro_crypto_x509
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/x509"] = Package{
+	Register("crypto/x509", func() Package { return Package{
 	Binds: map[string]Value{
 		"CANotAuthorizedForThisName":	ValueOf(x509.CANotAuthorizedForThisName),
 		"CreateCertificate":	ValueOf(x509.CreateCertificate),
@@ -105,5 +108,5 @@ func init() {
 		"VerifyOptions":	TypeOf((*x509.VerifyOptions)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "crypto/x509/pkix"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_crypto_x509_pkix
+// +build !gomacro_slim gomacro_crypto_x509_pkix
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["crypto/x509/pkix"] = Package{
+	Register("crypto/x509/pkix", func() Package { return Package{
 	Binds: map[string]Value{
 	},
 	Types: map[string]Type{
@@ -25,5 +28,5 @@ func init() {
 		"TBSCertificateList":	TypeOf((*pkix.TBSCertificateList)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "database/sql"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_database_sql
+// +build !gomacro_slim gomacro_database_sql
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["database/sql"] = Package{
+	Register("database/sql", func() Package { return Package{
 	Binds: map[string]Value{
 		"Drivers":	ValueOf(sql.Drivers),
 		"ErrNoRows":	ValueOf(&sql.ErrNoRows).Elem(),
@@ -48,7 +51,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Result":	TypeOf((*Result_database_sql)(nil)).Elem(),
 		"Scanner":	TypeOf((*Scanner_database_sql)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for database/sql.Result ---------------
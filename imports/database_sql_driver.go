@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "database/sql/driver"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_database_sql_driver
+// +build !gomacro_slim gomacro_database_sql_driver
+
 package imports
 
 import (
@@ -11,7 +14,7 @@ import (
 )
 
 func init() {
-	Packages["database/sql/driver"] = Package{
+	Register("database/sql/driver", func() Package { return Package{
 	Binds: map[string]Value{
 		"Bool":	ValueOf(&driver.Bool).Elem(),
 		"DefaultParameterConverter":	ValueOf(&driver.DefaultParameterConverter).Elem(),
@@ -82,7 +85,7 @@ func init() {
 		"Value":	TypeOf((*Value_database_sql_driver)(nil)).Elem(),
 		"ValueConverter":	TypeOf((*ValueConverter_database_sql_driver)(nil)).Elem(),
 		"Valuer":	TypeOf((*Valuer_database_sql_driver)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for database/sql/driver.ColumnConverter ---------------
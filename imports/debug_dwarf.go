@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/dwarf"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_dwarf
+// +build !gomacro_slim gomacro_debug_dwarf
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/dwarf"] = Package{
+	Register("debug/dwarf", func() Package { return Package{
 	Binds: map[string]Value{
 		"AttrAbstractOrigin":	ValueOf(dwarf.AttrAbstractOrigin),
 		"AttrAccessibility":	ValueOf(dwarf.AttrAccessibility),
@@ -202,7 +205,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Type":	TypeOf((*Type_debug_dwarf)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for debug/dwarf.Type ---------------
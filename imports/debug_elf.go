@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/elf"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_elf
+// +build !gomacro_slim gomacro_debug_elf
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/elf"] = Package{
+	Register("debug/elf", func() Package { return Package{
 	Binds: map[string]Value{
 		"ARM_MAGIC_TRAMP_NUMBER":	ValueOf(elf.ARM_MAGIC_TRAMP_NUMBER),
 		"COMPRESS_HIOS":	ValueOf(elf.COMPRESS_HIOS),
@@ -879,5 +882,18 @@ func init() {
 		"Version":	TypeOf((*elf.Version)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"ARM_MAGIC_TRAMP_NUMBER":	MakeUntypedConst(Int, "1543503875"),
+		"EI_ABIVERSION":	MakeUntypedConst(Int, "8"),
+		"EI_CLASS":	MakeUntypedConst(Int, "4"),
+		"EI_DATA":	MakeUntypedConst(Int, "5"),
+		"EI_NIDENT":	MakeUntypedConst(Int, "16"),
+		"EI_OSABI":	MakeUntypedConst(Int, "7"),
+		"EI_PAD":	MakeUntypedConst(Int, "9"),
+		"EI_VERSION":	MakeUntypedConst(Int, "6"),
+		"ELFMAG":	MakeUntypedConst(String, "\"\\x7fELF\""),
+		"Sym32Size":	MakeUntypedConst(Int, "16"),
+		"Sym64Size":	MakeUntypedConst(Int, "24"),
+	} } })
 }
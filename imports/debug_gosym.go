@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/gosym"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_gosym
+// +build !gomacro_slim gomacro_debug_gosym
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/gosym"] = Package{
+	Register("debug/gosym", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewLineTable":	ValueOf(gosym.NewLineTable),
 		"NewTable":	ValueOf(gosym.NewTable),
@@ -25,5 +28,5 @@ func init() {
 		"UnknownLineError":	TypeOf((*gosym.UnknownLineError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/macho"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_macho
+// +build !gomacro_slim gomacro_debug_macho
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/macho"] = Package{
+	Register("debug/macho", func() Package { return Package{
 	Binds: map[string]Value{
 		"Cpu386":	ValueOf(macho.Cpu386),
 		"CpuAmd64":	ValueOf(macho.CpuAmd64),
@@ -72,7 +75,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Load":	TypeOf((*Load_debug_macho)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for debug/macho.Load ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/pe"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_pe
+// +build !gomacro_slim gomacro_debug_pe
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/pe"] = Package{
+	Register("debug/pe", func() Package { return Package{
 	Binds: map[string]Value{
 		"COFFSymbolSize":	ValueOf(pe.COFFSymbolSize),
 		"IMAGE_FILE_MACHINE_AM33":	ValueOf(pe.IMAGE_FILE_MACHINE_AM33),
@@ -52,5 +55,28 @@ func init() {
 		"Symbol":	TypeOf((*pe.Symbol)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"COFFSymbolSize":	MakeUntypedConst(Int, "18"),
+		"IMAGE_FILE_MACHINE_AM33":	MakeUntypedConst(Int, "467"),
+		"IMAGE_FILE_MACHINE_AMD64":	MakeUntypedConst(Int, "34404"),
+		"IMAGE_FILE_MACHINE_ARM":	MakeUntypedConst(Int, "448"),
+		"IMAGE_FILE_MACHINE_EBC":	MakeUntypedConst(Int, "3772"),
+		"IMAGE_FILE_MACHINE_I386":	MakeUntypedConst(Int, "332"),
+		"IMAGE_FILE_MACHINE_IA64":	MakeUntypedConst(Int, "512"),
+		"IMAGE_FILE_MACHINE_M32R":	MakeUntypedConst(Int, "36929"),
+		"IMAGE_FILE_MACHINE_MIPS16":	MakeUntypedConst(Int, "614"),
+		"IMAGE_FILE_MACHINE_MIPSFPU":	MakeUntypedConst(Int, "870"),
+		"IMAGE_FILE_MACHINE_MIPSFPU16":	MakeUntypedConst(Int, "1126"),
+		"IMAGE_FILE_MACHINE_POWERPC":	MakeUntypedConst(Int, "496"),
+		"IMAGE_FILE_MACHINE_POWERPCFP":	MakeUntypedConst(Int, "497"),
+		"IMAGE_FILE_MACHINE_R4000":	MakeUntypedConst(Int, "358"),
+		"IMAGE_FILE_MACHINE_SH3":	MakeUntypedConst(Int, "418"),
+		"IMAGE_FILE_MACHINE_SH3DSP":	MakeUntypedConst(Int, "419"),
+		"IMAGE_FILE_MACHINE_SH4":	MakeUntypedConst(Int, "422"),
+		"IMAGE_FILE_MACHINE_SH5":	MakeUntypedConst(Int, "424"),
+		"IMAGE_FILE_MACHINE_THUMB":	MakeUntypedConst(Int, "450"),
+		"IMAGE_FILE_MACHINE_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"IMAGE_FILE_MACHINE_WCEMIPSV2":	MakeUntypedConst(Int, "361"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "debug/plan9obj"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_debug_plan9obj
+// +build !gomacro_slim gomacro_debug_plan9obj
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["debug/plan9obj"] = Package{
+	Register("debug/plan9obj", func() Package { return Package{
 	Binds: map[string]Value{
 		"Magic386":	ValueOf(plan9obj.Magic386),
 		"Magic64":	ValueOf(plan9obj.Magic64),
@@ -26,5 +29,11 @@ func init() {
 		"Sym":	TypeOf((*plan9obj.Sym)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Magic386":	MakeUntypedConst(Int, "491"),
+		"Magic64":	MakeUntypedConst(Int, "32768"),
+		"MagicAMD64":	MakeUntypedConst(Int, "35479"),
+		"MagicARM":	MakeUntypedConst(Int, "1607"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding
+// +build !gomacro_slim gomacro_encoding
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding"] = Package{
+	Register("encoding", func() Package { return Package{
 	Binds: map[string]Value{
 	},
 	Types: map[string]Type{
@@ -23,7 +26,7 @@ func init() {
 		"BinaryUnmarshaler":	TypeOf((*BinaryUnmarshaler_encoding)(nil)).Elem(),
 		"TextMarshaler":	TypeOf((*TextMarshaler_encoding)(nil)).Elem(),
 		"TextUnmarshaler":	TypeOf((*TextUnmarshaler_encoding)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for encoding.BinaryMarshaler ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/ascii85"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_ascii85
+// +build !gomacro_slim gomacro_encoding_ascii85
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/ascii85"] = Package{
+	Register("encoding/ascii85", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(ascii85.Decode),
 		"Encode":	ValueOf(ascii85.Encode),
@@ -21,5 +24,5 @@ func init() {
 		"CorruptInputError":	TypeOf((*ascii85.CorruptInputError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/asn1"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_asn1
+// +build !gomacro_slim gomacro_encoding_asn1
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/asn1"] = Package{
+	Register("encoding/asn1", func() Package { return Package{
 	Binds: map[string]Value{
 		"ClassApplication":	ValueOf(asn1.ClassApplication),
 		"ClassContextSpecific":	ValueOf(asn1.ClassContextSpecific),
@@ -45,5 +48,26 @@ func init() {
 		"SyntaxError":	TypeOf((*asn1.SyntaxError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"ClassApplication":	MakeUntypedConst(Int, "1"),
+		"ClassContextSpecific":	MakeUntypedConst(Int, "2"),
+		"ClassPrivate":	MakeUntypedConst(Int, "3"),
+		"ClassUniversal":	MakeUntypedConst(Int, "0"),
+		"TagBitString":	MakeUntypedConst(Int, "3"),
+		"TagBoolean":	MakeUntypedConst(Int, "1"),
+		"TagEnum":	MakeUntypedConst(Int, "10"),
+		"TagGeneralString":	MakeUntypedConst(Int, "27"),
+		"TagGeneralizedTime":	MakeUntypedConst(Int, "24"),
+		"TagIA5String":	MakeUntypedConst(Int, "22"),
+		"TagInteger":	MakeUntypedConst(Int, "2"),
+		"TagOID":	MakeUntypedConst(Int, "6"),
+		"TagOctetString":	MakeUntypedConst(Int, "4"),
+		"TagPrintableString":	MakeUntypedConst(Int, "19"),
+		"TagSequence":	MakeUntypedConst(Int, "16"),
+		"TagSet":	MakeUntypedConst(Int, "17"),
+		"TagT61String":	MakeUntypedConst(Int, "20"),
+		"TagUTCTime":	MakeUntypedConst(Int, "23"),
+		"TagUTF8String":	MakeUntypedConst(Int, "12"),
+	} } })
 }
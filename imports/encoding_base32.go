@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/base32"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_base32
+// +build !gomacro_slim gomacro_encoding_base32
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/base32"] = Package{
+	Register("encoding/base32", func() Package { return Package{
 	Binds: map[string]Value{
 		"HexEncoding":	ValueOf(&base32.HexEncoding).Elem(),
 		"NewDecoder":	ValueOf(base32.NewDecoder),
@@ -22,5 +25,5 @@ func init() {
 		"Encoding":	TypeOf((*base32.Encoding)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
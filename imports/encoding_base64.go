@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/base64"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_base64
+// +build !gomacro_slim gomacro_encoding_base64
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/base64"] = Package{
+	Register("encoding/base64", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewDecoder":	ValueOf(base64.NewDecoder),
 		"NewEncoder":	ValueOf(base64.NewEncoder),
@@ -26,5 +29,5 @@ func init() {
 		"Encoding":	TypeOf((*base64.Encoding)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/binary"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_binary
+// +build !gomacro_slim gomacro_encoding_binary
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/binary"] = Package{
+	Register("encoding/binary", func() Package { return Package{
 	Binds: map[string]Value{
 		"BigEndian":	ValueOf(&binary.BigEndian).Elem(),
 		"LittleEndian":	ValueOf(&binary.LittleEndian).Elem(),
@@ -31,7 +34,12 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"ByteOrder":	TypeOf((*ByteOrder_encoding_binary)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"MaxVarintLen16":	MakeUntypedConst(Int, "3"),
+		"MaxVarintLen32":	MakeUntypedConst(Int, "5"),
+		"MaxVarintLen64":	MakeUntypedConst(Int, "10"),
+	} } })
 }
 
 // --------------- proxy for encoding/binary.ByteOrder ---------------
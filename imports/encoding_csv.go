@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/csv"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_csv
+// +build !gomacro_slim gomacro_encoding_csv
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/csv"] = Package{
+	Register("encoding/csv", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrBareQuote":	ValueOf(&csv.ErrBareQuote).Elem(),
 		"ErrFieldCount":	ValueOf(&csv.ErrFieldCount).Elem(),
@@ -24,5 +27,5 @@ func init() {
 		"Writer":	TypeOf((*csv.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/gob"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_gob
+// +build !gomacro_slim gomacro_encoding_gob
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/gob"] = Package{
+	Register("encoding/gob", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewDecoder":	ValueOf(gob.NewDecoder),
 		"NewEncoder":	ValueOf(gob.NewEncoder),
@@ -26,7 +29,7 @@ func init() {
 	Proxies: map[string]Type{
 		"GobDecoder":	TypeOf((*GobDecoder_encoding_gob)(nil)).Elem(),
 		"GobEncoder":	TypeOf((*GobEncoder_encoding_gob)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for encoding/gob.GobDecoder ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/hex"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_hex
+// +build !gomacro_slim gomacro_encoding_hex
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/hex"] = Package{
+	Register("encoding/hex", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(hex.Decode),
 		"DecodeString":	ValueOf(hex.DecodeString),
@@ -25,5 +28,5 @@ func init() {
 		"InvalidByteError":	TypeOf((*hex.InvalidByteError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/json"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_json
+// +build !gomacro_slim gomacro_encoding_json
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/json"] = Package{
+	Register("encoding/json", func() Package { return Package{
 	Binds: map[string]Value{
 		"Compact":	ValueOf(json.Compact),
 		"HTMLEscape":	ValueOf(json.HTMLEscape),
@@ -42,7 +45,7 @@ func init() {
 		"Marshaler":	TypeOf((*Marshaler_encoding_json)(nil)).Elem(),
 		"Token":	TypeOf((*Token_encoding_json)(nil)).Elem(),
 		"Unmarshaler":	TypeOf((*Unmarshaler_encoding_json)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for encoding/json.Marshaler ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/pem"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_pem
+// +build !gomacro_slim gomacro_encoding_pem
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/pem"] = Package{
+	Register("encoding/pem", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(pem.Decode),
 		"Encode":	ValueOf(pem.Encode),
@@ -19,5 +22,5 @@ func init() {
 		"Block":	TypeOf((*pem.Block)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
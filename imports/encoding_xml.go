@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "encoding/xml"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_encoding_xml
+// +build !gomacro_slim gomacro_encoding_xml
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["encoding/xml"] = Package{
+	Register("encoding/xml", func() Package { return Package{
 	Binds: map[string]Value{
 		"CopyToken":	ValueOf(xml.CopyToken),
 		"Escape":	ValueOf(xml.Escape),
@@ -50,7 +53,10 @@ func init() {
 		"Token":	TypeOf((*Token_encoding_xml)(nil)).Elem(),
 		"Unmarshaler":	TypeOf((*Unmarshaler_encoding_xml)(nil)).Elem(),
 		"UnmarshalerAttr":	TypeOf((*UnmarshalerAttr_encoding_xml)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Header":	MakeUntypedConst(String, "\"<?xml version=\\\"1.0\\\" encoding=\\\"UTF-8\\\"?>\\n\""),
+	} } })
 }
 
 // --------------- proxy for encoding/xml.Marshaler ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "errors"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_errors
+// +build !gomacro_slim gomacro_errors
+
 package imports
 
 import (
@@ -9,12 +12,12 @@ import (
 )
 
 func init() {
-	Packages["errors"] = Package{
+	Register("errors", func() Package { return Package{
 	Binds: map[string]Value{
 		"New":	ValueOf(errors.New),
 	},
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "expvar"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_expvar
+// +build !gomacro_slim gomacro_expvar
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["expvar"] = Package{
+	Register("expvar", func() Package { return Package{
 	Binds: map[string]Value{
 		"Do":	ValueOf(expvar.Do),
 		"Get":	ValueOf(expvar.Get),
@@ -31,7 +34,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Var":	TypeOf((*Var_expvar)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for expvar.Var ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "flag"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_flag
+// +build !gomacro_slim gomacro_flag
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["flag"] = Package{
+	Register("flag", func() Package { return Package{
 	Binds: map[string]Value{
 		"Arg":	ValueOf(flag.Arg),
 		"Args":	ValueOf(flag.Args),
@@ -58,7 +61,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Getter":	TypeOf((*Getter_flag)(nil)).Elem(),
 		"Value":	TypeOf((*Value_flag)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for flag.Getter ---------------
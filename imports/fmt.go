@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "fmt"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_fmt
+// +build !gomacro_slim gomacro_fmt
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["fmt"] = Package{
+	Register("fmt", func() Package { return Package{
 	Binds: map[string]Value{
 		"Errorf":	ValueOf(fmt.Errorf),
 		"Fprint":	ValueOf(fmt.Fprint),
@@ -46,7 +49,7 @@ func init() {
 		"Scanner":	TypeOf((*Scanner_fmt)(nil)).Elem(),
 		"State":	TypeOf((*State_fmt)(nil)).Elem(),
 		"Stringer":	TypeOf((*Stringer_fmt)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for fmt.Formatter ---------------
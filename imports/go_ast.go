@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/ast"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_ast
+// +build !gomacro_slim gomacro_go_ast
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["go/ast"] = Package{
+	Register("go/ast", func() Package { return Package{
 	Binds: map[string]Value{
 		"Bad":	ValueOf(ast.Bad),
 		"Con":	ValueOf(ast.Con),
@@ -118,7 +121,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Node":	TypeOf((*Node_go_ast)(nil)).Elem(),
 		"Visitor":	TypeOf((*Visitor_go_ast)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for go/ast.Node ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/build"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_build
+// +build !gomacro_slim gomacro_go_build
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/build"] = Package{
+	Register("go/build", func() Package { return Package{
 	Binds: map[string]Value{
 		"AllowBinary":	ValueOf(build.AllowBinary),
 		"ArchChar":	ValueOf(build.ArchChar),
@@ -30,5 +33,5 @@ func init() {
 		"Package":	TypeOf((*build.Package)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
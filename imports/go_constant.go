@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/constant"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_constant
+// +build !gomacro_slim gomacro_go_constant
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/constant"] = Package{
+	Register("go/constant", func() Package { return Package{
 	Binds: map[string]Value{
 		"BinaryOp":	ValueOf(constant.BinaryOp),
 		"BitLen":	ValueOf(constant.BitLen),
@@ -52,5 +55,5 @@ func init() {
 		"Value":	TypeOf((*constant.Value)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
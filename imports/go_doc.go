@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/doc"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_doc
+// +build !gomacro_slim gomacro_go_doc
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/doc"] = Package{
+	Register("go/doc", func() Package { return Package{
 	Binds: map[string]Value{
 		"AllDecls":	ValueOf(doc.AllDecls),
 		"AllMethods":	ValueOf(doc.AllMethods),
@@ -32,5 +35,5 @@ func init() {
 		"Value":	TypeOf((*doc.Value)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
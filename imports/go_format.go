@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/format"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_format
+// +build !gomacro_slim gomacro_go_format
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/format"] = Package{
+	Register("go/format", func() Package { return Package{
 	Binds: map[string]Value{
 		"Node":	ValueOf(format.Node),
 		"Source":	ValueOf(format.Source),
@@ -17,5 +20,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/importer"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_importer
+// +build !gomacro_slim gomacro_go_importer
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/importer"] = Package{
+	Register("go/importer", func() Package { return Package{
 	Binds: map[string]Value{
 		"Default":	ValueOf(importer.Default),
 		"For":	ValueOf(importer.For),
@@ -18,5 +21,5 @@ func init() {
 		"Lookup":	TypeOf((*importer.Lookup)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/parser"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_parser
+// +build !gomacro_slim gomacro_go_parser
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/parser"] = Package{
+	Register("go/parser", func() Package { return Package{
 	Binds: map[string]Value{
 		"AllErrors":	ValueOf(parser.AllErrors),
 		"DeclarationErrors":	ValueOf(parser.DeclarationErrors),
@@ -27,5 +30,5 @@ func init() {
 		"Mode":	TypeOf((*parser.Mode)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
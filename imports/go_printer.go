@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/printer"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_printer
+// +build !gomacro_slim gomacro_go_printer
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/printer"] = Package{
+	Register("go/printer", func() Package { return Package{
 	Binds: map[string]Value{
 		"Fprint":	ValueOf(printer.Fprint),
 		"RawFormat":	ValueOf(printer.RawFormat),
@@ -23,5 +26,5 @@ func init() {
 		"Mode":	TypeOf((*printer.Mode)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/scanner"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_scanner
+// +build !gomacro_slim gomacro_go_scanner
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/scanner"] = Package{
+	Register("go/scanner", func() Package { return Package{
 	Binds: map[string]Value{
 		"PrintError":	ValueOf(scanner.PrintError),
 		"ScanComments":	ValueOf(scanner.ScanComments),
@@ -22,5 +25,5 @@ func init() {
 		"Scanner":	TypeOf((*scanner.Scanner)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
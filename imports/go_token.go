@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/token"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_token
+// +build !gomacro_slim gomacro_go_token
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/token"] = Package{
+	Register("go/token", func() Package { return Package{
 	Binds: map[string]Value{
 		"ADD":	ValueOf(token.ADD),
 		"ADD_ASSIGN":	ValueOf(token.ADD_ASSIGN),
@@ -107,5 +110,10 @@ func init() {
 		"Token":	TypeOf((*token.Token)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"HighestPrec":	MakeUntypedConst(Int, "7"),
+		"LowestPrec":	MakeUntypedConst(Int, "0"),
+		"UnaryPrec":	MakeUntypedConst(Int, "6"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "go/types"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_go_types
+// +build !gomacro_slim gomacro_go_types
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["go/types"] = Package{
+	Register("go/types", func() Package { return Package{
 	Binds: map[string]Value{
 		"AssertableTo":	ValueOf(types.AssertableTo),
 		"AssignableTo":	ValueOf(types.AssignableTo),
@@ -151,7 +154,7 @@ func init() {
 		"ImporterFrom":	TypeOf((*ImporterFrom_go_types)(nil)).Elem(),
 		"Sizes":	TypeOf((*Sizes_go_types)(nil)).Elem(),
 		"Type":	TypeOf((*Type_go_types)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for go/types.Importer ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "hash"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_hash
+// +build !gomacro_slim gomacro_hash
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["hash"] = Package{
+	Register("hash", func() Package { return Package{
 	Binds: map[string]Value{
 	},
 	Types: map[string]Type{
@@ -21,7 +24,7 @@ func init() {
 		"Hash":	TypeOf((*Hash_hash)(nil)).Elem(),
 		"Hash32":	TypeOf((*Hash32_hash)(nil)).Elem(),
 		"Hash64":	TypeOf((*Hash64_hash)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for hash.Hash ---------------
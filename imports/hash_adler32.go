@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "hash/adler32"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_hash_adler32
+// +build !gomacro_slim gomacro_hash_adler32
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["hash/adler32"] = Package{
+	Register("hash/adler32", func() Package { return Package{
 	Binds: map[string]Value{
 		"Checksum":	ValueOf(adler32.Checksum),
 		"New":	ValueOf(adler32.New),
@@ -18,5 +21,8 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Size":	MakeUntypedConst(Int, "4"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "hash/crc32"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_hash_crc32
+// +build !gomacro_slim gomacro_hash_crc32
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["hash/crc32"] = Package{
+	Register("hash/crc32", func() Package { return Package{
 	Binds: map[string]Value{
 		"Castagnoli":	ValueOf(uint32(crc32.Castagnoli)),
 		"Checksum":	ValueOf(crc32.Checksum),
@@ -27,5 +30,11 @@ func init() {
 		"Table":	TypeOf((*crc32.Table)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Castagnoli":	MakeUntypedConst(Int, "2197175160"),
+		"IEEE":	MakeUntypedConst(Int, "3988292384"),
+		"Koopman":	MakeUntypedConst(Int, "3945912366"),
+		"Size":	MakeUntypedConst(Int, "4"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "hash/crc64"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_hash_crc64
+// +build !gomacro_slim gomacro_hash_crc64
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["hash/crc64"] = Package{
+	Register("hash/crc64", func() Package { return Package{
 	Binds: map[string]Value{
 		"Checksum":	ValueOf(crc64.Checksum),
 		"ECMA":	ValueOf(uint64(crc64.ECMA)),
@@ -23,5 +26,10 @@ func init() {
 		"Table":	TypeOf((*crc64.Table)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"ECMA":	MakeUntypedConst(Int, "14514072000185962306"),
+		"ISO":	MakeUntypedConst(Int, "15564440312192434176"),
+		"Size":	MakeUntypedConst(Int, "8"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "hash/fnv"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_hash_fnv
+// +build !gomacro_slim gomacro_hash_fnv
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["hash/fnv"] = Package{
+	Register("hash/fnv", func() Package { return Package{
 	Binds: map[string]Value{
 		"New32":	ValueOf(fnv.New32),
 		"New32a":	ValueOf(fnv.New32a),
@@ -19,5 +22,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
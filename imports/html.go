@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "html"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_html
+// +build !gomacro_slim gomacro_html
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["html"] = Package{
+	Register("html", func() Package { return Package{
 	Binds: map[string]Value{
 		"EscapeString":	ValueOf(html.EscapeString),
 		"UnescapeString":	ValueOf(html.UnescapeString),
@@ -17,5 +20,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
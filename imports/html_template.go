@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "html/template"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_html_template
+// +build !gomacro_slim gomacro_html_template
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["html/template"] = Package{
+	Register("html/template", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrAmbigContext":	ValueOf(template.ErrAmbigContext),
 		"ErrBadHTML":	ValueOf(template.ErrBadHTML),
@@ -48,5 +51,5 @@ func init() {
 		"URL":	TypeOf((*template.URL)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
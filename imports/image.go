@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image
+// +build !gomacro_slim gomacro_image
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["image"] = Package{
+	Register("image", func() Package { return Package{
 	Binds: map[string]Value{
 		"Black":	ValueOf(&image.Black).Elem(),
 		"Decode":	ValueOf(image.Decode),
@@ -68,7 +71,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Image":	TypeOf((*Image_image)(nil)).Elem(),
 		"PalettedImage":	TypeOf((*PalettedImage_image)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for image.Image ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/color"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_color
+// +build !gomacro_slim gomacro_image_color
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["image/color"] = Package{
+	Register("image/color", func() Package { return Package{
 	Binds: map[string]Value{
 		"Alpha16Model":	ValueOf(&color.Alpha16Model).Elem(),
 		"AlphaModel":	ValueOf(&color.AlphaModel).Elem(),
@@ -51,7 +54,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Color":	TypeOf((*Color_image_color)(nil)).Elem(),
 		"Model":	TypeOf((*Model_image_color)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for image/color.Color ---------------
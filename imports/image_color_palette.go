@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/color/palette"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_color_palette
+// +build !gomacro_slim gomacro_image_color_palette
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["image/color/palette"] = Package{
+	Register("image/color/palette", func() Package { return Package{
 	Binds: map[string]Value{
 		"Plan9":	ValueOf(&palette.Plan9).Elem(),
 		"WebSafe":	ValueOf(&palette.WebSafe).Elem(),
@@ -17,5 +20,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
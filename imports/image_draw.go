@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/draw"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_draw
+// +build !gomacro_slim gomacro_image_draw
+
 package imports
 
 import (
@@ -11,7 +14,7 @@ import (
 )
 
 func init() {
-	Packages["image/draw"] = Package{
+	Register("image/draw", func() Package { return Package{
 	Binds: map[string]Value{
 		"Draw":	ValueOf(draw.Draw),
 		"DrawMask":	ValueOf(draw.DrawMask),
@@ -29,7 +32,7 @@ func init() {
 		"Drawer":	TypeOf((*Drawer_image_draw)(nil)).Elem(),
 		"Image":	TypeOf((*Image_image_draw)(nil)).Elem(),
 		"Quantizer":	TypeOf((*Quantizer_image_draw)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for image/draw.Drawer ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/gif"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_gif
+// +build !gomacro_slim gomacro_image_gif
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["image/gif"] = Package{
+	Register("image/gif", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(gif.Decode),
 		"DecodeAll":	ValueOf(gif.DecodeAll),
@@ -25,5 +28,10 @@ func init() {
 		"Options":	TypeOf((*gif.Options)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"DisposalBackground":	MakeUntypedConst(Int, "2"),
+		"DisposalNone":	MakeUntypedConst(Int, "1"),
+		"DisposalPrevious":	MakeUntypedConst(Int, "3"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/jpeg"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_jpeg
+// +build !gomacro_slim gomacro_image_jpeg
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["image/jpeg"] = Package{
+	Register("image/jpeg", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(jpeg.Decode),
 		"DecodeConfig":	ValueOf(jpeg.DecodeConfig),
@@ -24,7 +27,10 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Reader":	TypeOf((*Reader_image_jpeg)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"DefaultQuality":	MakeUntypedConst(Int, "75"),
+	} } })
 }
 
 // --------------- proxy for image/jpeg.Reader ---------------
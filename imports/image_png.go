@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "image/png"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_image_png
+// +build !gomacro_slim gomacro_image_png
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["image/png"] = Package{
+	Register("image/png", func() Package { return Package{
 	Binds: map[string]Value{
 		"BestCompression":	ValueOf(png.BestCompression),
 		"BestSpeed":	ValueOf(png.BestSpeed),
@@ -26,5 +29,5 @@ func init() {
 		"UnsupportedError":	TypeOf((*png.UnsupportedError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "index/suffixarray"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_index_suffixarray
+// +build !gomacro_slim gomacro_index_suffixarray
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["index/suffixarray"] = Package{
+	Register("index/suffixarray", func() Package { return Package{
 	Binds: map[string]Value{
 		"New":	ValueOf(suffixarray.New),
 	},
@@ -17,5 +20,5 @@ func init() {
 		"Index":	TypeOf((*suffixarray.Index)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
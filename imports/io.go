@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "io"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_io
+// +build !gomacro_slim gomacro_io
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["io"] = Package{
+	Register("io", func() Package { return Package{
 	Binds: map[string]Value{
 		"Copy":	ValueOf(io.Copy),
 		"CopyBuffer":	ValueOf(io.CopyBuffer),
@@ -80,7 +83,12 @@ func init() {
 		"Writer":	TypeOf((*Writer_io)(nil)).Elem(),
 		"WriterAt":	TypeOf((*WriterAt_io)(nil)).Elem(),
 		"WriterTo":	TypeOf((*WriterTo_io)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"SeekCurrent":	MakeUntypedConst(Int, "1"),
+		"SeekEnd":	MakeUntypedConst(Int, "2"),
+		"SeekStart":	MakeUntypedConst(Int, "0"),
+	} } })
 }
 
 // --------------- proxy for io.ByteReader ---------------
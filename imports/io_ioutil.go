@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "io/ioutil"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_io_ioutil
+// +build !gomacro_slim gomacro_io_ioutil
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["io/ioutil"] = Package{
+	Register("io/ioutil", func() Package { return Package{
 	Binds: map[string]Value{
 		"Discard":	ValueOf(&ioutil.Discard).Elem(),
 		"NopCloser":	ValueOf(ioutil.NopCloser),
@@ -23,5 +26,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
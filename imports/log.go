@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "log"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_log
+// +build !gomacro_slim gomacro_log
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["log"] = Package{
+	Register("log", func() Package { return Package{
 	Binds: map[string]Value{
 		"Fatal":	ValueOf(log.Fatal),
 		"Fatalf":	ValueOf(log.Fatalf),
@@ -39,5 +42,14 @@ func init() {
 		"Logger":	TypeOf((*log.Logger)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"LUTC":	MakeUntypedConst(Int, "32"),
+		"Ldate":	MakeUntypedConst(Int, "1"),
+		"Llongfile":	MakeUntypedConst(Int, "8"),
+		"Lmicroseconds":	MakeUntypedConst(Int, "4"),
+		"Lshortfile":	MakeUntypedConst(Int, "16"),
+		"LstdFlags":	MakeUntypedConst(Int, "3"),
+		"Ltime":	MakeUntypedConst(Int, "2"),
+	} } })
 }
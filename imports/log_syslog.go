@@ -1,6 +1,8 @@
 // this file was generated by gomacro command: import "log/syslog"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build (!gomacro_slim || gomacro_log_syslog) && !plan9 && !windows
+// +build !gomacro_slim gomacro_log_syslog
 // +build !plan9
 // +build !windows
 
@@ -12,7 +14,7 @@ import (
 )
 
 func init() {
-	Packages["log/syslog"] = Package{
+	Register("log/syslog", func() Package { return Package{
 	Binds: map[string]Value{
 		"Dial":	ValueOf(syslog.Dial),
 		"LOG_ALERT":	ValueOf(syslog.LOG_ALERT),
@@ -51,5 +53,5 @@ func init() {
 		"Writer":	TypeOf((*syslog.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
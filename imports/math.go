@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "math"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_math
+// +build !gomacro_slim gomacro_math
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["math"] = Package{
+	Register("math", func() Package { return Package{
 	Binds: map[string]Value{
 		"Abs":	ValueOf(math.Abs),
 		"Acos":	ValueOf(math.Acos),
@@ -104,5 +107,34 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"E":	MakeUntypedConst(Float64, "271828182845904523536028747135266249775724709369995957496696763/100000000000000000000000000000000000000000000000000000000000000"),
+		"Ln10":	MakeUntypedConst(Float64, "23025850929940456840179914546843642076011014886287729760333279/10000000000000000000000000000000000000000000000000000000000000"),
+		"Ln2":	MakeUntypedConst(Float64, "693147180559945309417232121458176568075500134360255254120680009/1000000000000000000000000000000000000000000000000000000000000000"),
+		"Log10E":	MakeUntypedConst(Float64, "10000000000000000000000000000000000000000000000000000000000000/23025850929940456840179914546843642076011014886287729760333279"),
+		"Log2E":	MakeUntypedConst(Float64, "1000000000000000000000000000000000000000000000000000000000000000/693147180559945309417232121458176568075500134360255254120680009"),
+		"MaxFloat32":	MakeUntypedConst(Float64, "340282346638528859811704183484516925440"),
+		"MaxFloat64":	MakeUntypedConst(Float64, "179769313486231570814527423731704356798070567525844996598917476803157260780028538760589558632766878171540458953514382464234321326889464182768467546703537516986049910576551282076245490090389328944075868508455133942304583236903222948165808559332123348274797826204144723168738177180919299881250404026184124858368"),
+		"MaxInt16":	MakeUntypedConst(Int, "32767"),
+		"MaxInt32":	MakeUntypedConst(Int, "2147483647"),
+		"MaxInt64":	MakeUntypedConst(Int, "9223372036854775807"),
+		"MaxInt8":	MakeUntypedConst(Int, "127"),
+		"MaxUint16":	MakeUntypedConst(Int, "65535"),
+		"MaxUint32":	MakeUntypedConst(Int, "4294967295"),
+		"MaxUint64":	MakeUntypedConst(Int, "18446744073709551615"),
+		"MaxUint8":	MakeUntypedConst(Int, "255"),
+		"MinInt16":	MakeUntypedConst(Int, "-32768"),
+		"MinInt32":	MakeUntypedConst(Int, "-2147483648"),
+		"MinInt64":	MakeUntypedConst(Int, "-9223372036854775808"),
+		"MinInt8":	MakeUntypedConst(Int, "-128"),
+		"Phi":	MakeUntypedConst(Float64, "80901699437494742410229341718281905886015458990288143106772431/50000000000000000000000000000000000000000000000000000000000000"),
+		"Pi":	MakeUntypedConst(Float64, "314159265358979323846264338327950288419716939937510582097494459/100000000000000000000000000000000000000000000000000000000000000"),
+		"SmallestNonzeroFloat32":	MakeUntypedConst(Float64, "1/713623846352979940529142984724747568191373312"),
+		"SmallestNonzeroFloat64":	MakeUntypedConst(Float64, "1/202402253307310618352495346718917307049556649764142118356901358027430339567995346891960383701437124495187077864316811911389808737385793476867013399940738509921517424276566361364466907742093216341239767678472745068562007483424692698618103355649159556340810056512358769552333414615230502532186327508646006263307707741093494784"),
+		"Sqrt2":	MakeUntypedConst(Float64, "70710678118654752440084436210484903928483593768847403658833987/50000000000000000000000000000000000000000000000000000000000000"),
+		"SqrtE":	MakeUntypedConst(Float64, "164872127070012814684865078781416357165377610071014801157507931/100000000000000000000000000000000000000000000000000000000000000"),
+		"SqrtPhi":	MakeUntypedConst(Float64, "63600982475703448212621123086874574585780402092004812430832019/50000000000000000000000000000000000000000000000000000000000000"),
+		"SqrtPi":	MakeUntypedConst(Float64, "177245385090551602729816748334114518279754945612238712821380779/100000000000000000000000000000000000000000000000000000000000000"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "math/big"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_math_big
+// +build !gomacro_slim gomacro_math_big
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["math/big"] = Package{
+	Register("math/big", func() Package { return Package{
 	Binds: map[string]Value{
 		"Above":	ValueOf(big.Above),
 		"AwayFromZero":	ValueOf(big.AwayFromZero),
@@ -40,5 +43,11 @@ func init() {
 		"Word":	TypeOf((*big.Word)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"MaxBase":	MakeUntypedConst(Int32, "62"),
+		"MaxExp":	MakeUntypedConst(Int, "2147483647"),
+		"MaxPrec":	MakeUntypedConst(Int, "4294967295"),
+		"MinExp":	MakeUntypedConst(Int, "-2147483648"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "math/cmplx"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_math_cmplx
+// +build !gomacro_slim gomacro_math_cmplx
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["math/cmplx"] = Package{
+	Register("math/cmplx", func() Package { return Package{
 	Binds: map[string]Value{
 		"Abs":	ValueOf(cmplx.Abs),
 		"Acos":	ValueOf(cmplx.Acos),
@@ -42,5 +45,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
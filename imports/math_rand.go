@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "math/rand"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_math_rand
+// +build !gomacro_slim gomacro_math_rand
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["math/rand"] = Package{
+	Register("math/rand", func() Package { return Package{
 	Binds: map[string]Value{
 		"ExpFloat64":	ValueOf(rand.ExpFloat64),
 		"Float32":	ValueOf(rand.Float32),
@@ -39,7 +42,7 @@ func init() {
 	Proxies: map[string]Type{
 		"Source":	TypeOf((*Source_math_rand)(nil)).Elem(),
 		"Source64":	TypeOf((*Source64_math_rand)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for math/rand.Source ---------------
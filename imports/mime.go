@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "mime"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_mime
+// +build !gomacro_slim gomacro_mime
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["mime"] = Package{
+	Register("mime", func() Package { return Package{
 	Binds: map[string]Value{
 		"AddExtensionType":	ValueOf(mime.AddExtensionType),
 		"BEncoding":	ValueOf(mime.BEncoding),
@@ -24,5 +27,5 @@ func init() {
 		"WordEncoder":	TypeOf((*mime.WordEncoder)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "mime/multipart"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_mime_multipart
+// +build !gomacro_slim gomacro_mime_multipart
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["mime/multipart"] = Package{
+	Register("mime/multipart", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewReader":	ValueOf(multipart.NewReader),
 		"NewWriter":	ValueOf(multipart.NewWriter),
@@ -24,7 +27,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"File":	TypeOf((*File_mime_multipart)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for mime/multipart.File ---------------
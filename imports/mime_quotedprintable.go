@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "mime/quotedprintable"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_mime_quotedprintable
+// +build !gomacro_slim gomacro_mime_quotedprintable
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["mime/quotedprintable"] = Package{
+	Register("mime/quotedprintable", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewReader":	ValueOf(quotedprintable.NewReader),
 		"NewWriter":	ValueOf(quotedprintable.NewWriter),
@@ -19,5 +22,5 @@ func init() {
 		"Writer":	TypeOf((*quotedprintable.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
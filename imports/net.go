@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net
+// +build !gomacro_slim gomacro_net
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["net"] = Package{
+	Register("net", func() Package { return Package{
 	Binds: map[string]Value{
 		"CIDRMask":	ValueOf(net.CIDRMask),
 		"DefaultResolver":	ValueOf(&net.DefaultResolver).Elem(),
@@ -117,7 +120,11 @@ func init() {
 		"Error":	TypeOf((*Error_net)(nil)).Elem(),
 		"Listener":	TypeOf((*Listener_net)(nil)).Elem(),
 		"PacketConn":	TypeOf((*PacketConn_net)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"IPv4len":	MakeUntypedConst(Int, "4"),
+		"IPv6len":	MakeUntypedConst(Int, "16"),
+	} } })
 }
 
 // --------------- proxy for net.Addr ---------------
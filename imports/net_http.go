@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http
+// +build !gomacro_slim gomacro_net_http
+
 package imports
 
 import (
@@ -13,7 +16,7 @@ import (
 )
 
 func init() {
-	Packages["net/http"] = Package{
+	Register("net/http", func() Package { return Package{
 	Binds: map[string]Value{
 		"CanonicalHeaderKey":	ValueOf(http.CanonicalHeaderKey),
 		"DefaultClient":	ValueOf(&http.DefaultClient).Elem(),
@@ -189,7 +192,81 @@ func init() {
 		"Pusher":	TypeOf((*Pusher_net_http)(nil)).Elem(),
 		"ResponseWriter":	TypeOf((*ResponseWriter_net_http)(nil)).Elem(),
 		"RoundTripper":	TypeOf((*RoundTripper_net_http)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"DefaultMaxHeaderBytes":	MakeUntypedConst(Int, "1048576"),
+		"DefaultMaxIdleConnsPerHost":	MakeUntypedConst(Int, "2"),
+		"MethodConnect":	MakeUntypedConst(String, "\"CONNECT\""),
+		"MethodDelete":	MakeUntypedConst(String, "\"DELETE\""),
+		"MethodGet":	MakeUntypedConst(String, "\"GET\""),
+		"MethodHead":	MakeUntypedConst(String, "\"HEAD\""),
+		"MethodOptions":	MakeUntypedConst(String, "\"OPTIONS\""),
+		"MethodPatch":	MakeUntypedConst(String, "\"PATCH\""),
+		"MethodPost":	MakeUntypedConst(String, "\"POST\""),
+		"MethodPut":	MakeUntypedConst(String, "\"PUT\""),
+		"MethodTrace":	MakeUntypedConst(String, "\"TRACE\""),
+		"StatusAccepted":	MakeUntypedConst(Int, "202"),
+		"StatusAlreadyReported":	MakeUntypedConst(Int, "208"),
+		"StatusBadGateway":	MakeUntypedConst(Int, "502"),
+		"StatusBadRequest":	MakeUntypedConst(Int, "400"),
+		"StatusConflict":	MakeUntypedConst(Int, "409"),
+		"StatusContinue":	MakeUntypedConst(Int, "100"),
+		"StatusCreated":	MakeUntypedConst(Int, "201"),
+		"StatusExpectationFailed":	MakeUntypedConst(Int, "417"),
+		"StatusFailedDependency":	MakeUntypedConst(Int, "424"),
+		"StatusForbidden":	MakeUntypedConst(Int, "403"),
+		"StatusFound":	MakeUntypedConst(Int, "302"),
+		"StatusGatewayTimeout":	MakeUntypedConst(Int, "504"),
+		"StatusGone":	MakeUntypedConst(Int, "410"),
+		"StatusHTTPVersionNotSupported":	MakeUntypedConst(Int, "505"),
+		"StatusIMUsed":	MakeUntypedConst(Int, "226"),
+		"StatusInsufficientStorage":	MakeUntypedConst(Int, "507"),
+		"StatusInternalServerError":	MakeUntypedConst(Int, "500"),
+		"StatusLengthRequired":	MakeUntypedConst(Int, "411"),
+		"StatusLocked":	MakeUntypedConst(Int, "423"),
+		"StatusLoopDetected":	MakeUntypedConst(Int, "508"),
+		"StatusMethodNotAllowed":	MakeUntypedConst(Int, "405"),
+		"StatusMovedPermanently":	MakeUntypedConst(Int, "301"),
+		"StatusMultiStatus":	MakeUntypedConst(Int, "207"),
+		"StatusMultipleChoices":	MakeUntypedConst(Int, "300"),
+		"StatusNetworkAuthenticationRequired":	MakeUntypedConst(Int, "511"),
+		"StatusNoContent":	MakeUntypedConst(Int, "204"),
+		"StatusNonAuthoritativeInfo":	MakeUntypedConst(Int, "203"),
+		"StatusNotAcceptable":	MakeUntypedConst(Int, "406"),
+		"StatusNotExtended":	MakeUntypedConst(Int, "510"),
+		"StatusNotFound":	MakeUntypedConst(Int, "404"),
+		"StatusNotImplemented":	MakeUntypedConst(Int, "501"),
+		"StatusNotModified":	MakeUntypedConst(Int, "304"),
+		"StatusOK":	MakeUntypedConst(Int, "200"),
+		"StatusPartialContent":	MakeUntypedConst(Int, "206"),
+		"StatusPaymentRequired":	MakeUntypedConst(Int, "402"),
+		"StatusPermanentRedirect":	MakeUntypedConst(Int, "308"),
+		"StatusPreconditionFailed":	MakeUntypedConst(Int, "412"),
+		"StatusPreconditionRequired":	MakeUntypedConst(Int, "428"),
+		"StatusProcessing":	MakeUntypedConst(Int, "102"),
+		"StatusProxyAuthRequired":	MakeUntypedConst(Int, "407"),
+		"StatusRequestEntityTooLarge":	MakeUntypedConst(Int, "413"),
+		"StatusRequestHeaderFieldsTooLarge":	MakeUntypedConst(Int, "431"),
+		"StatusRequestTimeout":	MakeUntypedConst(Int, "408"),
+		"StatusRequestURITooLong":	MakeUntypedConst(Int, "414"),
+		"StatusRequestedRangeNotSatisfiable":	MakeUntypedConst(Int, "416"),
+		"StatusResetContent":	MakeUntypedConst(Int, "205"),
+		"StatusSeeOther":	MakeUntypedConst(Int, "303"),
+		"StatusServiceUnavailable":	MakeUntypedConst(Int, "503"),
+		"StatusSwitchingProtocols":	MakeUntypedConst(Int, "101"),
+		"StatusTeapot":	MakeUntypedConst(Int, "418"),
+		"StatusTemporaryRedirect":	MakeUntypedConst(Int, "307"),
+		"StatusTooManyRequests":	MakeUntypedConst(Int, "429"),
+		"StatusUnauthorized":	MakeUntypedConst(Int, "401"),
+		"StatusUnavailableForLegalReasons":	MakeUntypedConst(Int, "451"),
+		"StatusUnprocessableEntity":	MakeUntypedConst(Int, "422"),
+		"StatusUnsupportedMediaType":	MakeUntypedConst(Int, "415"),
+		"StatusUpgradeRequired":	MakeUntypedConst(Int, "426"),
+		"StatusUseProxy":	MakeUntypedConst(Int, "305"),
+		"StatusVariantAlsoNegotiates":	MakeUntypedConst(Int, "506"),
+		"TimeFormat":	MakeUntypedConst(String, "\"Mon, 02 Jan 2006 15:04:05 GMT\""),
+		"TrailerPrefix":	MakeUntypedConst(String, "\"Trailer:\""),
+	} } })
 }
 
 // --------------- proxy for net/http.CloseNotifier ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/cgi"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_cgi
+// +build !gomacro_slim gomacro_net_http_cgi
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/cgi"] = Package{
+	Register("net/http/cgi", func() Package { return Package{
 	Binds: map[string]Value{
 		"Request":	ValueOf(cgi.Request),
 		"RequestFromMap":	ValueOf(cgi.RequestFromMap),
@@ -19,5 +22,5 @@ func init() {
 		"Handler":	TypeOf((*cgi.Handler)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/cookiejar"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_cookiejar
+// +build !gomacro_slim gomacro_net_http_cookiejar
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/cookiejar"] = Package{
+	Register("net/http/cookiejar", func() Package { return Package{
 	Binds: map[string]Value{
 		"New":	ValueOf(cookiejar.New),
 	},
@@ -20,7 +23,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"PublicSuffixList":	TypeOf((*PublicSuffixList_net_http_cookiejar)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for net/http/cookiejar.PublicSuffixList ---------------
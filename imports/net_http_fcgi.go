@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/fcgi"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_fcgi
+// +build !gomacro_slim gomacro_net_http_fcgi
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/fcgi"] = Package{
+	Register("net/http/fcgi", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrConnClosed":	ValueOf(&fcgi.ErrConnClosed).Elem(),
 		"ErrRequestAborted":	ValueOf(&fcgi.ErrRequestAborted).Elem(),
@@ -18,5 +21,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
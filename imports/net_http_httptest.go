@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/httptest"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_httptest
+// +build !gomacro_slim gomacro_net_http_httptest
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/httptest"] = Package{
+	Register("net/http/httptest", func() Package { return Package{
 	Binds: map[string]Value{
 		"DefaultRemoteAddr":	ValueOf(httptest.DefaultRemoteAddr),
 		"NewRecorder":	ValueOf(httptest.NewRecorder),
@@ -23,5 +26,8 @@ func init() {
 		"Server":	TypeOf((*httptest.Server)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"DefaultRemoteAddr":	MakeUntypedConst(String, "\"1.2.3.4\""),
+	} } })
 }
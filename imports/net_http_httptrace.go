@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/httptrace"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_httptrace
+// +build !gomacro_slim gomacro_net_http_httptrace
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/httptrace"] = Package{
+	Register("net/http/httptrace", func() Package { return Package{
 	Binds: map[string]Value{
 		"ContextClientTrace":	ValueOf(httptrace.ContextClientTrace),
 		"WithClientTrace":	ValueOf(httptrace.WithClientTrace),
@@ -22,5 +25,5 @@ func init() {
 		"WroteRequestInfo":	TypeOf((*httptrace.WroteRequestInfo)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
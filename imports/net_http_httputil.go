@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/httputil"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_httputil
+// +build !gomacro_slim gomacro_net_http_httputil
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/httputil"] = Package{
+	Register("net/http/httputil", func() Package { return Package{
 	Binds: map[string]Value{
 		"DumpRequest":	ValueOf(httputil.DumpRequest),
 		"DumpRequestOut":	ValueOf(httputil.DumpRequestOut),
@@ -33,7 +36,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"BufferPool":	TypeOf((*BufferPool_net_http_httputil)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for net/http/httputil.BufferPool ---------------
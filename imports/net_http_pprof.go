@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/http/pprof"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_http_pprof
+// +build !gomacro_slim gomacro_net_http_pprof
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/http/pprof"] = Package{
+	Register("net/http/pprof", func() Package { return Package{
 	Binds: map[string]Value{
 		"Cmdline":	ValueOf(pprof.Cmdline),
 		"Handler":	ValueOf(pprof.Handler),
@@ -21,5 +24,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
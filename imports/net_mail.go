@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/mail"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_mail
+// +build !gomacro_slim gomacro_net_mail
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/mail"] = Package{
+	Register("net/mail", func() Package { return Package{
 	Binds: map[string]Value{
 		"ErrHeaderNotPresent":	ValueOf(&mail.ErrHeaderNotPresent).Elem(),
 		"ParseAddress":	ValueOf(mail.ParseAddress),
@@ -24,5 +27,5 @@ func init() {
 		"Message":	TypeOf((*mail.Message)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/rpc"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_rpc
+// +build !gomacro_slim gomacro_net_rpc
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/rpc"] = Package{
+	Register("net/rpc", func() Package { return Package{
 	Binds: map[string]Value{
 		"Accept":	ValueOf(rpc.Accept),
 		"DefaultDebugPath":	ValueOf(rpc.DefaultDebugPath),
@@ -42,7 +45,11 @@ func init() {
 	Proxies: map[string]Type{
 		"ClientCodec":	TypeOf((*ClientCodec_net_rpc)(nil)).Elem(),
 		"ServerCodec":	TypeOf((*ServerCodec_net_rpc)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"DefaultDebugPath":	MakeUntypedConst(String, "\"/debug/rpc\""),
+		"DefaultRPCPath":	MakeUntypedConst(String, "\"/_goRPC_\""),
+	} } })
 }
 
 // --------------- proxy for net/rpc.ClientCodec ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/rpc/jsonrpc"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_rpc_jsonrpc
+// +build !gomacro_slim gomacro_net_rpc_jsonrpc
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/rpc/jsonrpc"] = Package{
+	Register("net/rpc/jsonrpc", func() Package { return Package{
 	Binds: map[string]Value{
 		"Dial":	ValueOf(jsonrpc.Dial),
 		"NewClient":	ValueOf(jsonrpc.NewClient),
@@ -20,5 +23,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
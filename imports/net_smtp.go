@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/smtp"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_smtp
+// +build !gomacro_slim gomacro_net_smtp
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/smtp"] = Package{
+	Register("net/smtp", func() Package { return Package{
 	Binds: map[string]Value{
 		"CRAMMD5Auth":	ValueOf(smtp.CRAMMD5Auth),
 		"Dial":	ValueOf(smtp.Dial),
@@ -24,7 +27,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Auth":	TypeOf((*Auth_net_smtp)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for net/smtp.Auth ---------------
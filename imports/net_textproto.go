@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/textproto"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_textproto
+// +build !gomacro_slim gomacro_net_textproto
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/textproto"] = Package{
+	Register("net/textproto", func() Package { return Package{
 	Binds: map[string]Value{
 		"CanonicalMIMEHeaderKey":	ValueOf(textproto.CanonicalMIMEHeaderKey),
 		"Dial":	ValueOf(textproto.Dial),
@@ -29,5 +32,5 @@ func init() {
 		"Writer":	TypeOf((*textproto.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
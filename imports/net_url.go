@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "net/url"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_net_url
+// +build !gomacro_slim gomacro_net_url
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["net/url"] = Package{
+	Register("net/url", func() Package { return Package{
 	Binds: map[string]Value{
 		"Parse":	ValueOf(url.Parse),
 		"ParseQuery":	ValueOf(url.ParseQuery),
@@ -30,5 +33,5 @@ func init() {
 		"Values":	TypeOf((*url.Values)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
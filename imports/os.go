@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "os"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_os
+// +build !gomacro_slim gomacro_os
+
 package imports
 
 import (
@@ -10,7 +13,7 @@ import (
 )
 
 func init() {
-	Packages["os"] = Package{
+	Register("os", func() Package { return Package{
 	Binds: map[string]Value{
 		"Args":	ValueOf(&os.Args).Elem(),
 		"Chdir":	ValueOf(os.Chdir),
@@ -117,7 +120,7 @@ func init() {
 	Proxies: map[string]Type{
 		"FileInfo":	TypeOf((*FileInfo_os)(nil)).Elem(),
 		"Signal":	TypeOf((*Signal_os)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for os.FileInfo ---------------
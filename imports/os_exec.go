@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "os/exec"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_os_exec
+// +build !gomacro_slim gomacro_os_exec
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["os/exec"] = Package{
+	Register("os/exec", func() Package { return Package{
 	Binds: map[string]Value{
 		"Command":	ValueOf(exec.Command),
 		"CommandContext":	ValueOf(exec.CommandContext),
@@ -22,5 +25,5 @@ func init() {
 		"ExitError":	TypeOf((*exec.ExitError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "os/signal"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_os_signal
+// +build !gomacro_slim gomacro_os_signal
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["os/signal"] = Package{
+	Register("os/signal", func() Package { return Package{
 	Binds: map[string]Value{
 		"Ignore":	ValueOf(signal.Ignore),
 		"Notify":	ValueOf(signal.Notify),
@@ -19,5 +22,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
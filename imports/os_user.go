@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "os/user"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_os_user
+// +build !gomacro_slim gomacro_os_user
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["os/user"] = Package{
+	Register("os/user", func() Package { return Package{
 	Binds: map[string]Value{
 		"Current":	ValueOf(user.Current),
 		"Lookup":	ValueOf(user.Lookup),
@@ -26,5 +29,5 @@ func init() {
 		"User":	TypeOf((*user.User)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -26,26 +26,72 @@ package imports
 
 import (
 	. "reflect"
+
+	"github.com/cosmos72/gomacro/macros"
 )
 
 type Package struct {
 	Binds   map[string]Value
 	Types   map[string]Type
 	Proxies map[string]Type
+	// untyped constants, with their exact value.
+	// They also appear in Binds, converted to their default type
+	Untypeds map[string]UntypedConst
+	// source code of the macro libraries shipped with the package, indexed by file name.
+	// The interpreter evaluates them when the package is imported
+	MacroSources map[string]string
 }
 
+// Packages contains the bindings of imported packages, indexed by import path.
+// Packages added with Register() appear here only after their first Lookup()
 var Packages = make(map[string]Package)
 
+// lazyPackages contains the functions that create the bindings of packages added with Register()
+var lazyPackages = make(map[string]func() Package)
+
+// Register adds package 'path' to the known packages, without creating its bindings:
+// fun is called on the first Lookup(path). Used by the generated files in this directory,
+// to avoid building the bindings of every standard package at startup
+func Register(path string, fun func() Package) {
+	lazyPackages[path] = fun
+}
+
+// Lookup returns the bindings of package 'path', creating them if it was added with Register()
+func Lookup(path string) (Package, bool) {
+	pkg, ok := Packages[path]
+	if !ok {
+		if fun := lazyPackages[path]; fun != nil {
+			pkg = fun()
+			Packages[path] = pkg
+			delete(lazyPackages, path)
+			ok = true
+		}
+	}
+	return pkg, ok
+}
+
 // inception: allow interpreted code to import "github.com/cosmos72/gomacro/imports"
 func init() {
 	Packages["github.com/cosmos72/gomacro/imports"] = Package{
-		map[string]Value{
-			"Packages": ValueOf(&Packages).Elem(),
+		Binds: map[string]Value{
+			"Lookup":           ValueOf(Lookup),
+			"MakeUntypedConst": ValueOf(MakeUntypedConst),
+			"Packages":         ValueOf(&Packages).Elem(),
+			"Register":         ValueOf(Register),
 		},
-		map[string]Type{
-			"Package": TypeOf((*Package)(nil)).Elem(),
+		Types: map[string]Type{
+			"Package":      TypeOf((*Package)(nil)).Elem(),
+			"UntypedConst": TypeOf((*UntypedConst)(nil)).Elem(),
 		},
-		map[string]Type{},
+		Proxies: map[string]Type{},
+	}
+	// the standard macro library has no runtime bindings: importing it
+	// only evaluates its macros, see interpreter.importMacroLibrary()
+	Packages["github.com/cosmos72/gomacro/macros"] = Package{
+		Binds:        map[string]Value{},
+		Types:        map[string]Type{},
+		Proxies:      map[string]Type{},
+		MacroSources: map[string]string{"macros_macros.gomacro": macros.Source},
 	}
 }
 
@@ -53,16 +99,21 @@ func (pkg *Package) Init() {
 	pkg.Binds = make(map[string]Value)
 	pkg.Types = make(map[string]Type)
 	pkg.Proxies = make(map[string]Type)
+	pkg.Untypeds = make(map[string]UntypedConst)
 }
 
 func (pkg Package) SaveToPackages(path string) {
 	// exploit the fact that maps are actually handles
-	dst, ok := Packages[path]
+	dst, ok := Lookup(path)
 	if !ok {
 		dst = Package{}
 		dst.Init()
 		Packages[path] = dst
 	}
+	if dst.Untypeds == nil && len(pkg.Untypeds) != 0 {
+		dst.Untypeds = make(map[string]UntypedConst)
+		Packages[path] = dst
+	}
 	dst.Merge(pkg)
 }
 
@@ -77,4 +128,7 @@ func (dst Package) Merge(src Package) {
 	for k, v := range src.Proxies {
 		dst.Proxies[k] = v
 	}
+	for k, v := range src.Untypeds {
+		dst.Untypeds[k] = v
+	}
 }
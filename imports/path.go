@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "path"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_path
+// +build !gomacro_slim gomacro_path
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["path"] = Package{
+	Register("path", func() Package { return Package{
 	Binds: map[string]Value{
 		"Base":	ValueOf(path.Base),
 		"Clean":	ValueOf(path.Clean),
@@ -24,5 +27,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "path/filepath"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_path_filepath
+// +build !gomacro_slim gomacro_path_filepath
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["path/filepath"] = Package{
+	Register("path/filepath", func() Package { return Package{
 	Binds: map[string]Value{
 		"Abs":	ValueOf(filepath.Abs),
 		"Base":	ValueOf(filepath.Base),
@@ -38,5 +41,5 @@ func init() {
 		"WalkFunc":	TypeOf((*filepath.WalkFunc)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "plugin"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_plugin
+// +build !gomacro_slim gomacro_plugin
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["plugin"] = Package{
+	Register("plugin", func() Package { return Package{
 	Binds: map[string]Value{
 		"Open":	ValueOf(plugin.Open),
 	},
@@ -19,7 +22,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Symbol":	TypeOf((*Symbol_plugin)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for plugin.Symbol ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "reflect"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_reflect
+// +build !gomacro_slim gomacro_reflect
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["reflect"] = Package{
+	Register("reflect", func() Package { return Package{
 	Binds: map[string]Value{
 		"Append":	ValueOf(reflect.Append),
 		"AppendSlice":	ValueOf(reflect.AppendSlice),
@@ -83,5 +86,5 @@ func init() {
 		"ValueError":	TypeOf((*reflect.ValueError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
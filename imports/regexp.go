@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "regexp"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_regexp
+// +build !gomacro_slim gomacro_regexp
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["regexp"] = Package{
+	Register("regexp", func() Package { return Package{
 	Binds: map[string]Value{
 		"Compile":	ValueOf(regexp.Compile),
 		"CompilePOSIX":	ValueOf(regexp.CompilePOSIX),
@@ -24,5 +27,5 @@ func init() {
 		"Regexp":	TypeOf((*regexp.Regexp)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
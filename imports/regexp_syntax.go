@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "regexp/syntax"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_regexp_syntax
+// +build !gomacro_slim gomacro_regexp_syntax
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["regexp/syntax"] = Package{
+	Register("regexp/syntax", func() Package { return Package{
 	Binds: map[string]Value{
 		"ClassNL":	ValueOf(syntax.ClassNL),
 		"Compile":	ValueOf(syntax.Compile),
@@ -91,5 +94,5 @@ func init() {
 		"Regexp":	TypeOf((*syntax.Regexp)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "runtime"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_runtime
+// +build !gomacro_slim gomacro_runtime
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["runtime"] = Package{
+	Register("runtime", func() Package { return Package{
 	Binds: map[string]Value{
 		"BlockProfile":	ValueOf(runtime.BlockProfile),
 		"Breakpoint":	ValueOf(runtime.Breakpoint),
@@ -62,7 +65,10 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Error":	TypeOf((*Error_runtime)(nil)).Elem(),
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Compiler":	MakeUntypedConst(String, "\"gc\""),
+	} } })
 }
 
 // --------------- proxy for runtime.Error ---------------
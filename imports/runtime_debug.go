@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "runtime/debug"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_runtime_debug
+// +build !gomacro_slim gomacro_runtime_debug
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["runtime/debug"] = Package{
+	Register("runtime/debug", func() Package { return Package{
 	Binds: map[string]Value{
 		"FreeOSMemory":	ValueOf(debug.FreeOSMemory),
 		"PrintStack":	ValueOf(debug.PrintStack),
@@ -26,5 +29,5 @@ func init() {
 		"GCStats":	TypeOf((*debug.GCStats)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "runtime/pprof"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_runtime_pprof
+// +build !gomacro_slim gomacro_runtime_pprof
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["runtime/pprof"] = Package{
+	Register("runtime/pprof", func() Package { return Package{
 	Binds: map[string]Value{
 		"Lookup":	ValueOf(pprof.Lookup),
 		"NewProfile":	ValueOf(pprof.NewProfile),
@@ -22,5 +25,5 @@ func init() {
 		"Profile":	TypeOf((*pprof.Profile)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "runtime/trace"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_runtime_trace
+// +build !gomacro_slim gomacro_runtime_trace
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["runtime/trace"] = Package{
+	Register("runtime/trace", func() Package { return Package{
 	Binds: map[string]Value{
 		"Start":	ValueOf(trace.Start),
 		"Stop":	ValueOf(trace.Stop),
@@ -17,5 +20,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
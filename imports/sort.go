@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "sort"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_sort
+// +build !gomacro_slim gomacro_sort
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["sort"] = Package{
+	Register("sort", func() Package { return Package{
 	Binds: map[string]Value{
 		"Float64s":	ValueOf(sort.Float64s),
 		"Float64sAreSorted":	ValueOf(sort.Float64sAreSorted),
@@ -37,7 +40,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Interface":	TypeOf((*Interface_sort)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for sort.Interface ---------------
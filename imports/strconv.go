@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "strconv"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_strconv
+// +build !gomacro_slim gomacro_strconv
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["strconv"] = Package{
+	Register("strconv", func() Package { return Package{
 	Binds: map[string]Value{
 		"AppendBool":	ValueOf(strconv.AppendBool),
 		"AppendFloat":	ValueOf(strconv.AppendFloat),
@@ -50,5 +53,5 @@ func init() {
 		"NumError":	TypeOf((*strconv.NumError)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
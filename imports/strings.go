@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "strings"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_strings
+// +build !gomacro_slim gomacro_strings
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["strings"] = Package{
+	Register("strings", func() Package { return Package{
 	Binds: map[string]Value{
 		"Compare":	ValueOf(strings.Compare),
 		"Contains":	ValueOf(strings.Contains),
@@ -62,5 +65,5 @@ func init() {
 		"Replacer":	TypeOf((*strings.Replacer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
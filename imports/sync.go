@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "sync"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_sync
+// +build !gomacro_slim gomacro_sync
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["sync"] = Package{
+	Register("sync", func() Package { return Package{
 	Binds: map[string]Value{
 		"NewCond":	ValueOf(sync.NewCond),
 	},
@@ -24,7 +27,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Locker":	TypeOf((*Locker_sync)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for sync.Locker ---------------
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "sync/atomic"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_sync_atomic
+// +build !gomacro_slim gomacro_sync_atomic
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["sync/atomic"] = Package{
+	Register("sync/atomic", func() Package { return Package{
 	Binds: map[string]Value{
 		"AddInt32":	ValueOf(atomic.AddInt32),
 		"AddInt64":	ValueOf(atomic.AddInt64),
@@ -45,5 +48,5 @@ func init() {
 		"Value":	TypeOf((*atomic.Value)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "syscall"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_syscall
+// +build !gomacro_slim gomacro_syscall
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["syscall"] = Package{
+	Register("syscall", func() Package { return Package{
 	Binds: map[string]Value{
 		"AF_APPLETALK":	ValueOf(syscall.AF_APPLETALK),
 		"AF_CCITT":	ValueOf(syscall.AF_CCITT),
@@ -1912,5 +1915,5 @@ func init() {
 		"WaitStatus":	TypeOf((*syscall.WaitStatus)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "syscall"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_syscall
+// +build !gomacro_slim gomacro_syscall
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["syscall"] = Package{
+	Register("syscall", func() Package { return Package{
 	Binds: map[string]Value{
 		"AF_APPLETALK":	ValueOf(syscall.AF_APPLETALK),
 		"AF_CCITT":	ValueOf(syscall.AF_CCITT),
@@ -1912,5 +1915,1510 @@ func init() {
 		"WaitStatus":	TypeOf((*syscall.WaitStatus)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"AF_APPLETALK":	MakeUntypedConst(Int, "16"),
+		"AF_CCITT":	MakeUntypedConst(Int, "10"),
+		"AF_CHAOS":	MakeUntypedConst(Int, "5"),
+		"AF_CNT":	MakeUntypedConst(Int, "21"),
+		"AF_COIP":	MakeUntypedConst(Int, "20"),
+		"AF_DATAKIT":	MakeUntypedConst(Int, "9"),
+		"AF_DECnet":	MakeUntypedConst(Int, "12"),
+		"AF_DLI":	MakeUntypedConst(Int, "13"),
+		"AF_E164":	MakeUntypedConst(Int, "28"),
+		"AF_ECMA":	MakeUntypedConst(Int, "8"),
+		"AF_HYLINK":	MakeUntypedConst(Int, "15"),
+		"AF_IEEE80211":	MakeUntypedConst(Int, "37"),
+		"AF_IMPLINK":	MakeUntypedConst(Int, "3"),
+		"AF_INET":	MakeUntypedConst(Int, "2"),
+		"AF_INET6":	MakeUntypedConst(Int, "30"),
+		"AF_IPX":	MakeUntypedConst(Int, "23"),
+		"AF_ISDN":	MakeUntypedConst(Int, "28"),
+		"AF_ISO":	MakeUntypedConst(Int, "7"),
+		"AF_LAT":	MakeUntypedConst(Int, "14"),
+		"AF_LINK":	MakeUntypedConst(Int, "18"),
+		"AF_LOCAL":	MakeUntypedConst(Int, "1"),
+		"AF_MAX":	MakeUntypedConst(Int, "38"),
+		"AF_NATM":	MakeUntypedConst(Int, "31"),
+		"AF_NDRV":	MakeUntypedConst(Int, "27"),
+		"AF_NETBIOS":	MakeUntypedConst(Int, "33"),
+		"AF_NS":	MakeUntypedConst(Int, "6"),
+		"AF_OSI":	MakeUntypedConst(Int, "7"),
+		"AF_PPP":	MakeUntypedConst(Int, "34"),
+		"AF_PUP":	MakeUntypedConst(Int, "4"),
+		"AF_RESERVED_36":	MakeUntypedConst(Int, "36"),
+		"AF_ROUTE":	MakeUntypedConst(Int, "17"),
+		"AF_SIP":	MakeUntypedConst(Int, "24"),
+		"AF_SNA":	MakeUntypedConst(Int, "11"),
+		"AF_SYSTEM":	MakeUntypedConst(Int, "32"),
+		"AF_UNIX":	MakeUntypedConst(Int, "1"),
+		"AF_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"B0":	MakeUntypedConst(Int, "0"),
+		"B110":	MakeUntypedConst(Int, "110"),
+		"B115200":	MakeUntypedConst(Int, "115200"),
+		"B1200":	MakeUntypedConst(Int, "1200"),
+		"B134":	MakeUntypedConst(Int, "134"),
+		"B14400":	MakeUntypedConst(Int, "14400"),
+		"B150":	MakeUntypedConst(Int, "150"),
+		"B1800":	MakeUntypedConst(Int, "1800"),
+		"B19200":	MakeUntypedConst(Int, "19200"),
+		"B200":	MakeUntypedConst(Int, "200"),
+		"B230400":	MakeUntypedConst(Int, "230400"),
+		"B2400":	MakeUntypedConst(Int, "2400"),
+		"B28800":	MakeUntypedConst(Int, "28800"),
+		"B300":	MakeUntypedConst(Int, "300"),
+		"B38400":	MakeUntypedConst(Int, "38400"),
+		"B4800":	MakeUntypedConst(Int, "4800"),
+		"B50":	MakeUntypedConst(Int, "50"),
+		"B57600":	MakeUntypedConst(Int, "57600"),
+		"B600":	MakeUntypedConst(Int, "600"),
+		"B7200":	MakeUntypedConst(Int, "7200"),
+		"B75":	MakeUntypedConst(Int, "75"),
+		"B76800":	MakeUntypedConst(Int, "76800"),
+		"B9600":	MakeUntypedConst(Int, "9600"),
+		"BIOCFLUSH":	MakeUntypedConst(Int, "536887912"),
+		"BIOCGBLEN":	MakeUntypedConst(Int, "1074020966"),
+		"BIOCGDLT":	MakeUntypedConst(Int, "1074020970"),
+		"BIOCGDLTLIST":	MakeUntypedConst(Int, "3222028921"),
+		"BIOCGETIF":	MakeUntypedConst(Int, "1075855979"),
+		"BIOCGHDRCMPLT":	MakeUntypedConst(Int, "1074020980"),
+		"BIOCGRSIG":	MakeUntypedConst(Int, "1074020978"),
+		"BIOCGRTIMEOUT":	MakeUntypedConst(Int, "1074807406"),
+		"BIOCGSEESENT":	MakeUntypedConst(Int, "1074020982"),
+		"BIOCGSTATS":	MakeUntypedConst(Int, "1074283119"),
+		"BIOCIMMEDIATE":	MakeUntypedConst(Int, "2147762800"),
+		"BIOCPROMISC":	MakeUntypedConst(Int, "536887913"),
+		"BIOCSBLEN":	MakeUntypedConst(Int, "3221504614"),
+		"BIOCSDLT":	MakeUntypedConst(Int, "2147762808"),
+		"BIOCSETF":	MakeUntypedConst(Int, "2148549223"),
+		"BIOCSETIF":	MakeUntypedConst(Int, "2149597804"),
+		"BIOCSHDRCMPLT":	MakeUntypedConst(Int, "2147762805"),
+		"BIOCSRSIG":	MakeUntypedConst(Int, "2147762803"),
+		"BIOCSRTIMEOUT":	MakeUntypedConst(Int, "2148549229"),
+		"BIOCSSEESENT":	MakeUntypedConst(Int, "2147762807"),
+		"BIOCVERSION":	MakeUntypedConst(Int, "1074020977"),
+		"BPF_A":	MakeUntypedConst(Int, "16"),
+		"BPF_ABS":	MakeUntypedConst(Int, "32"),
+		"BPF_ADD":	MakeUntypedConst(Int, "0"),
+		"BPF_ALIGNMENT":	MakeUntypedConst(Int, "4"),
+		"BPF_ALU":	MakeUntypedConst(Int, "4"),
+		"BPF_AND":	MakeUntypedConst(Int, "80"),
+		"BPF_B":	MakeUntypedConst(Int, "16"),
+		"BPF_DIV":	MakeUntypedConst(Int, "48"),
+		"BPF_H":	MakeUntypedConst(Int, "8"),
+		"BPF_IMM":	MakeUntypedConst(Int, "0"),
+		"BPF_IND":	MakeUntypedConst(Int, "64"),
+		"BPF_JA":	MakeUntypedConst(Int, "0"),
+		"BPF_JEQ":	MakeUntypedConst(Int, "16"),
+		"BPF_JGE":	MakeUntypedConst(Int, "48"),
+		"BPF_JGT":	MakeUntypedConst(Int, "32"),
+		"BPF_JMP":	MakeUntypedConst(Int, "5"),
+		"BPF_JSET":	MakeUntypedConst(Int, "64"),
+		"BPF_K":	MakeUntypedConst(Int, "0"),
+		"BPF_LD":	MakeUntypedConst(Int, "0"),
+		"BPF_LDX":	MakeUntypedConst(Int, "1"),
+		"BPF_LEN":	MakeUntypedConst(Int, "128"),
+		"BPF_LSH":	MakeUntypedConst(Int, "96"),
+		"BPF_MAJOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MAXBUFSIZE":	MakeUntypedConst(Int, "524288"),
+		"BPF_MAXINSNS":	MakeUntypedConst(Int, "512"),
+		"BPF_MEM":	MakeUntypedConst(Int, "96"),
+		"BPF_MEMWORDS":	MakeUntypedConst(Int, "16"),
+		"BPF_MINBUFSIZE":	MakeUntypedConst(Int, "32"),
+		"BPF_MINOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MISC":	MakeUntypedConst(Int, "7"),
+		"BPF_MSH":	MakeUntypedConst(Int, "160"),
+		"BPF_MUL":	MakeUntypedConst(Int, "32"),
+		"BPF_NEG":	MakeUntypedConst(Int, "128"),
+		"BPF_OR":	MakeUntypedConst(Int, "64"),
+		"BPF_RELEASE":	MakeUntypedConst(Int, "199606"),
+		"BPF_RET":	MakeUntypedConst(Int, "6"),
+		"BPF_RSH":	MakeUntypedConst(Int, "112"),
+		"BPF_ST":	MakeUntypedConst(Int, "2"),
+		"BPF_STX":	MakeUntypedConst(Int, "3"),
+		"BPF_SUB":	MakeUntypedConst(Int, "16"),
+		"BPF_TAX":	MakeUntypedConst(Int, "0"),
+		"BPF_TXA":	MakeUntypedConst(Int, "128"),
+		"BPF_W":	MakeUntypedConst(Int, "0"),
+		"BPF_X":	MakeUntypedConst(Int, "8"),
+		"BRKINT":	MakeUntypedConst(Int, "2"),
+		"CFLUSH":	MakeUntypedConst(Int, "15"),
+		"CLOCAL":	MakeUntypedConst(Int, "32768"),
+		"CREAD":	MakeUntypedConst(Int, "2048"),
+		"CS5":	MakeUntypedConst(Int, "0"),
+		"CS6":	MakeUntypedConst(Int, "256"),
+		"CS7":	MakeUntypedConst(Int, "512"),
+		"CS8":	MakeUntypedConst(Int, "768"),
+		"CSIZE":	MakeUntypedConst(Int, "768"),
+		"CSTART":	MakeUntypedConst(Int, "17"),
+		"CSTATUS":	MakeUntypedConst(Int, "20"),
+		"CSTOP":	MakeUntypedConst(Int, "19"),
+		"CSTOPB":	MakeUntypedConst(Int, "1024"),
+		"CSUSP":	MakeUntypedConst(Int, "26"),
+		"CTL_MAXNAME":	MakeUntypedConst(Int, "12"),
+		"CTL_NET":	MakeUntypedConst(Int, "4"),
+		"DLT_APPLE_IP_OVER_IEEE1394":	MakeUntypedConst(Int, "138"),
+		"DLT_ARCNET":	MakeUntypedConst(Int, "7"),
+		"DLT_ATM_CLIP":	MakeUntypedConst(Int, "19"),
+		"DLT_ATM_RFC1483":	MakeUntypedConst(Int, "11"),
+		"DLT_AX25":	MakeUntypedConst(Int, "3"),
+		"DLT_CHAOS":	MakeUntypedConst(Int, "5"),
+		"DLT_CHDLC":	MakeUntypedConst(Int, "104"),
+		"DLT_C_HDLC":	MakeUntypedConst(Int, "104"),
+		"DLT_EN10MB":	MakeUntypedConst(Int, "1"),
+		"DLT_EN3MB":	MakeUntypedConst(Int, "2"),
+		"DLT_FDDI":	MakeUntypedConst(Int, "10"),
+		"DLT_IEEE802":	MakeUntypedConst(Int, "6"),
+		"DLT_IEEE802_11":	MakeUntypedConst(Int, "105"),
+		"DLT_IEEE802_11_RADIO":	MakeUntypedConst(Int, "127"),
+		"DLT_IEEE802_11_RADIO_AVS":	MakeUntypedConst(Int, "163"),
+		"DLT_LINUX_SLL":	MakeUntypedConst(Int, "113"),
+		"DLT_LOOP":	MakeUntypedConst(Int, "108"),
+		"DLT_NULL":	MakeUntypedConst(Int, "0"),
+		"DLT_PFLOG":	MakeUntypedConst(Int, "117"),
+		"DLT_PFSYNC":	MakeUntypedConst(Int, "18"),
+		"DLT_PPP":	MakeUntypedConst(Int, "9"),
+		"DLT_PPP_BSDOS":	MakeUntypedConst(Int, "16"),
+		"DLT_PPP_SERIAL":	MakeUntypedConst(Int, "50"),
+		"DLT_PRONET":	MakeUntypedConst(Int, "4"),
+		"DLT_RAW":	MakeUntypedConst(Int, "12"),
+		"DLT_SLIP":	MakeUntypedConst(Int, "8"),
+		"DLT_SLIP_BSDOS":	MakeUntypedConst(Int, "15"),
+		"DT_BLK":	MakeUntypedConst(Int, "6"),
+		"DT_CHR":	MakeUntypedConst(Int, "2"),
+		"DT_DIR":	MakeUntypedConst(Int, "4"),
+		"DT_FIFO":	MakeUntypedConst(Int, "1"),
+		"DT_LNK":	MakeUntypedConst(Int, "10"),
+		"DT_REG":	MakeUntypedConst(Int, "8"),
+		"DT_SOCK":	MakeUntypedConst(Int, "12"),
+		"DT_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"DT_WHT":	MakeUntypedConst(Int, "14"),
+		"ECHO":	MakeUntypedConst(Int, "8"),
+		"ECHOCTL":	MakeUntypedConst(Int, "64"),
+		"ECHOE":	MakeUntypedConst(Int, "2"),
+		"ECHOK":	MakeUntypedConst(Int, "4"),
+		"ECHOKE":	MakeUntypedConst(Int, "1"),
+		"ECHONL":	MakeUntypedConst(Int, "16"),
+		"ECHOPRT":	MakeUntypedConst(Int, "32"),
+		"EVFILT_AIO":	MakeUntypedConst(Int, "-3"),
+		"EVFILT_FS":	MakeUntypedConst(Int, "-9"),
+		"EVFILT_MACHPORT":	MakeUntypedConst(Int, "-8"),
+		"EVFILT_PROC":	MakeUntypedConst(Int, "-5"),
+		"EVFILT_READ":	MakeUntypedConst(Int, "-1"),
+		"EVFILT_SIGNAL":	MakeUntypedConst(Int, "-6"),
+		"EVFILT_SYSCOUNT":	MakeUntypedConst(Int, "12"),
+		"EVFILT_THREADMARKER":	MakeUntypedConst(Int, "12"),
+		"EVFILT_TIMER":	MakeUntypedConst(Int, "-7"),
+		"EVFILT_USER":	MakeUntypedConst(Int, "-10"),
+		"EVFILT_VM":	MakeUntypedConst(Int, "-12"),
+		"EVFILT_VNODE":	MakeUntypedConst(Int, "-4"),
+		"EVFILT_WRITE":	MakeUntypedConst(Int, "-2"),
+		"EV_ADD":	MakeUntypedConst(Int, "1"),
+		"EV_CLEAR":	MakeUntypedConst(Int, "32"),
+		"EV_DELETE":	MakeUntypedConst(Int, "2"),
+		"EV_DISABLE":	MakeUntypedConst(Int, "8"),
+		"EV_DISPATCH":	MakeUntypedConst(Int, "128"),
+		"EV_ENABLE":	MakeUntypedConst(Int, "4"),
+		"EV_EOF":	MakeUntypedConst(Int, "32768"),
+		"EV_ERROR":	MakeUntypedConst(Int, "16384"),
+		"EV_FLAG0":	MakeUntypedConst(Int, "4096"),
+		"EV_FLAG1":	MakeUntypedConst(Int, "8192"),
+		"EV_ONESHOT":	MakeUntypedConst(Int, "16"),
+		"EV_OOBAND":	MakeUntypedConst(Int, "8192"),
+		"EV_POLL":	MakeUntypedConst(Int, "4096"),
+		"EV_RECEIPT":	MakeUntypedConst(Int, "64"),
+		"EV_SYSFLAGS":	MakeUntypedConst(Int, "61440"),
+		"EXTA":	MakeUntypedConst(Int, "19200"),
+		"EXTB":	MakeUntypedConst(Int, "38400"),
+		"EXTPROC":	MakeUntypedConst(Int, "2048"),
+		"FD_CLOEXEC":	MakeUntypedConst(Int, "1"),
+		"FD_SETSIZE":	MakeUntypedConst(Int, "1024"),
+		"FLUSHO":	MakeUntypedConst(Int, "8388608"),
+		"F_ADDFILESIGS":	MakeUntypedConst(Int, "61"),
+		"F_ADDSIGS":	MakeUntypedConst(Int, "59"),
+		"F_ALLOCATEALL":	MakeUntypedConst(Int, "4"),
+		"F_ALLOCATECONTIG":	MakeUntypedConst(Int, "2"),
+		"F_CHKCLEAN":	MakeUntypedConst(Int, "41"),
+		"F_DUPFD":	MakeUntypedConst(Int, "0"),
+		"F_DUPFD_CLOEXEC":	MakeUntypedConst(Int, "67"),
+		"F_FLUSH_DATA":	MakeUntypedConst(Int, "40"),
+		"F_FREEZE_FS":	MakeUntypedConst(Int, "53"),
+		"F_FULLFSYNC":	MakeUntypedConst(Int, "51"),
+		"F_GETFD":	MakeUntypedConst(Int, "1"),
+		"F_GETFL":	MakeUntypedConst(Int, "3"),
+		"F_GETLK":	MakeUntypedConst(Int, "7"),
+		"F_GETLKPID":	MakeUntypedConst(Int, "66"),
+		"F_GETNOSIGPIPE":	MakeUntypedConst(Int, "74"),
+		"F_GETOWN":	MakeUntypedConst(Int, "5"),
+		"F_GETPATH":	MakeUntypedConst(Int, "50"),
+		"F_GETPATH_MTMINFO":	MakeUntypedConst(Int, "71"),
+		"F_GETPROTECTIONCLASS":	MakeUntypedConst(Int, "63"),
+		"F_GLOBAL_NOCACHE":	MakeUntypedConst(Int, "55"),
+		"F_LOG2PHYS":	MakeUntypedConst(Int, "49"),
+		"F_LOG2PHYS_EXT":	MakeUntypedConst(Int, "65"),
+		"F_MARKDEPENDENCY":	MakeUntypedConst(Int, "60"),
+		"F_NOCACHE":	MakeUntypedConst(Int, "48"),
+		"F_NODIRECT":	MakeUntypedConst(Int, "62"),
+		"F_OK":	MakeUntypedConst(Int, "0"),
+		"F_PATHPKG_CHECK":	MakeUntypedConst(Int, "52"),
+		"F_PEOFPOSMODE":	MakeUntypedConst(Int, "3"),
+		"F_PREALLOCATE":	MakeUntypedConst(Int, "42"),
+		"F_RDADVISE":	MakeUntypedConst(Int, "44"),
+		"F_RDAHEAD":	MakeUntypedConst(Int, "45"),
+		"F_RDLCK":	MakeUntypedConst(Int, "1"),
+		"F_READBOOTSTRAP":	MakeUntypedConst(Int, "46"),
+		"F_SETBACKINGSTORE":	MakeUntypedConst(Int, "70"),
+		"F_SETFD":	MakeUntypedConst(Int, "2"),
+		"F_SETFL":	MakeUntypedConst(Int, "4"),
+		"F_SETLK":	MakeUntypedConst(Int, "8"),
+		"F_SETLKW":	MakeUntypedConst(Int, "9"),
+		"F_SETNOSIGPIPE":	MakeUntypedConst(Int, "73"),
+		"F_SETOWN":	MakeUntypedConst(Int, "6"),
+		"F_SETPROTECTIONCLASS":	MakeUntypedConst(Int, "64"),
+		"F_SETSIZE":	MakeUntypedConst(Int, "43"),
+		"F_THAW_FS":	MakeUntypedConst(Int, "54"),
+		"F_UNLCK":	MakeUntypedConst(Int, "2"),
+		"F_VOLPOSMODE":	MakeUntypedConst(Int, "4"),
+		"F_WRITEBOOTSTRAP":	MakeUntypedConst(Int, "47"),
+		"F_WRLCK":	MakeUntypedConst(Int, "3"),
+		"HUPCL":	MakeUntypedConst(Int, "16384"),
+		"ICANON":	MakeUntypedConst(Int, "256"),
+		"ICMP6_FILTER":	MakeUntypedConst(Int, "18"),
+		"ICRNL":	MakeUntypedConst(Int, "256"),
+		"IEXTEN":	MakeUntypedConst(Int, "1024"),
+		"IFF_ALLMULTI":	MakeUntypedConst(Int, "512"),
+		"IFF_ALTPHYS":	MakeUntypedConst(Int, "16384"),
+		"IFF_BROADCAST":	MakeUntypedConst(Int, "2"),
+		"IFF_DEBUG":	MakeUntypedConst(Int, "4"),
+		"IFF_LINK0":	MakeUntypedConst(Int, "4096"),
+		"IFF_LINK1":	MakeUntypedConst(Int, "8192"),
+		"IFF_LINK2":	MakeUntypedConst(Int, "16384"),
+		"IFF_LOOPBACK":	MakeUntypedConst(Int, "8"),
+		"IFF_MULTICAST":	MakeUntypedConst(Int, "32768"),
+		"IFF_NOARP":	MakeUntypedConst(Int, "128"),
+		"IFF_NOTRAILERS":	MakeUntypedConst(Int, "32"),
+		"IFF_OACTIVE":	MakeUntypedConst(Int, "1024"),
+		"IFF_POINTOPOINT":	MakeUntypedConst(Int, "16"),
+		"IFF_PROMISC":	MakeUntypedConst(Int, "256"),
+		"IFF_RUNNING":	MakeUntypedConst(Int, "64"),
+		"IFF_SIMPLEX":	MakeUntypedConst(Int, "2048"),
+		"IFF_UP":	MakeUntypedConst(Int, "1"),
+		"IFNAMSIZ":	MakeUntypedConst(Int, "16"),
+		"IFT_1822":	MakeUntypedConst(Int, "2"),
+		"IFT_AAL5":	MakeUntypedConst(Int, "49"),
+		"IFT_ARCNET":	MakeUntypedConst(Int, "35"),
+		"IFT_ARCNETPLUS":	MakeUntypedConst(Int, "36"),
+		"IFT_ATM":	MakeUntypedConst(Int, "37"),
+		"IFT_BRIDGE":	MakeUntypedConst(Int, "209"),
+		"IFT_CARP":	MakeUntypedConst(Int, "248"),
+		"IFT_CELLULAR":	MakeUntypedConst(Int, "255"),
+		"IFT_CEPT":	MakeUntypedConst(Int, "19"),
+		"IFT_DS3":	MakeUntypedConst(Int, "30"),
+		"IFT_ENC":	MakeUntypedConst(Int, "244"),
+		"IFT_EON":	MakeUntypedConst(Int, "25"),
+		"IFT_ETHER":	MakeUntypedConst(Int, "6"),
+		"IFT_FAITH":	MakeUntypedConst(Int, "56"),
+		"IFT_FDDI":	MakeUntypedConst(Int, "15"),
+		"IFT_FRELAY":	MakeUntypedConst(Int, "32"),
+		"IFT_FRELAYDCE":	MakeUntypedConst(Int, "44"),
+		"IFT_GIF":	MakeUntypedConst(Int, "55"),
+		"IFT_HDH1822":	MakeUntypedConst(Int, "3"),
+		"IFT_HIPPI":	MakeUntypedConst(Int, "47"),
+		"IFT_HSSI":	MakeUntypedConst(Int, "46"),
+		"IFT_HY":	MakeUntypedConst(Int, "14"),
+		"IFT_IEEE1394":	MakeUntypedConst(Int, "144"),
+		"IFT_IEEE8023ADLAG":	MakeUntypedConst(Int, "136"),
+		"IFT_ISDNBASIC":	MakeUntypedConst(Int, "20"),
+		"IFT_ISDNPRIMARY":	MakeUntypedConst(Int, "21"),
+		"IFT_ISO88022LLC":	MakeUntypedConst(Int, "41"),
+		"IFT_ISO88023":	MakeUntypedConst(Int, "7"),
+		"IFT_ISO88024":	MakeUntypedConst(Int, "8"),
+		"IFT_ISO88025":	MakeUntypedConst(Int, "9"),
+		"IFT_ISO88026":	MakeUntypedConst(Int, "10"),
+		"IFT_L2VLAN":	MakeUntypedConst(Int, "135"),
+		"IFT_LAPB":	MakeUntypedConst(Int, "16"),
+		"IFT_LOCALTALK":	MakeUntypedConst(Int, "42"),
+		"IFT_LOOP":	MakeUntypedConst(Int, "24"),
+		"IFT_MIOX25":	MakeUntypedConst(Int, "38"),
+		"IFT_MODEM":	MakeUntypedConst(Int, "48"),
+		"IFT_NSIP":	MakeUntypedConst(Int, "27"),
+		"IFT_OTHER":	MakeUntypedConst(Int, "1"),
+		"IFT_P10":	MakeUntypedConst(Int, "12"),
+		"IFT_P80":	MakeUntypedConst(Int, "13"),
+		"IFT_PARA":	MakeUntypedConst(Int, "34"),
+		"IFT_PDP":	MakeUntypedConst(Int, "255"),
+		"IFT_PFLOG":	MakeUntypedConst(Int, "245"),
+		"IFT_PFSYNC":	MakeUntypedConst(Int, "246"),
+		"IFT_PPP":	MakeUntypedConst(Int, "23"),
+		"IFT_PROPMUX":	MakeUntypedConst(Int, "54"),
+		"IFT_PROPVIRTUAL":	MakeUntypedConst(Int, "53"),
+		"IFT_PTPSERIAL":	MakeUntypedConst(Int, "22"),
+		"IFT_RS232":	MakeUntypedConst(Int, "33"),
+		"IFT_SDLC":	MakeUntypedConst(Int, "17"),
+		"IFT_SIP":	MakeUntypedConst(Int, "31"),
+		"IFT_SLIP":	MakeUntypedConst(Int, "28"),
+		"IFT_SMDSDXI":	MakeUntypedConst(Int, "43"),
+		"IFT_SMDSICIP":	MakeUntypedConst(Int, "52"),
+		"IFT_SONET":	MakeUntypedConst(Int, "39"),
+		"IFT_SONETPATH":	MakeUntypedConst(Int, "50"),
+		"IFT_SONETVT":	MakeUntypedConst(Int, "51"),
+		"IFT_STARLAN":	MakeUntypedConst(Int, "11"),
+		"IFT_STF":	MakeUntypedConst(Int, "57"),
+		"IFT_T1":	MakeUntypedConst(Int, "18"),
+		"IFT_ULTRA":	MakeUntypedConst(Int, "29"),
+		"IFT_V35":	MakeUntypedConst(Int, "45"),
+		"IFT_X25":	MakeUntypedConst(Int, "5"),
+		"IFT_X25DDN":	MakeUntypedConst(Int, "4"),
+		"IFT_X25PLE":	MakeUntypedConst(Int, "40"),
+		"IFT_XETHER":	MakeUntypedConst(Int, "26"),
+		"IGNBRK":	MakeUntypedConst(Int, "1"),
+		"IGNCR":	MakeUntypedConst(Int, "128"),
+		"IGNPAR":	MakeUntypedConst(Int, "4"),
+		"IMAXBEL":	MakeUntypedConst(Int, "8192"),
+		"INLCR":	MakeUntypedConst(Int, "64"),
+		"INPCK":	MakeUntypedConst(Int, "16"),
+		"IN_CLASSA_HOST":	MakeUntypedConst(Int, "16777215"),
+		"IN_CLASSA_MAX":	MakeUntypedConst(Int, "128"),
+		"IN_CLASSA_NET":	MakeUntypedConst(Int, "4278190080"),
+		"IN_CLASSA_NSHIFT":	MakeUntypedConst(Int, "24"),
+		"IN_CLASSB_HOST":	MakeUntypedConst(Int, "65535"),
+		"IN_CLASSB_MAX":	MakeUntypedConst(Int, "65536"),
+		"IN_CLASSB_NET":	MakeUntypedConst(Int, "4294901760"),
+		"IN_CLASSB_NSHIFT":	MakeUntypedConst(Int, "16"),
+		"IN_CLASSC_HOST":	MakeUntypedConst(Int, "255"),
+		"IN_CLASSC_NET":	MakeUntypedConst(Int, "4294967040"),
+		"IN_CLASSC_NSHIFT":	MakeUntypedConst(Int, "8"),
+		"IN_CLASSD_HOST":	MakeUntypedConst(Int, "268435455"),
+		"IN_CLASSD_NET":	MakeUntypedConst(Int, "4026531840"),
+		"IN_CLASSD_NSHIFT":	MakeUntypedConst(Int, "28"),
+		"IN_LINKLOCALNETNUM":	MakeUntypedConst(Int, "2851995648"),
+		"IN_LOOPBACKNET":	MakeUntypedConst(Int, "127"),
+		"IPPROTO_3PC":	MakeUntypedConst(Int, "34"),
+		"IPPROTO_ADFS":	MakeUntypedConst(Int, "68"),
+		"IPPROTO_AH":	MakeUntypedConst(Int, "51"),
+		"IPPROTO_AHIP":	MakeUntypedConst(Int, "61"),
+		"IPPROTO_APES":	MakeUntypedConst(Int, "99"),
+		"IPPROTO_ARGUS":	MakeUntypedConst(Int, "13"),
+		"IPPROTO_AX25":	MakeUntypedConst(Int, "93"),
+		"IPPROTO_BHA":	MakeUntypedConst(Int, "49"),
+		"IPPROTO_BLT":	MakeUntypedConst(Int, "30"),
+		"IPPROTO_BRSATMON":	MakeUntypedConst(Int, "76"),
+		"IPPROTO_CFTP":	MakeUntypedConst(Int, "62"),
+		"IPPROTO_CHAOS":	MakeUntypedConst(Int, "16"),
+		"IPPROTO_CMTP":	MakeUntypedConst(Int, "38"),
+		"IPPROTO_CPHB":	MakeUntypedConst(Int, "73"),
+		"IPPROTO_CPNX":	MakeUntypedConst(Int, "72"),
+		"IPPROTO_DDP":	MakeUntypedConst(Int, "37"),
+		"IPPROTO_DGP":	MakeUntypedConst(Int, "86"),
+		"IPPROTO_DIVERT":	MakeUntypedConst(Int, "254"),
+		"IPPROTO_DONE":	MakeUntypedConst(Int, "257"),
+		"IPPROTO_DSTOPTS":	MakeUntypedConst(Int, "60"),
+		"IPPROTO_EGP":	MakeUntypedConst(Int, "8"),
+		"IPPROTO_EMCON":	MakeUntypedConst(Int, "14"),
+		"IPPROTO_ENCAP":	MakeUntypedConst(Int, "98"),
+		"IPPROTO_EON":	MakeUntypedConst(Int, "80"),
+		"IPPROTO_ESP":	MakeUntypedConst(Int, "50"),
+		"IPPROTO_ETHERIP":	MakeUntypedConst(Int, "97"),
+		"IPPROTO_FRAGMENT":	MakeUntypedConst(Int, "44"),
+		"IPPROTO_GGP":	MakeUntypedConst(Int, "3"),
+		"IPPROTO_GMTP":	MakeUntypedConst(Int, "100"),
+		"IPPROTO_GRE":	MakeUntypedConst(Int, "47"),
+		"IPPROTO_HELLO":	MakeUntypedConst(Int, "63"),
+		"IPPROTO_HMP":	MakeUntypedConst(Int, "20"),
+		"IPPROTO_HOPOPTS":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_ICMP":	MakeUntypedConst(Int, "1"),
+		"IPPROTO_ICMPV6":	MakeUntypedConst(Int, "58"),
+		"IPPROTO_IDP":	MakeUntypedConst(Int, "22"),
+		"IPPROTO_IDPR":	MakeUntypedConst(Int, "35"),
+		"IPPROTO_IDRP":	MakeUntypedConst(Int, "45"),
+		"IPPROTO_IGMP":	MakeUntypedConst(Int, "2"),
+		"IPPROTO_IGP":	MakeUntypedConst(Int, "85"),
+		"IPPROTO_IGRP":	MakeUntypedConst(Int, "88"),
+		"IPPROTO_IL":	MakeUntypedConst(Int, "40"),
+		"IPPROTO_INLSP":	MakeUntypedConst(Int, "52"),
+		"IPPROTO_INP":	MakeUntypedConst(Int, "32"),
+		"IPPROTO_IP":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_IPCOMP":	MakeUntypedConst(Int, "108"),
+		"IPPROTO_IPCV":	MakeUntypedConst(Int, "71"),
+		"IPPROTO_IPEIP":	MakeUntypedConst(Int, "94"),
+		"IPPROTO_IPIP":	MakeUntypedConst(Int, "4"),
+		"IPPROTO_IPPC":	MakeUntypedConst(Int, "67"),
+		"IPPROTO_IPV4":	MakeUntypedConst(Int, "4"),
+		"IPPROTO_IPV6":	MakeUntypedConst(Int, "41"),
+		"IPPROTO_IRTP":	MakeUntypedConst(Int, "28"),
+		"IPPROTO_KRYPTOLAN":	MakeUntypedConst(Int, "65"),
+		"IPPROTO_LARP":	MakeUntypedConst(Int, "91"),
+		"IPPROTO_LEAF1":	MakeUntypedConst(Int, "25"),
+		"IPPROTO_LEAF2":	MakeUntypedConst(Int, "26"),
+		"IPPROTO_MAX":	MakeUntypedConst(Int, "256"),
+		"IPPROTO_MAXID":	MakeUntypedConst(Int, "52"),
+		"IPPROTO_MEAS":	MakeUntypedConst(Int, "19"),
+		"IPPROTO_MHRP":	MakeUntypedConst(Int, "48"),
+		"IPPROTO_MICP":	MakeUntypedConst(Int, "95"),
+		"IPPROTO_MTP":	MakeUntypedConst(Int, "92"),
+		"IPPROTO_MUX":	MakeUntypedConst(Int, "18"),
+		"IPPROTO_ND":	MakeUntypedConst(Int, "77"),
+		"IPPROTO_NHRP":	MakeUntypedConst(Int, "54"),
+		"IPPROTO_NONE":	MakeUntypedConst(Int, "59"),
+		"IPPROTO_NSP":	MakeUntypedConst(Int, "31"),
+		"IPPROTO_NVPII":	MakeUntypedConst(Int, "11"),
+		"IPPROTO_OSPFIGP":	MakeUntypedConst(Int, "89"),
+		"IPPROTO_PGM":	MakeUntypedConst(Int, "113"),
+		"IPPROTO_PIGP":	MakeUntypedConst(Int, "9"),
+		"IPPROTO_PIM":	MakeUntypedConst(Int, "103"),
+		"IPPROTO_PRM":	MakeUntypedConst(Int, "21"),
+		"IPPROTO_PUP":	MakeUntypedConst(Int, "12"),
+		"IPPROTO_PVP":	MakeUntypedConst(Int, "75"),
+		"IPPROTO_RAW":	MakeUntypedConst(Int, "255"),
+		"IPPROTO_RCCMON":	MakeUntypedConst(Int, "10"),
+		"IPPROTO_RDP":	MakeUntypedConst(Int, "27"),
+		"IPPROTO_ROUTING":	MakeUntypedConst(Int, "43"),
+		"IPPROTO_RSVP":	MakeUntypedConst(Int, "46"),
+		"IPPROTO_RVD":	MakeUntypedConst(Int, "66"),
+		"IPPROTO_SATEXPAK":	MakeUntypedConst(Int, "64"),
+		"IPPROTO_SATMON":	MakeUntypedConst(Int, "69"),
+		"IPPROTO_SCCSP":	MakeUntypedConst(Int, "96"),
+		"IPPROTO_SCTP":	MakeUntypedConst(Int, "132"),
+		"IPPROTO_SDRP":	MakeUntypedConst(Int, "42"),
+		"IPPROTO_SEP":	MakeUntypedConst(Int, "33"),
+		"IPPROTO_SRPC":	MakeUntypedConst(Int, "90"),
+		"IPPROTO_ST":	MakeUntypedConst(Int, "7"),
+		"IPPROTO_SVMTP":	MakeUntypedConst(Int, "82"),
+		"IPPROTO_SWIPE":	MakeUntypedConst(Int, "53"),
+		"IPPROTO_TCF":	MakeUntypedConst(Int, "87"),
+		"IPPROTO_TCP":	MakeUntypedConst(Int, "6"),
+		"IPPROTO_TP":	MakeUntypedConst(Int, "29"),
+		"IPPROTO_TPXX":	MakeUntypedConst(Int, "39"),
+		"IPPROTO_TRUNK1":	MakeUntypedConst(Int, "23"),
+		"IPPROTO_TRUNK2":	MakeUntypedConst(Int, "24"),
+		"IPPROTO_TTP":	MakeUntypedConst(Int, "84"),
+		"IPPROTO_UDP":	MakeUntypedConst(Int, "17"),
+		"IPPROTO_VINES":	MakeUntypedConst(Int, "83"),
+		"IPPROTO_VISA":	MakeUntypedConst(Int, "70"),
+		"IPPROTO_VMTP":	MakeUntypedConst(Int, "81"),
+		"IPPROTO_WBEXPAK":	MakeUntypedConst(Int, "79"),
+		"IPPROTO_WBMON":	MakeUntypedConst(Int, "78"),
+		"IPPROTO_WSN":	MakeUntypedConst(Int, "74"),
+		"IPPROTO_XNET":	MakeUntypedConst(Int, "15"),
+		"IPPROTO_XTP":	MakeUntypedConst(Int, "36"),
+		"IPV6_2292DSTOPTS":	MakeUntypedConst(Int, "23"),
+		"IPV6_2292HOPLIMIT":	MakeUntypedConst(Int, "20"),
+		"IPV6_2292HOPOPTS":	MakeUntypedConst(Int, "22"),
+		"IPV6_2292NEXTHOP":	MakeUntypedConst(Int, "21"),
+		"IPV6_2292PKTINFO":	MakeUntypedConst(Int, "19"),
+		"IPV6_2292PKTOPTIONS":	MakeUntypedConst(Int, "25"),
+		"IPV6_2292RTHDR":	MakeUntypedConst(Int, "24"),
+		"IPV6_BINDV6ONLY":	MakeUntypedConst(Int, "27"),
+		"IPV6_BOUND_IF":	MakeUntypedConst(Int, "125"),
+		"IPV6_CHECKSUM":	MakeUntypedConst(Int, "26"),
+		"IPV6_DEFAULT_MULTICAST_HOPS":	MakeUntypedConst(Int, "1"),
+		"IPV6_DEFAULT_MULTICAST_LOOP":	MakeUntypedConst(Int, "1"),
+		"IPV6_DEFHLIM":	MakeUntypedConst(Int, "64"),
+		"IPV6_FAITH":	MakeUntypedConst(Int, "29"),
+		"IPV6_FLOWINFO_MASK":	MakeUntypedConst(Int, "4294967055"),
+		"IPV6_FLOWLABEL_MASK":	MakeUntypedConst(Int, "4294905600"),
+		"IPV6_FRAGTTL":	MakeUntypedConst(Int, "120"),
+		"IPV6_FW_ADD":	MakeUntypedConst(Int, "30"),
+		"IPV6_FW_DEL":	MakeUntypedConst(Int, "31"),
+		"IPV6_FW_FLUSH":	MakeUntypedConst(Int, "32"),
+		"IPV6_FW_GET":	MakeUntypedConst(Int, "34"),
+		"IPV6_FW_ZERO":	MakeUntypedConst(Int, "33"),
+		"IPV6_HLIMDEC":	MakeUntypedConst(Int, "1"),
+		"IPV6_IPSEC_POLICY":	MakeUntypedConst(Int, "28"),
+		"IPV6_JOIN_GROUP":	MakeUntypedConst(Int, "12"),
+		"IPV6_LEAVE_GROUP":	MakeUntypedConst(Int, "13"),
+		"IPV6_MAXHLIM":	MakeUntypedConst(Int, "255"),
+		"IPV6_MAXOPTHDR":	MakeUntypedConst(Int, "2048"),
+		"IPV6_MAXPACKET":	MakeUntypedConst(Int, "65535"),
+		"IPV6_MAX_GROUP_SRC_FILTER":	MakeUntypedConst(Int, "512"),
+		"IPV6_MAX_MEMBERSHIPS":	MakeUntypedConst(Int, "4095"),
+		"IPV6_MAX_SOCK_SRC_FILTER":	MakeUntypedConst(Int, "128"),
+		"IPV6_MIN_MEMBERSHIPS":	MakeUntypedConst(Int, "31"),
+		"IPV6_MMTU":	MakeUntypedConst(Int, "1280"),
+		"IPV6_MULTICAST_HOPS":	MakeUntypedConst(Int, "10"),
+		"IPV6_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IPV6_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IPV6_PORTRANGE":	MakeUntypedConst(Int, "14"),
+		"IPV6_PORTRANGE_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"IPV6_PORTRANGE_HIGH":	MakeUntypedConst(Int, "1"),
+		"IPV6_PORTRANGE_LOW":	MakeUntypedConst(Int, "2"),
+		"IPV6_RECVTCLASS":	MakeUntypedConst(Int, "35"),
+		"IPV6_RTHDR_LOOSE":	MakeUntypedConst(Int, "0"),
+		"IPV6_RTHDR_STRICT":	MakeUntypedConst(Int, "1"),
+		"IPV6_RTHDR_TYPE_0":	MakeUntypedConst(Int, "0"),
+		"IPV6_SOCKOPT_RESERVED1":	MakeUntypedConst(Int, "3"),
+		"IPV6_TCLASS":	MakeUntypedConst(Int, "36"),
+		"IPV6_UNICAST_HOPS":	MakeUntypedConst(Int, "4"),
+		"IPV6_V6ONLY":	MakeUntypedConst(Int, "27"),
+		"IPV6_VERSION":	MakeUntypedConst(Int, "96"),
+		"IPV6_VERSION_MASK":	MakeUntypedConst(Int, "240"),
+		"IP_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "12"),
+		"IP_ADD_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "70"),
+		"IP_BLOCK_SOURCE":	MakeUntypedConst(Int, "72"),
+		"IP_BOUND_IF":	MakeUntypedConst(Int, "25"),
+		"IP_DEFAULT_MULTICAST_LOOP":	MakeUntypedConst(Int, "1"),
+		"IP_DEFAULT_MULTICAST_TTL":	MakeUntypedConst(Int, "1"),
+		"IP_DF":	MakeUntypedConst(Int, "16384"),
+		"IP_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "13"),
+		"IP_DROP_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "71"),
+		"IP_DUMMYNET_CONFIGURE":	MakeUntypedConst(Int, "60"),
+		"IP_DUMMYNET_DEL":	MakeUntypedConst(Int, "61"),
+		"IP_DUMMYNET_FLUSH":	MakeUntypedConst(Int, "62"),
+		"IP_DUMMYNET_GET":	MakeUntypedConst(Int, "64"),
+		"IP_FAITH":	MakeUntypedConst(Int, "22"),
+		"IP_FW_ADD":	MakeUntypedConst(Int, "40"),
+		"IP_FW_DEL":	MakeUntypedConst(Int, "41"),
+		"IP_FW_FLUSH":	MakeUntypedConst(Int, "42"),
+		"IP_FW_GET":	MakeUntypedConst(Int, "44"),
+		"IP_FW_RESETLOG":	MakeUntypedConst(Int, "45"),
+		"IP_FW_ZERO":	MakeUntypedConst(Int, "43"),
+		"IP_HDRINCL":	MakeUntypedConst(Int, "2"),
+		"IP_IPSEC_POLICY":	MakeUntypedConst(Int, "21"),
+		"IP_MAXPACKET":	MakeUntypedConst(Int, "65535"),
+		"IP_MAX_GROUP_SRC_FILTER":	MakeUntypedConst(Int, "512"),
+		"IP_MAX_MEMBERSHIPS":	MakeUntypedConst(Int, "4095"),
+		"IP_MAX_SOCK_MUTE_FILTER":	MakeUntypedConst(Int, "128"),
+		"IP_MAX_SOCK_SRC_FILTER":	MakeUntypedConst(Int, "128"),
+		"IP_MF":	MakeUntypedConst(Int, "8192"),
+		"IP_MIN_MEMBERSHIPS":	MakeUntypedConst(Int, "31"),
+		"IP_MSFILTER":	MakeUntypedConst(Int, "74"),
+		"IP_MSS":	MakeUntypedConst(Int, "576"),
+		"IP_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IP_MULTICAST_IFINDEX":	MakeUntypedConst(Int, "66"),
+		"IP_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IP_MULTICAST_TTL":	MakeUntypedConst(Int, "10"),
+		"IP_MULTICAST_VIF":	MakeUntypedConst(Int, "14"),
+		"IP_NAT__XXX":	MakeUntypedConst(Int, "55"),
+		"IP_OFFMASK":	MakeUntypedConst(Int, "8191"),
+		"IP_OLD_FW_ADD":	MakeUntypedConst(Int, "50"),
+		"IP_OLD_FW_DEL":	MakeUntypedConst(Int, "51"),
+		"IP_OLD_FW_FLUSH":	MakeUntypedConst(Int, "52"),
+		"IP_OLD_FW_GET":	MakeUntypedConst(Int, "54"),
+		"IP_OLD_FW_RESETLOG":	MakeUntypedConst(Int, "56"),
+		"IP_OLD_FW_ZERO":	MakeUntypedConst(Int, "53"),
+		"IP_OPTIONS":	MakeUntypedConst(Int, "1"),
+		"IP_PKTINFO":	MakeUntypedConst(Int, "26"),
+		"IP_PORTRANGE":	MakeUntypedConst(Int, "19"),
+		"IP_PORTRANGE_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"IP_PORTRANGE_HIGH":	MakeUntypedConst(Int, "1"),
+		"IP_PORTRANGE_LOW":	MakeUntypedConst(Int, "2"),
+		"IP_RECVDSTADDR":	MakeUntypedConst(Int, "7"),
+		"IP_RECVIF":	MakeUntypedConst(Int, "20"),
+		"IP_RECVOPTS":	MakeUntypedConst(Int, "5"),
+		"IP_RECVPKTINFO":	MakeUntypedConst(Int, "26"),
+		"IP_RECVRETOPTS":	MakeUntypedConst(Int, "6"),
+		"IP_RECVTTL":	MakeUntypedConst(Int, "24"),
+		"IP_RETOPTS":	MakeUntypedConst(Int, "8"),
+		"IP_RF":	MakeUntypedConst(Int, "32768"),
+		"IP_RSVP_OFF":	MakeUntypedConst(Int, "16"),
+		"IP_RSVP_ON":	MakeUntypedConst(Int, "15"),
+		"IP_RSVP_VIF_OFF":	MakeUntypedConst(Int, "18"),
+		"IP_RSVP_VIF_ON":	MakeUntypedConst(Int, "17"),
+		"IP_STRIPHDR":	MakeUntypedConst(Int, "23"),
+		"IP_TOS":	MakeUntypedConst(Int, "3"),
+		"IP_TRAFFIC_MGT_BACKGROUND":	MakeUntypedConst(Int, "65"),
+		"IP_TTL":	MakeUntypedConst(Int, "4"),
+		"IP_UNBLOCK_SOURCE":	MakeUntypedConst(Int, "73"),
+		"ISIG":	MakeUntypedConst(Int, "128"),
+		"ISTRIP":	MakeUntypedConst(Int, "32"),
+		"IUTF8":	MakeUntypedConst(Int, "16384"),
+		"IXANY":	MakeUntypedConst(Int, "2048"),
+		"IXOFF":	MakeUntypedConst(Int, "1024"),
+		"IXON":	MakeUntypedConst(Int, "512"),
+		"ImplementsGetwd":	MakeUntypedConst(Bool, "true"),
+		"LOCK_EX":	MakeUntypedConst(Int, "2"),
+		"LOCK_NB":	MakeUntypedConst(Int, "4"),
+		"LOCK_SH":	MakeUntypedConst(Int, "1"),
+		"LOCK_UN":	MakeUntypedConst(Int, "8"),
+		"MADV_CAN_REUSE":	MakeUntypedConst(Int, "9"),
+		"MADV_DONTNEED":	MakeUntypedConst(Int, "4"),
+		"MADV_FREE":	MakeUntypedConst(Int, "5"),
+		"MADV_FREE_REUSABLE":	MakeUntypedConst(Int, "7"),
+		"MADV_FREE_REUSE":	MakeUntypedConst(Int, "8"),
+		"MADV_NORMAL":	MakeUntypedConst(Int, "0"),
+		"MADV_RANDOM":	MakeUntypedConst(Int, "1"),
+		"MADV_SEQUENTIAL":	MakeUntypedConst(Int, "2"),
+		"MADV_WILLNEED":	MakeUntypedConst(Int, "3"),
+		"MADV_ZERO_WIRED_PAGES":	MakeUntypedConst(Int, "6"),
+		"MAP_ANON":	MakeUntypedConst(Int, "4096"),
+		"MAP_COPY":	MakeUntypedConst(Int, "2"),
+		"MAP_FILE":	MakeUntypedConst(Int, "0"),
+		"MAP_FIXED":	MakeUntypedConst(Int, "16"),
+		"MAP_HASSEMAPHORE":	MakeUntypedConst(Int, "512"),
+		"MAP_JIT":	MakeUntypedConst(Int, "2048"),
+		"MAP_NOCACHE":	MakeUntypedConst(Int, "1024"),
+		"MAP_NOEXTEND":	MakeUntypedConst(Int, "256"),
+		"MAP_NORESERVE":	MakeUntypedConst(Int, "64"),
+		"MAP_PRIVATE":	MakeUntypedConst(Int, "2"),
+		"MAP_RENAME":	MakeUntypedConst(Int, "32"),
+		"MAP_RESERVED0080":	MakeUntypedConst(Int, "128"),
+		"MAP_SHARED":	MakeUntypedConst(Int, "1"),
+		"MCL_CURRENT":	MakeUntypedConst(Int, "1"),
+		"MCL_FUTURE":	MakeUntypedConst(Int, "2"),
+		"MSG_CTRUNC":	MakeUntypedConst(Int, "32"),
+		"MSG_DONTROUTE":	MakeUntypedConst(Int, "4"),
+		"MSG_DONTWAIT":	MakeUntypedConst(Int, "128"),
+		"MSG_EOF":	MakeUntypedConst(Int, "256"),
+		"MSG_EOR":	MakeUntypedConst(Int, "8"),
+		"MSG_FLUSH":	MakeUntypedConst(Int, "1024"),
+		"MSG_HAVEMORE":	MakeUntypedConst(Int, "8192"),
+		"MSG_HOLD":	MakeUntypedConst(Int, "2048"),
+		"MSG_NEEDSA":	MakeUntypedConst(Int, "65536"),
+		"MSG_OOB":	MakeUntypedConst(Int, "1"),
+		"MSG_PEEK":	MakeUntypedConst(Int, "2"),
+		"MSG_RCVMORE":	MakeUntypedConst(Int, "16384"),
+		"MSG_SEND":	MakeUntypedConst(Int, "4096"),
+		"MSG_TRUNC":	MakeUntypedConst(Int, "16"),
+		"MSG_WAITALL":	MakeUntypedConst(Int, "64"),
+		"MSG_WAITSTREAM":	MakeUntypedConst(Int, "512"),
+		"MS_ASYNC":	MakeUntypedConst(Int, "1"),
+		"MS_DEACTIVATE":	MakeUntypedConst(Int, "8"),
+		"MS_INVALIDATE":	MakeUntypedConst(Int, "2"),
+		"MS_KILLPAGES":	MakeUntypedConst(Int, "4"),
+		"MS_SYNC":	MakeUntypedConst(Int, "16"),
+		"NAME_MAX":	MakeUntypedConst(Int, "255"),
+		"NET_RT_DUMP":	MakeUntypedConst(Int, "1"),
+		"NET_RT_DUMP2":	MakeUntypedConst(Int, "7"),
+		"NET_RT_FLAGS":	MakeUntypedConst(Int, "2"),
+		"NET_RT_IFLIST":	MakeUntypedConst(Int, "3"),
+		"NET_RT_IFLIST2":	MakeUntypedConst(Int, "6"),
+		"NET_RT_MAXID":	MakeUntypedConst(Int, "10"),
+		"NET_RT_STAT":	MakeUntypedConst(Int, "4"),
+		"NET_RT_TRASH":	MakeUntypedConst(Int, "5"),
+		"NOFLSH":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_ABSOLUTE":	MakeUntypedConst(Int, "8"),
+		"NOTE_ATTRIB":	MakeUntypedConst(Int, "8"),
+		"NOTE_CHILD":	MakeUntypedConst(Int, "4"),
+		"NOTE_DELETE":	MakeUntypedConst(Int, "1"),
+		"NOTE_EXEC":	MakeUntypedConst(Int, "536870912"),
+		"NOTE_EXIT":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_EXITSTATUS":	MakeUntypedConst(Int, "67108864"),
+		"NOTE_EXTEND":	MakeUntypedConst(Int, "4"),
+		"NOTE_FFAND":	MakeUntypedConst(Int, "1073741824"),
+		"NOTE_FFCOPY":	MakeUntypedConst(Int, "3221225472"),
+		"NOTE_FFCTRLMASK":	MakeUntypedConst(Int, "3221225472"),
+		"NOTE_FFLAGSMASK":	MakeUntypedConst(Int, "16777215"),
+		"NOTE_FFNOP":	MakeUntypedConst(Int, "0"),
+		"NOTE_FFOR":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_FORK":	MakeUntypedConst(Int, "1073741824"),
+		"NOTE_LINK":	MakeUntypedConst(Int, "16"),
+		"NOTE_LOWAT":	MakeUntypedConst(Int, "1"),
+		"NOTE_NONE":	MakeUntypedConst(Int, "128"),
+		"NOTE_NSECONDS":	MakeUntypedConst(Int, "4"),
+		"NOTE_PCTRLMASK":	MakeUntypedConst(Int, "-1048576"),
+		"NOTE_PDATAMASK":	MakeUntypedConst(Int, "1048575"),
+		"NOTE_REAP":	MakeUntypedConst(Int, "268435456"),
+		"NOTE_RENAME":	MakeUntypedConst(Int, "32"),
+		"NOTE_RESOURCEEND":	MakeUntypedConst(Int, "33554432"),
+		"NOTE_REVOKE":	MakeUntypedConst(Int, "64"),
+		"NOTE_SECONDS":	MakeUntypedConst(Int, "1"),
+		"NOTE_SIGNAL":	MakeUntypedConst(Int, "134217728"),
+		"NOTE_TRACK":	MakeUntypedConst(Int, "1"),
+		"NOTE_TRACKERR":	MakeUntypedConst(Int, "2"),
+		"NOTE_TRIGGER":	MakeUntypedConst(Int, "16777216"),
+		"NOTE_USECONDS":	MakeUntypedConst(Int, "2"),
+		"NOTE_VM_ERROR":	MakeUntypedConst(Int, "268435456"),
+		"NOTE_VM_PRESSURE":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_VM_PRESSURE_SUDDEN_TERMINATE":	MakeUntypedConst(Int, "536870912"),
+		"NOTE_VM_PRESSURE_TERMINATE":	MakeUntypedConst(Int, "1073741824"),
+		"NOTE_WRITE":	MakeUntypedConst(Int, "2"),
+		"OCRNL":	MakeUntypedConst(Int, "16"),
+		"OFDEL":	MakeUntypedConst(Int, "131072"),
+		"OFILL":	MakeUntypedConst(Int, "128"),
+		"ONLCR":	MakeUntypedConst(Int, "2"),
+		"ONLRET":	MakeUntypedConst(Int, "64"),
+		"ONOCR":	MakeUntypedConst(Int, "32"),
+		"ONOEOT":	MakeUntypedConst(Int, "8"),
+		"OPOST":	MakeUntypedConst(Int, "1"),
+		"O_ACCMODE":	MakeUntypedConst(Int, "3"),
+		"O_ALERT":	MakeUntypedConst(Int, "536870912"),
+		"O_APPEND":	MakeUntypedConst(Int, "8"),
+		"O_ASYNC":	MakeUntypedConst(Int, "64"),
+		"O_CLOEXEC":	MakeUntypedConst(Int, "16777216"),
+		"O_CREAT":	MakeUntypedConst(Int, "512"),
+		"O_DIRECTORY":	MakeUntypedConst(Int, "1048576"),
+		"O_DSYNC":	MakeUntypedConst(Int, "4194304"),
+		"O_EVTONLY":	MakeUntypedConst(Int, "32768"),
+		"O_EXCL":	MakeUntypedConst(Int, "2048"),
+		"O_EXLOCK":	MakeUntypedConst(Int, "32"),
+		"O_FSYNC":	MakeUntypedConst(Int, "128"),
+		"O_NDELAY":	MakeUntypedConst(Int, "4"),
+		"O_NOCTTY":	MakeUntypedConst(Int, "131072"),
+		"O_NOFOLLOW":	MakeUntypedConst(Int, "256"),
+		"O_NONBLOCK":	MakeUntypedConst(Int, "4"),
+		"O_POPUP":	MakeUntypedConst(Int, "2147483648"),
+		"O_RDONLY":	MakeUntypedConst(Int, "0"),
+		"O_RDWR":	MakeUntypedConst(Int, "2"),
+		"O_SHLOCK":	MakeUntypedConst(Int, "16"),
+		"O_SYMLINK":	MakeUntypedConst(Int, "2097152"),
+		"O_SYNC":	MakeUntypedConst(Int, "128"),
+		"O_TRUNC":	MakeUntypedConst(Int, "1024"),
+		"O_WRONLY":	MakeUntypedConst(Int, "1"),
+		"PARENB":	MakeUntypedConst(Int, "4096"),
+		"PARMRK":	MakeUntypedConst(Int, "8"),
+		"PARODD":	MakeUntypedConst(Int, "8192"),
+		"PENDIN":	MakeUntypedConst(Int, "536870912"),
+		"PRIO_PGRP":	MakeUntypedConst(Int, "1"),
+		"PRIO_PROCESS":	MakeUntypedConst(Int, "0"),
+		"PRIO_USER":	MakeUntypedConst(Int, "2"),
+		"PROT_EXEC":	MakeUntypedConst(Int, "4"),
+		"PROT_NONE":	MakeUntypedConst(Int, "0"),
+		"PROT_READ":	MakeUntypedConst(Int, "1"),
+		"PROT_WRITE":	MakeUntypedConst(Int, "2"),
+		"PTRACE_CONT":	MakeUntypedConst(Int, "7"),
+		"PTRACE_KILL":	MakeUntypedConst(Int, "8"),
+		"PTRACE_TRACEME":	MakeUntypedConst(Int, "0"),
+		"PT_ATTACH":	MakeUntypedConst(Int, "10"),
+		"PT_ATTACHEXC":	MakeUntypedConst(Int, "14"),
+		"PT_CONTINUE":	MakeUntypedConst(Int, "7"),
+		"PT_DENY_ATTACH":	MakeUntypedConst(Int, "31"),
+		"PT_DETACH":	MakeUntypedConst(Int, "11"),
+		"PT_FIRSTMACH":	MakeUntypedConst(Int, "32"),
+		"PT_FORCEQUOTA":	MakeUntypedConst(Int, "30"),
+		"PT_KILL":	MakeUntypedConst(Int, "8"),
+		"PT_READ_D":	MakeUntypedConst(Int, "2"),
+		"PT_READ_I":	MakeUntypedConst(Int, "1"),
+		"PT_READ_U":	MakeUntypedConst(Int, "3"),
+		"PT_SIGEXC":	MakeUntypedConst(Int, "12"),
+		"PT_STEP":	MakeUntypedConst(Int, "9"),
+		"PT_THUPDATE":	MakeUntypedConst(Int, "13"),
+		"PT_TRACE_ME":	MakeUntypedConst(Int, "0"),
+		"PT_WRITE_D":	MakeUntypedConst(Int, "5"),
+		"PT_WRITE_I":	MakeUntypedConst(Int, "4"),
+		"PT_WRITE_U":	MakeUntypedConst(Int, "6"),
+		"RLIMIT_AS":	MakeUntypedConst(Int, "5"),
+		"RLIMIT_CORE":	MakeUntypedConst(Int, "4"),
+		"RLIMIT_CPU":	MakeUntypedConst(Int, "0"),
+		"RLIMIT_DATA":	MakeUntypedConst(Int, "2"),
+		"RLIMIT_FSIZE":	MakeUntypedConst(Int, "1"),
+		"RLIMIT_NOFILE":	MakeUntypedConst(Int, "8"),
+		"RLIMIT_STACK":	MakeUntypedConst(Int, "3"),
+		"RLIM_INFINITY":	MakeUntypedConst(Int, "9223372036854775807"),
+		"RTAX_AUTHOR":	MakeUntypedConst(Int, "6"),
+		"RTAX_BRD":	MakeUntypedConst(Int, "7"),
+		"RTAX_DST":	MakeUntypedConst(Int, "0"),
+		"RTAX_GATEWAY":	MakeUntypedConst(Int, "1"),
+		"RTAX_GENMASK":	MakeUntypedConst(Int, "3"),
+		"RTAX_IFA":	MakeUntypedConst(Int, "5"),
+		"RTAX_IFP":	MakeUntypedConst(Int, "4"),
+		"RTAX_MAX":	MakeUntypedConst(Int, "8"),
+		"RTAX_NETMASK":	MakeUntypedConst(Int, "2"),
+		"RTA_AUTHOR":	MakeUntypedConst(Int, "64"),
+		"RTA_BRD":	MakeUntypedConst(Int, "128"),
+		"RTA_DST":	MakeUntypedConst(Int, "1"),
+		"RTA_GATEWAY":	MakeUntypedConst(Int, "2"),
+		"RTA_GENMASK":	MakeUntypedConst(Int, "8"),
+		"RTA_IFA":	MakeUntypedConst(Int, "32"),
+		"RTA_IFP":	MakeUntypedConst(Int, "16"),
+		"RTA_NETMASK":	MakeUntypedConst(Int, "4"),
+		"RTF_BLACKHOLE":	MakeUntypedConst(Int, "4096"),
+		"RTF_BROADCAST":	MakeUntypedConst(Int, "4194304"),
+		"RTF_CLONING":	MakeUntypedConst(Int, "256"),
+		"RTF_CONDEMNED":	MakeUntypedConst(Int, "33554432"),
+		"RTF_DELCLONE":	MakeUntypedConst(Int, "128"),
+		"RTF_DONE":	MakeUntypedConst(Int, "64"),
+		"RTF_DYNAMIC":	MakeUntypedConst(Int, "16"),
+		"RTF_GATEWAY":	MakeUntypedConst(Int, "2"),
+		"RTF_HOST":	MakeUntypedConst(Int, "4"),
+		"RTF_IFREF":	MakeUntypedConst(Int, "67108864"),
+		"RTF_IFSCOPE":	MakeUntypedConst(Int, "16777216"),
+		"RTF_LLINFO":	MakeUntypedConst(Int, "1024"),
+		"RTF_LOCAL":	MakeUntypedConst(Int, "2097152"),
+		"RTF_MODIFIED":	MakeUntypedConst(Int, "32"),
+		"RTF_MULTICAST":	MakeUntypedConst(Int, "8388608"),
+		"RTF_PINNED":	MakeUntypedConst(Int, "1048576"),
+		"RTF_PRCLONING":	MakeUntypedConst(Int, "65536"),
+		"RTF_PROTO1":	MakeUntypedConst(Int, "32768"),
+		"RTF_PROTO2":	MakeUntypedConst(Int, "16384"),
+		"RTF_PROTO3":	MakeUntypedConst(Int, "262144"),
+		"RTF_REJECT":	MakeUntypedConst(Int, "8"),
+		"RTF_STATIC":	MakeUntypedConst(Int, "2048"),
+		"RTF_UP":	MakeUntypedConst(Int, "1"),
+		"RTF_WASCLONED":	MakeUntypedConst(Int, "131072"),
+		"RTF_XRESOLVE":	MakeUntypedConst(Int, "512"),
+		"RTM_ADD":	MakeUntypedConst(Int, "1"),
+		"RTM_CHANGE":	MakeUntypedConst(Int, "3"),
+		"RTM_DELADDR":	MakeUntypedConst(Int, "13"),
+		"RTM_DELETE":	MakeUntypedConst(Int, "2"),
+		"RTM_DELMADDR":	MakeUntypedConst(Int, "16"),
+		"RTM_GET":	MakeUntypedConst(Int, "4"),
+		"RTM_GET2":	MakeUntypedConst(Int, "20"),
+		"RTM_IFINFO":	MakeUntypedConst(Int, "14"),
+		"RTM_IFINFO2":	MakeUntypedConst(Int, "18"),
+		"RTM_LOCK":	MakeUntypedConst(Int, "8"),
+		"RTM_LOSING":	MakeUntypedConst(Int, "5"),
+		"RTM_MISS":	MakeUntypedConst(Int, "7"),
+		"RTM_NEWADDR":	MakeUntypedConst(Int, "12"),
+		"RTM_NEWMADDR":	MakeUntypedConst(Int, "15"),
+		"RTM_NEWMADDR2":	MakeUntypedConst(Int, "19"),
+		"RTM_OLDADD":	MakeUntypedConst(Int, "9"),
+		"RTM_OLDDEL":	MakeUntypedConst(Int, "10"),
+		"RTM_REDIRECT":	MakeUntypedConst(Int, "6"),
+		"RTM_RESOLVE":	MakeUntypedConst(Int, "11"),
+		"RTM_RTTUNIT":	MakeUntypedConst(Int, "1000000"),
+		"RTM_VERSION":	MakeUntypedConst(Int, "5"),
+		"RTV_EXPIRE":	MakeUntypedConst(Int, "4"),
+		"RTV_HOPCOUNT":	MakeUntypedConst(Int, "2"),
+		"RTV_MTU":	MakeUntypedConst(Int, "1"),
+		"RTV_RPIPE":	MakeUntypedConst(Int, "8"),
+		"RTV_RTT":	MakeUntypedConst(Int, "64"),
+		"RTV_RTTVAR":	MakeUntypedConst(Int, "128"),
+		"RTV_SPIPE":	MakeUntypedConst(Int, "16"),
+		"RTV_SSTHRESH":	MakeUntypedConst(Int, "32"),
+		"RUSAGE_CHILDREN":	MakeUntypedConst(Int, "-1"),
+		"RUSAGE_SELF":	MakeUntypedConst(Int, "0"),
+		"SCM_CREDS":	MakeUntypedConst(Int, "3"),
+		"SCM_RIGHTS":	MakeUntypedConst(Int, "1"),
+		"SCM_TIMESTAMP":	MakeUntypedConst(Int, "2"),
+		"SCM_TIMESTAMP_MONOTONIC":	MakeUntypedConst(Int, "4"),
+		"SHUT_RD":	MakeUntypedConst(Int, "0"),
+		"SHUT_RDWR":	MakeUntypedConst(Int, "2"),
+		"SHUT_WR":	MakeUntypedConst(Int, "1"),
+		"SIOCADDMULTI":	MakeUntypedConst(Int, "2149607729"),
+		"SIOCAIFADDR":	MakeUntypedConst(Int, "2151704858"),
+		"SIOCALIFADDR":	MakeUntypedConst(Int, "2165860637"),
+		"SIOCARPIPLL":	MakeUntypedConst(Int, "3223349544"),
+		"SIOCATMARK":	MakeUntypedConst(Int, "1074033415"),
+		"SIOCAUTOADDR":	MakeUntypedConst(Int, "3223349542"),
+		"SIOCAUTONETMASK":	MakeUntypedConst(Int, "2149607719"),
+		"SIOCDELMULTI":	MakeUntypedConst(Int, "2149607730"),
+		"SIOCDIFADDR":	MakeUntypedConst(Int, "2149607705"),
+		"SIOCDIFPHYADDR":	MakeUntypedConst(Int, "2149607745"),
+		"SIOCDLIFADDR":	MakeUntypedConst(Int, "2165860639"),
+		"SIOCGDRVSPEC":	MakeUntypedConst(Int, "3223873915"),
+		"SIOCGETSGCNT":	MakeUntypedConst(Int, "3222565404"),
+		"SIOCGETVIFCNT":	MakeUntypedConst(Int, "3222565403"),
+		"SIOCGETVLAN":	MakeUntypedConst(Int, "3223349631"),
+		"SIOCGHIWAT":	MakeUntypedConst(Int, "1074033409"),
+		"SIOCGIFADDR":	MakeUntypedConst(Int, "3223349537"),
+		"SIOCGIFALTMTU":	MakeUntypedConst(Int, "3223349576"),
+		"SIOCGIFASYNCMAP":	MakeUntypedConst(Int, "3223349628"),
+		"SIOCGIFBOND":	MakeUntypedConst(Int, "3223349575"),
+		"SIOCGIFBRDADDR":	MakeUntypedConst(Int, "3223349539"),
+		"SIOCGIFCAP":	MakeUntypedConst(Int, "3223349595"),
+		"SIOCGIFCONF":	MakeUntypedConst(Int, "3222038820"),
+		"SIOCGIFDEVMTU":	MakeUntypedConst(Int, "3223349572"),
+		"SIOCGIFDSTADDR":	MakeUntypedConst(Int, "3223349538"),
+		"SIOCGIFFLAGS":	MakeUntypedConst(Int, "3223349521"),
+		"SIOCGIFGENERIC":	MakeUntypedConst(Int, "3223349562"),
+		"SIOCGIFKPI":	MakeUntypedConst(Int, "3223349639"),
+		"SIOCGIFMAC":	MakeUntypedConst(Int, "3223349634"),
+		"SIOCGIFMEDIA":	MakeUntypedConst(Int, "3224135992"),
+		"SIOCGIFMETRIC":	MakeUntypedConst(Int, "3223349527"),
+		"SIOCGIFMTU":	MakeUntypedConst(Int, "3223349555"),
+		"SIOCGIFNETMASK":	MakeUntypedConst(Int, "3223349541"),
+		"SIOCGIFPDSTADDR":	MakeUntypedConst(Int, "3223349568"),
+		"SIOCGIFPHYS":	MakeUntypedConst(Int, "3223349557"),
+		"SIOCGIFPSRCADDR":	MakeUntypedConst(Int, "3223349567"),
+		"SIOCGIFSTATUS":	MakeUntypedConst(Int, "3274795325"),
+		"SIOCGIFVLAN":	MakeUntypedConst(Int, "3223349631"),
+		"SIOCGIFWAKEFLAGS":	MakeUntypedConst(Int, "3223349640"),
+		"SIOCGLIFADDR":	MakeUntypedConst(Int, "3239602462"),
+		"SIOCGLIFPHYADDR":	MakeUntypedConst(Int, "3239602499"),
+		"SIOCGLOWAT":	MakeUntypedConst(Int, "1074033411"),
+		"SIOCGPGRP":	MakeUntypedConst(Int, "1074033417"),
+		"SIOCIFCREATE":	MakeUntypedConst(Int, "3223349624"),
+		"SIOCIFCREATE2":	MakeUntypedConst(Int, "3223349626"),
+		"SIOCIFDESTROY":	MakeUntypedConst(Int, "2149607801"),
+		"SIOCRSLVMULTI":	MakeUntypedConst(Int, "3222300987"),
+		"SIOCSDRVSPEC":	MakeUntypedConst(Int, "2150132091"),
+		"SIOCSETVLAN":	MakeUntypedConst(Int, "2149607806"),
+		"SIOCSHIWAT":	MakeUntypedConst(Int, "2147775232"),
+		"SIOCSIFADDR":	MakeUntypedConst(Int, "2149607692"),
+		"SIOCSIFALTMTU":	MakeUntypedConst(Int, "2149607749"),
+		"SIOCSIFASYNCMAP":	MakeUntypedConst(Int, "2149607805"),
+		"SIOCSIFBOND":	MakeUntypedConst(Int, "2149607750"),
+		"SIOCSIFBRDADDR":	MakeUntypedConst(Int, "2149607699"),
+		"SIOCSIFCAP":	MakeUntypedConst(Int, "2149607770"),
+		"SIOCSIFDSTADDR":	MakeUntypedConst(Int, "2149607694"),
+		"SIOCSIFFLAGS":	MakeUntypedConst(Int, "2149607696"),
+		"SIOCSIFGENERIC":	MakeUntypedConst(Int, "2149607737"),
+		"SIOCSIFKPI":	MakeUntypedConst(Int, "2149607814"),
+		"SIOCSIFLLADDR":	MakeUntypedConst(Int, "2149607740"),
+		"SIOCSIFMAC":	MakeUntypedConst(Int, "2149607811"),
+		"SIOCSIFMEDIA":	MakeUntypedConst(Int, "3223349559"),
+		"SIOCSIFMETRIC":	MakeUntypedConst(Int, "2149607704"),
+		"SIOCSIFMTU":	MakeUntypedConst(Int, "2149607732"),
+		"SIOCSIFNETMASK":	MakeUntypedConst(Int, "2149607702"),
+		"SIOCSIFPHYADDR":	MakeUntypedConst(Int, "2151704894"),
+		"SIOCSIFPHYS":	MakeUntypedConst(Int, "2149607734"),
+		"SIOCSIFVLAN":	MakeUntypedConst(Int, "2149607806"),
+		"SIOCSLIFPHYADDR":	MakeUntypedConst(Int, "2165860674"),
+		"SIOCSLOWAT":	MakeUntypedConst(Int, "2147775234"),
+		"SIOCSPGRP":	MakeUntypedConst(Int, "2147775240"),
+		"SOCK_DGRAM":	MakeUntypedConst(Int, "2"),
+		"SOCK_MAXADDRLEN":	MakeUntypedConst(Int, "255"),
+		"SOCK_RAW":	MakeUntypedConst(Int, "3"),
+		"SOCK_RDM":	MakeUntypedConst(Int, "4"),
+		"SOCK_SEQPACKET":	MakeUntypedConst(Int, "5"),
+		"SOCK_STREAM":	MakeUntypedConst(Int, "1"),
+		"SOL_SOCKET":	MakeUntypedConst(Int, "65535"),
+		"SOMAXCONN":	MakeUntypedConst(Int, "128"),
+		"SO_ACCEPTCONN":	MakeUntypedConst(Int, "2"),
+		"SO_BROADCAST":	MakeUntypedConst(Int, "32"),
+		"SO_DEBUG":	MakeUntypedConst(Int, "1"),
+		"SO_DONTROUTE":	MakeUntypedConst(Int, "16"),
+		"SO_DONTTRUNC":	MakeUntypedConst(Int, "8192"),
+		"SO_ERROR":	MakeUntypedConst(Int, "4103"),
+		"SO_KEEPALIVE":	MakeUntypedConst(Int, "8"),
+		"SO_LABEL":	MakeUntypedConst(Int, "4112"),
+		"SO_LINGER":	MakeUntypedConst(Int, "128"),
+		"SO_LINGER_SEC":	MakeUntypedConst(Int, "4224"),
+		"SO_NKE":	MakeUntypedConst(Int, "4129"),
+		"SO_NOADDRERR":	MakeUntypedConst(Int, "4131"),
+		"SO_NOSIGPIPE":	MakeUntypedConst(Int, "4130"),
+		"SO_NOTIFYCONFLICT":	MakeUntypedConst(Int, "4134"),
+		"SO_NP_EXTENSIONS":	MakeUntypedConst(Int, "4227"),
+		"SO_NREAD":	MakeUntypedConst(Int, "4128"),
+		"SO_NWRITE":	MakeUntypedConst(Int, "4132"),
+		"SO_OOBINLINE":	MakeUntypedConst(Int, "256"),
+		"SO_PEERLABEL":	MakeUntypedConst(Int, "4113"),
+		"SO_RANDOMPORT":	MakeUntypedConst(Int, "4226"),
+		"SO_RCVBUF":	MakeUntypedConst(Int, "4098"),
+		"SO_RCVLOWAT":	MakeUntypedConst(Int, "4100"),
+		"SO_RCVTIMEO":	MakeUntypedConst(Int, "4102"),
+		"SO_RESTRICTIONS":	MakeUntypedConst(Int, "4225"),
+		"SO_RESTRICT_DENYIN":	MakeUntypedConst(Int, "1"),
+		"SO_RESTRICT_DENYOUT":	MakeUntypedConst(Int, "2"),
+		"SO_RESTRICT_DENYSET":	MakeUntypedConst(Int, "2147483648"),
+		"SO_REUSEADDR":	MakeUntypedConst(Int, "4"),
+		"SO_REUSEPORT":	MakeUntypedConst(Int, "512"),
+		"SO_REUSESHAREUID":	MakeUntypedConst(Int, "4133"),
+		"SO_SNDBUF":	MakeUntypedConst(Int, "4097"),
+		"SO_SNDLOWAT":	MakeUntypedConst(Int, "4099"),
+		"SO_SNDTIMEO":	MakeUntypedConst(Int, "4101"),
+		"SO_TIMESTAMP":	MakeUntypedConst(Int, "1024"),
+		"SO_TIMESTAMP_MONOTONIC":	MakeUntypedConst(Int, "2048"),
+		"SO_TYPE":	MakeUntypedConst(Int, "4104"),
+		"SO_UPCALLCLOSEWAIT":	MakeUntypedConst(Int, "4135"),
+		"SO_USELOOPBACK":	MakeUntypedConst(Int, "64"),
+		"SO_WANTMORE":	MakeUntypedConst(Int, "16384"),
+		"SO_WANTOOBFLAG":	MakeUntypedConst(Int, "32768"),
+		"SYS_ACCEPT":	MakeUntypedConst(Int, "30"),
+		"SYS_ACCEPT_NOCANCEL":	MakeUntypedConst(Int, "404"),
+		"SYS_ACCESS":	MakeUntypedConst(Int, "33"),
+		"SYS_ACCESS_EXTENDED":	MakeUntypedConst(Int, "284"),
+		"SYS_ACCT":	MakeUntypedConst(Int, "51"),
+		"SYS_ADD_PROFIL":	MakeUntypedConst(Int, "176"),
+		"SYS_ADJTIME":	MakeUntypedConst(Int, "140"),
+		"SYS_AIO_CANCEL":	MakeUntypedConst(Int, "316"),
+		"SYS_AIO_ERROR":	MakeUntypedConst(Int, "317"),
+		"SYS_AIO_FSYNC":	MakeUntypedConst(Int, "313"),
+		"SYS_AIO_READ":	MakeUntypedConst(Int, "318"),
+		"SYS_AIO_RETURN":	MakeUntypedConst(Int, "314"),
+		"SYS_AIO_SUSPEND":	MakeUntypedConst(Int, "315"),
+		"SYS_AIO_SUSPEND_NOCANCEL":	MakeUntypedConst(Int, "421"),
+		"SYS_AIO_WRITE":	MakeUntypedConst(Int, "319"),
+		"SYS_ATGETMSG":	MakeUntypedConst(Int, "207"),
+		"SYS_ATPGETREQ":	MakeUntypedConst(Int, "211"),
+		"SYS_ATPGETRSP":	MakeUntypedConst(Int, "212"),
+		"SYS_ATPSNDREQ":	MakeUntypedConst(Int, "209"),
+		"SYS_ATPSNDRSP":	MakeUntypedConst(Int, "210"),
+		"SYS_ATPUTMSG":	MakeUntypedConst(Int, "208"),
+		"SYS_ATSOCKET":	MakeUntypedConst(Int, "206"),
+		"SYS_AUDIT":	MakeUntypedConst(Int, "350"),
+		"SYS_AUDITCTL":	MakeUntypedConst(Int, "359"),
+		"SYS_AUDITON":	MakeUntypedConst(Int, "351"),
+		"SYS_AUDIT_SESSION_JOIN":	MakeUntypedConst(Int, "429"),
+		"SYS_AUDIT_SESSION_PORT":	MakeUntypedConst(Int, "432"),
+		"SYS_AUDIT_SESSION_SELF":	MakeUntypedConst(Int, "428"),
+		"SYS_BIND":	MakeUntypedConst(Int, "104"),
+		"SYS_BSDTHREAD_CREATE":	MakeUntypedConst(Int, "360"),
+		"SYS_BSDTHREAD_REGISTER":	MakeUntypedConst(Int, "366"),
+		"SYS_BSDTHREAD_TERMINATE":	MakeUntypedConst(Int, "361"),
+		"SYS_CHDIR":	MakeUntypedConst(Int, "12"),
+		"SYS_CHFLAGS":	MakeUntypedConst(Int, "34"),
+		"SYS_CHMOD":	MakeUntypedConst(Int, "15"),
+		"SYS_CHMOD_EXTENDED":	MakeUntypedConst(Int, "282"),
+		"SYS_CHOWN":	MakeUntypedConst(Int, "16"),
+		"SYS_CHROOT":	MakeUntypedConst(Int, "61"),
+		"SYS_CHUD":	MakeUntypedConst(Int, "185"),
+		"SYS_CLOSE":	MakeUntypedConst(Int, "6"),
+		"SYS_CLOSE_NOCANCEL":	MakeUntypedConst(Int, "399"),
+		"SYS_CONNECT":	MakeUntypedConst(Int, "98"),
+		"SYS_CONNECT_NOCANCEL":	MakeUntypedConst(Int, "409"),
+		"SYS_COPYFILE":	MakeUntypedConst(Int, "227"),
+		"SYS_CSOPS":	MakeUntypedConst(Int, "169"),
+		"SYS_DELETE":	MakeUntypedConst(Int, "226"),
+		"SYS_DUP":	MakeUntypedConst(Int, "41"),
+		"SYS_DUP2":	MakeUntypedConst(Int, "90"),
+		"SYS_EXCHANGEDATA":	MakeUntypedConst(Int, "223"),
+		"SYS_EXECVE":	MakeUntypedConst(Int, "59"),
+		"SYS_EXIT":	MakeUntypedConst(Int, "1"),
+		"SYS_FCHDIR":	MakeUntypedConst(Int, "13"),
+		"SYS_FCHFLAGS":	MakeUntypedConst(Int, "35"),
+		"SYS_FCHMOD":	MakeUntypedConst(Int, "124"),
+		"SYS_FCHMOD_EXTENDED":	MakeUntypedConst(Int, "283"),
+		"SYS_FCHOWN":	MakeUntypedConst(Int, "123"),
+		"SYS_FCNTL":	MakeUntypedConst(Int, "92"),
+		"SYS_FCNTL_NOCANCEL":	MakeUntypedConst(Int, "406"),
+		"SYS_FDATASYNC":	MakeUntypedConst(Int, "187"),
+		"SYS_FFSCTL":	MakeUntypedConst(Int, "245"),
+		"SYS_FGETATTRLIST":	MakeUntypedConst(Int, "228"),
+		"SYS_FGETXATTR":	MakeUntypedConst(Int, "235"),
+		"SYS_FHOPEN":	MakeUntypedConst(Int, "248"),
+		"SYS_FILEPORT_MAKEFD":	MakeUntypedConst(Int, "431"),
+		"SYS_FILEPORT_MAKEPORT":	MakeUntypedConst(Int, "430"),
+		"SYS_FLISTXATTR":	MakeUntypedConst(Int, "241"),
+		"SYS_FLOCK":	MakeUntypedConst(Int, "131"),
+		"SYS_FORK":	MakeUntypedConst(Int, "2"),
+		"SYS_FPATHCONF":	MakeUntypedConst(Int, "192"),
+		"SYS_FREMOVEXATTR":	MakeUntypedConst(Int, "239"),
+		"SYS_FSCTL":	MakeUntypedConst(Int, "242"),
+		"SYS_FSETATTRLIST":	MakeUntypedConst(Int, "229"),
+		"SYS_FSETXATTR":	MakeUntypedConst(Int, "237"),
+		"SYS_FSGETPATH":	MakeUntypedConst(Int, "427"),
+		"SYS_FSTAT":	MakeUntypedConst(Int, "189"),
+		"SYS_FSTAT64":	MakeUntypedConst(Int, "339"),
+		"SYS_FSTAT64_EXTENDED":	MakeUntypedConst(Int, "343"),
+		"SYS_FSTATFS":	MakeUntypedConst(Int, "158"),
+		"SYS_FSTATFS64":	MakeUntypedConst(Int, "346"),
+		"SYS_FSTATV":	MakeUntypedConst(Int, "219"),
+		"SYS_FSTAT_EXTENDED":	MakeUntypedConst(Int, "281"),
+		"SYS_FSYNC":	MakeUntypedConst(Int, "95"),
+		"SYS_FSYNC_NOCANCEL":	MakeUntypedConst(Int, "408"),
+		"SYS_FTRUNCATE":	MakeUntypedConst(Int, "201"),
+		"SYS_FUTIMES":	MakeUntypedConst(Int, "139"),
+		"SYS_GETATTRLIST":	MakeUntypedConst(Int, "220"),
+		"SYS_GETAUDIT":	MakeUntypedConst(Int, "355"),
+		"SYS_GETAUDIT_ADDR":	MakeUntypedConst(Int, "357"),
+		"SYS_GETAUID":	MakeUntypedConst(Int, "353"),
+		"SYS_GETDIRENTRIES":	MakeUntypedConst(Int, "196"),
+		"SYS_GETDIRENTRIES64":	MakeUntypedConst(Int, "344"),
+		"SYS_GETDIRENTRIESATTR":	MakeUntypedConst(Int, "222"),
+		"SYS_GETDTABLESIZE":	MakeUntypedConst(Int, "89"),
+		"SYS_GETEGID":	MakeUntypedConst(Int, "43"),
+		"SYS_GETEUID":	MakeUntypedConst(Int, "25"),
+		"SYS_GETFH":	MakeUntypedConst(Int, "161"),
+		"SYS_GETFSSTAT":	MakeUntypedConst(Int, "18"),
+		"SYS_GETFSSTAT64":	MakeUntypedConst(Int, "347"),
+		"SYS_GETGID":	MakeUntypedConst(Int, "47"),
+		"SYS_GETGROUPS":	MakeUntypedConst(Int, "79"),
+		"SYS_GETHOSTUUID":	MakeUntypedConst(Int, "142"),
+		"SYS_GETITIMER":	MakeUntypedConst(Int, "86"),
+		"SYS_GETLCID":	MakeUntypedConst(Int, "395"),
+		"SYS_GETLOGIN":	MakeUntypedConst(Int, "49"),
+		"SYS_GETPEERNAME":	MakeUntypedConst(Int, "31"),
+		"SYS_GETPGID":	MakeUntypedConst(Int, "151"),
+		"SYS_GETPGRP":	MakeUntypedConst(Int, "81"),
+		"SYS_GETPID":	MakeUntypedConst(Int, "20"),
+		"SYS_GETPPID":	MakeUntypedConst(Int, "39"),
+		"SYS_GETPRIORITY":	MakeUntypedConst(Int, "100"),
+		"SYS_GETRLIMIT":	MakeUntypedConst(Int, "194"),
+		"SYS_GETRUSAGE":	MakeUntypedConst(Int, "117"),
+		"SYS_GETSGROUPS":	MakeUntypedConst(Int, "288"),
+		"SYS_GETSID":	MakeUntypedConst(Int, "310"),
+		"SYS_GETSOCKNAME":	MakeUntypedConst(Int, "32"),
+		"SYS_GETSOCKOPT":	MakeUntypedConst(Int, "118"),
+		"SYS_GETTID":	MakeUntypedConst(Int, "286"),
+		"SYS_GETTIMEOFDAY":	MakeUntypedConst(Int, "116"),
+		"SYS_GETUID":	MakeUntypedConst(Int, "24"),
+		"SYS_GETWGROUPS":	MakeUntypedConst(Int, "290"),
+		"SYS_GETXATTR":	MakeUntypedConst(Int, "234"),
+		"SYS_IDENTITYSVC":	MakeUntypedConst(Int, "293"),
+		"SYS_INITGROUPS":	MakeUntypedConst(Int, "243"),
+		"SYS_IOCTL":	MakeUntypedConst(Int, "54"),
+		"SYS_IOPOLICYSYS":	MakeUntypedConst(Int, "322"),
+		"SYS_ISSETUGID":	MakeUntypedConst(Int, "327"),
+		"SYS_KDEBUG_TRACE":	MakeUntypedConst(Int, "180"),
+		"SYS_KEVENT":	MakeUntypedConst(Int, "363"),
+		"SYS_KEVENT64":	MakeUntypedConst(Int, "369"),
+		"SYS_KILL":	MakeUntypedConst(Int, "37"),
+		"SYS_KQUEUE":	MakeUntypedConst(Int, "362"),
+		"SYS_LCHOWN":	MakeUntypedConst(Int, "364"),
+		"SYS_LINK":	MakeUntypedConst(Int, "9"),
+		"SYS_LIO_LISTIO":	MakeUntypedConst(Int, "320"),
+		"SYS_LISTEN":	MakeUntypedConst(Int, "106"),
+		"SYS_LISTXATTR":	MakeUntypedConst(Int, "240"),
+		"SYS_LSEEK":	MakeUntypedConst(Int, "199"),
+		"SYS_LSTAT":	MakeUntypedConst(Int, "190"),
+		"SYS_LSTAT64":	MakeUntypedConst(Int, "340"),
+		"SYS_LSTAT64_EXTENDED":	MakeUntypedConst(Int, "342"),
+		"SYS_LSTATV":	MakeUntypedConst(Int, "218"),
+		"SYS_LSTAT_EXTENDED":	MakeUntypedConst(Int, "280"),
+		"SYS_MADVISE":	MakeUntypedConst(Int, "75"),
+		"SYS_MAXSYSCALL":	MakeUntypedConst(Int, "439"),
+		"SYS_MINCORE":	MakeUntypedConst(Int, "78"),
+		"SYS_MINHERIT":	MakeUntypedConst(Int, "250"),
+		"SYS_MKCOMPLEX":	MakeUntypedConst(Int, "216"),
+		"SYS_MKDIR":	MakeUntypedConst(Int, "136"),
+		"SYS_MKDIR_EXTENDED":	MakeUntypedConst(Int, "292"),
+		"SYS_MKFIFO":	MakeUntypedConst(Int, "132"),
+		"SYS_MKFIFO_EXTENDED":	MakeUntypedConst(Int, "291"),
+		"SYS_MKNOD":	MakeUntypedConst(Int, "14"),
+		"SYS_MLOCK":	MakeUntypedConst(Int, "203"),
+		"SYS_MLOCKALL":	MakeUntypedConst(Int, "324"),
+		"SYS_MMAP":	MakeUntypedConst(Int, "197"),
+		"SYS_MODWATCH":	MakeUntypedConst(Int, "233"),
+		"SYS_MOUNT":	MakeUntypedConst(Int, "167"),
+		"SYS_MPROTECT":	MakeUntypedConst(Int, "74"),
+		"SYS_MSGCTL":	MakeUntypedConst(Int, "258"),
+		"SYS_MSGGET":	MakeUntypedConst(Int, "259"),
+		"SYS_MSGRCV":	MakeUntypedConst(Int, "261"),
+		"SYS_MSGRCV_NOCANCEL":	MakeUntypedConst(Int, "419"),
+		"SYS_MSGSND":	MakeUntypedConst(Int, "260"),
+		"SYS_MSGSND_NOCANCEL":	MakeUntypedConst(Int, "418"),
+		"SYS_MSGSYS":	MakeUntypedConst(Int, "252"),
+		"SYS_MSYNC":	MakeUntypedConst(Int, "65"),
+		"SYS_MSYNC_NOCANCEL":	MakeUntypedConst(Int, "405"),
+		"SYS_MUNLOCK":	MakeUntypedConst(Int, "204"),
+		"SYS_MUNLOCKALL":	MakeUntypedConst(Int, "325"),
+		"SYS_MUNMAP":	MakeUntypedConst(Int, "73"),
+		"SYS_NFSCLNT":	MakeUntypedConst(Int, "247"),
+		"SYS_NFSSVC":	MakeUntypedConst(Int, "155"),
+		"SYS_OPEN":	MakeUntypedConst(Int, "5"),
+		"SYS_OPEN_EXTENDED":	MakeUntypedConst(Int, "277"),
+		"SYS_OPEN_NOCANCEL":	MakeUntypedConst(Int, "398"),
+		"SYS_PATHCONF":	MakeUntypedConst(Int, "191"),
+		"SYS_PID_HIBERNATE":	MakeUntypedConst(Int, "435"),
+		"SYS_PID_RESUME":	MakeUntypedConst(Int, "434"),
+		"SYS_PID_SHUTDOWN_SOCKETS":	MakeUntypedConst(Int, "436"),
+		"SYS_PID_SUSPEND":	MakeUntypedConst(Int, "433"),
+		"SYS_PIPE":	MakeUntypedConst(Int, "42"),
+		"SYS_POLL":	MakeUntypedConst(Int, "230"),
+		"SYS_POLL_NOCANCEL":	MakeUntypedConst(Int, "417"),
+		"SYS_POSIX_SPAWN":	MakeUntypedConst(Int, "244"),
+		"SYS_PREAD":	MakeUntypedConst(Int, "153"),
+		"SYS_PREAD_NOCANCEL":	MakeUntypedConst(Int, "414"),
+		"SYS_PROCESS_POLICY":	MakeUntypedConst(Int, "323"),
+		"SYS_PROC_INFO":	MakeUntypedConst(Int, "336"),
+		"SYS_PROFIL":	MakeUntypedConst(Int, "44"),
+		"SYS_PSYNCH_CVBROAD":	MakeUntypedConst(Int, "303"),
+		"SYS_PSYNCH_CVCLRPREPOST":	MakeUntypedConst(Int, "312"),
+		"SYS_PSYNCH_CVSIGNAL":	MakeUntypedConst(Int, "304"),
+		"SYS_PSYNCH_CVWAIT":	MakeUntypedConst(Int, "305"),
+		"SYS_PSYNCH_MUTEXDROP":	MakeUntypedConst(Int, "302"),
+		"SYS_PSYNCH_MUTEXWAIT":	MakeUntypedConst(Int, "301"),
+		"SYS_PSYNCH_RW_DOWNGRADE":	MakeUntypedConst(Int, "299"),
+		"SYS_PSYNCH_RW_LONGRDLOCK":	MakeUntypedConst(Int, "297"),
+		"SYS_PSYNCH_RW_RDLOCK":	MakeUntypedConst(Int, "306"),
+		"SYS_PSYNCH_RW_UNLOCK":	MakeUntypedConst(Int, "308"),
+		"SYS_PSYNCH_RW_UNLOCK2":	MakeUntypedConst(Int, "309"),
+		"SYS_PSYNCH_RW_UPGRADE":	MakeUntypedConst(Int, "300"),
+		"SYS_PSYNCH_RW_WRLOCK":	MakeUntypedConst(Int, "307"),
+		"SYS_PSYNCH_RW_YIELDWRLOCK":	MakeUntypedConst(Int, "298"),
+		"SYS_PTRACE":	MakeUntypedConst(Int, "26"),
+		"SYS_PWRITE":	MakeUntypedConst(Int, "154"),
+		"SYS_PWRITE_NOCANCEL":	MakeUntypedConst(Int, "415"),
+		"SYS_QUOTACTL":	MakeUntypedConst(Int, "165"),
+		"SYS_READ":	MakeUntypedConst(Int, "3"),
+		"SYS_READLINK":	MakeUntypedConst(Int, "58"),
+		"SYS_READV":	MakeUntypedConst(Int, "120"),
+		"SYS_READV_NOCANCEL":	MakeUntypedConst(Int, "411"),
+		"SYS_READ_NOCANCEL":	MakeUntypedConst(Int, "396"),
+		"SYS_REBOOT":	MakeUntypedConst(Int, "55"),
+		"SYS_RECVFROM":	MakeUntypedConst(Int, "29"),
+		"SYS_RECVFROM_NOCANCEL":	MakeUntypedConst(Int, "403"),
+		"SYS_RECVMSG":	MakeUntypedConst(Int, "27"),
+		"SYS_RECVMSG_NOCANCEL":	MakeUntypedConst(Int, "401"),
+		"SYS_REMOVEXATTR":	MakeUntypedConst(Int, "238"),
+		"SYS_RENAME":	MakeUntypedConst(Int, "128"),
+		"SYS_REVOKE":	MakeUntypedConst(Int, "56"),
+		"SYS_RMDIR":	MakeUntypedConst(Int, "137"),
+		"SYS_SEARCHFS":	MakeUntypedConst(Int, "225"),
+		"SYS_SELECT":	MakeUntypedConst(Int, "93"),
+		"SYS_SELECT_NOCANCEL":	MakeUntypedConst(Int, "407"),
+		"SYS_SEMCTL":	MakeUntypedConst(Int, "254"),
+		"SYS_SEMGET":	MakeUntypedConst(Int, "255"),
+		"SYS_SEMOP":	MakeUntypedConst(Int, "256"),
+		"SYS_SEMSYS":	MakeUntypedConst(Int, "251"),
+		"SYS_SEM_CLOSE":	MakeUntypedConst(Int, "269"),
+		"SYS_SEM_DESTROY":	MakeUntypedConst(Int, "276"),
+		"SYS_SEM_GETVALUE":	MakeUntypedConst(Int, "274"),
+		"SYS_SEM_INIT":	MakeUntypedConst(Int, "275"),
+		"SYS_SEM_OPEN":	MakeUntypedConst(Int, "268"),
+		"SYS_SEM_POST":	MakeUntypedConst(Int, "273"),
+		"SYS_SEM_TRYWAIT":	MakeUntypedConst(Int, "272"),
+		"SYS_SEM_UNLINK":	MakeUntypedConst(Int, "270"),
+		"SYS_SEM_WAIT":	MakeUntypedConst(Int, "271"),
+		"SYS_SEM_WAIT_NOCANCEL":	MakeUntypedConst(Int, "420"),
+		"SYS_SENDFILE":	MakeUntypedConst(Int, "337"),
+		"SYS_SENDMSG":	MakeUntypedConst(Int, "28"),
+		"SYS_SENDMSG_NOCANCEL":	MakeUntypedConst(Int, "402"),
+		"SYS_SENDTO":	MakeUntypedConst(Int, "133"),
+		"SYS_SENDTO_NOCANCEL":	MakeUntypedConst(Int, "413"),
+		"SYS_SETATTRLIST":	MakeUntypedConst(Int, "221"),
+		"SYS_SETAUDIT":	MakeUntypedConst(Int, "356"),
+		"SYS_SETAUDIT_ADDR":	MakeUntypedConst(Int, "358"),
+		"SYS_SETAUID":	MakeUntypedConst(Int, "354"),
+		"SYS_SETEGID":	MakeUntypedConst(Int, "182"),
+		"SYS_SETEUID":	MakeUntypedConst(Int, "183"),
+		"SYS_SETGID":	MakeUntypedConst(Int, "181"),
+		"SYS_SETGROUPS":	MakeUntypedConst(Int, "80"),
+		"SYS_SETITIMER":	MakeUntypedConst(Int, "83"),
+		"SYS_SETLCID":	MakeUntypedConst(Int, "394"),
+		"SYS_SETLOGIN":	MakeUntypedConst(Int, "50"),
+		"SYS_SETPGID":	MakeUntypedConst(Int, "82"),
+		"SYS_SETPRIORITY":	MakeUntypedConst(Int, "96"),
+		"SYS_SETPRIVEXEC":	MakeUntypedConst(Int, "152"),
+		"SYS_SETREGID":	MakeUntypedConst(Int, "127"),
+		"SYS_SETREUID":	MakeUntypedConst(Int, "126"),
+		"SYS_SETRLIMIT":	MakeUntypedConst(Int, "195"),
+		"SYS_SETSGROUPS":	MakeUntypedConst(Int, "287"),
+		"SYS_SETSID":	MakeUntypedConst(Int, "147"),
+		"SYS_SETSOCKOPT":	MakeUntypedConst(Int, "105"),
+		"SYS_SETTID":	MakeUntypedConst(Int, "285"),
+		"SYS_SETTID_WITH_PID":	MakeUntypedConst(Int, "311"),
+		"SYS_SETTIMEOFDAY":	MakeUntypedConst(Int, "122"),
+		"SYS_SETUID":	MakeUntypedConst(Int, "23"),
+		"SYS_SETWGROUPS":	MakeUntypedConst(Int, "289"),
+		"SYS_SETXATTR":	MakeUntypedConst(Int, "236"),
+		"SYS_SHARED_REGION_CHECK_NP":	MakeUntypedConst(Int, "294"),
+		"SYS_SHARED_REGION_MAP_AND_SLIDE_NP":	MakeUntypedConst(Int, "438"),
+		"SYS_SHMAT":	MakeUntypedConst(Int, "262"),
+		"SYS_SHMCTL":	MakeUntypedConst(Int, "263"),
+		"SYS_SHMDT":	MakeUntypedConst(Int, "264"),
+		"SYS_SHMGET":	MakeUntypedConst(Int, "265"),
+		"SYS_SHMSYS":	MakeUntypedConst(Int, "253"),
+		"SYS_SHM_OPEN":	MakeUntypedConst(Int, "266"),
+		"SYS_SHM_UNLINK":	MakeUntypedConst(Int, "267"),
+		"SYS_SHUTDOWN":	MakeUntypedConst(Int, "134"),
+		"SYS_SIGACTION":	MakeUntypedConst(Int, "46"),
+		"SYS_SIGALTSTACK":	MakeUntypedConst(Int, "53"),
+		"SYS_SIGPENDING":	MakeUntypedConst(Int, "52"),
+		"SYS_SIGPROCMASK":	MakeUntypedConst(Int, "48"),
+		"SYS_SIGRETURN":	MakeUntypedConst(Int, "184"),
+		"SYS_SIGSUSPEND":	MakeUntypedConst(Int, "111"),
+		"SYS_SIGSUSPEND_NOCANCEL":	MakeUntypedConst(Int, "410"),
+		"SYS_SOCKET":	MakeUntypedConst(Int, "97"),
+		"SYS_SOCKETPAIR":	MakeUntypedConst(Int, "135"),
+		"SYS_STACK_SNAPSHOT":	MakeUntypedConst(Int, "365"),
+		"SYS_STAT":	MakeUntypedConst(Int, "188"),
+		"SYS_STAT64":	MakeUntypedConst(Int, "338"),
+		"SYS_STAT64_EXTENDED":	MakeUntypedConst(Int, "341"),
+		"SYS_STATFS":	MakeUntypedConst(Int, "157"),
+		"SYS_STATFS64":	MakeUntypedConst(Int, "345"),
+		"SYS_STATV":	MakeUntypedConst(Int, "217"),
+		"SYS_STAT_EXTENDED":	MakeUntypedConst(Int, "279"),
+		"SYS_SWAPON":	MakeUntypedConst(Int, "85"),
+		"SYS_SYMLINK":	MakeUntypedConst(Int, "57"),
+		"SYS_SYNC":	MakeUntypedConst(Int, "36"),
+		"SYS_SYSCALL":	MakeUntypedConst(Int, "0"),
+		"SYS_THREAD_SELFID":	MakeUntypedConst(Int, "372"),
+		"SYS_TRUNCATE":	MakeUntypedConst(Int, "200"),
+		"SYS_UMASK":	MakeUntypedConst(Int, "60"),
+		"SYS_UMASK_EXTENDED":	MakeUntypedConst(Int, "278"),
+		"SYS_UNDELETE":	MakeUntypedConst(Int, "205"),
+		"SYS_UNLINK":	MakeUntypedConst(Int, "10"),
+		"SYS_UNMOUNT":	MakeUntypedConst(Int, "159"),
+		"SYS_UTIMES":	MakeUntypedConst(Int, "138"),
+		"SYS_VFORK":	MakeUntypedConst(Int, "66"),
+		"SYS_VM_PRESSURE_MONITOR":	MakeUntypedConst(Int, "296"),
+		"SYS_WAIT4":	MakeUntypedConst(Int, "7"),
+		"SYS_WAIT4_NOCANCEL":	MakeUntypedConst(Int, "400"),
+		"SYS_WAITEVENT":	MakeUntypedConst(Int, "232"),
+		"SYS_WAITID":	MakeUntypedConst(Int, "173"),
+		"SYS_WAITID_NOCANCEL":	MakeUntypedConst(Int, "416"),
+		"SYS_WATCHEVENT":	MakeUntypedConst(Int, "231"),
+		"SYS_WORKQ_KERNRETURN":	MakeUntypedConst(Int, "368"),
+		"SYS_WORKQ_OPEN":	MakeUntypedConst(Int, "367"),
+		"SYS_WRITE":	MakeUntypedConst(Int, "4"),
+		"SYS_WRITEV":	MakeUntypedConst(Int, "121"),
+		"SYS_WRITEV_NOCANCEL":	MakeUntypedConst(Int, "412"),
+		"SYS_WRITE_NOCANCEL":	MakeUntypedConst(Int, "397"),
+		"SYS___DISABLE_THREADSIGNAL":	MakeUntypedConst(Int, "331"),
+		"SYS___MAC_EXECVE":	MakeUntypedConst(Int, "380"),
+		"SYS___MAC_GETFSSTAT":	MakeUntypedConst(Int, "426"),
+		"SYS___MAC_GET_FD":	MakeUntypedConst(Int, "388"),
+		"SYS___MAC_GET_FILE":	MakeUntypedConst(Int, "382"),
+		"SYS___MAC_GET_LCID":	MakeUntypedConst(Int, "391"),
+		"SYS___MAC_GET_LCTX":	MakeUntypedConst(Int, "392"),
+		"SYS___MAC_GET_LINK":	MakeUntypedConst(Int, "384"),
+		"SYS___MAC_GET_MOUNT":	MakeUntypedConst(Int, "425"),
+		"SYS___MAC_GET_PID":	MakeUntypedConst(Int, "390"),
+		"SYS___MAC_GET_PROC":	MakeUntypedConst(Int, "386"),
+		"SYS___MAC_MOUNT":	MakeUntypedConst(Int, "424"),
+		"SYS___MAC_SET_FD":	MakeUntypedConst(Int, "389"),
+		"SYS___MAC_SET_FILE":	MakeUntypedConst(Int, "383"),
+		"SYS___MAC_SET_LCTX":	MakeUntypedConst(Int, "393"),
+		"SYS___MAC_SET_LINK":	MakeUntypedConst(Int, "385"),
+		"SYS___MAC_SET_PROC":	MakeUntypedConst(Int, "387"),
+		"SYS___MAC_SYSCALL":	MakeUntypedConst(Int, "381"),
+		"SYS___OLD_SEMWAIT_SIGNAL":	MakeUntypedConst(Int, "370"),
+		"SYS___OLD_SEMWAIT_SIGNAL_NOCANCEL":	MakeUntypedConst(Int, "371"),
+		"SYS___PTHREAD_CANCELED":	MakeUntypedConst(Int, "333"),
+		"SYS___PTHREAD_CHDIR":	MakeUntypedConst(Int, "348"),
+		"SYS___PTHREAD_FCHDIR":	MakeUntypedConst(Int, "349"),
+		"SYS___PTHREAD_KILL":	MakeUntypedConst(Int, "328"),
+		"SYS___PTHREAD_MARKCANCEL":	MakeUntypedConst(Int, "332"),
+		"SYS___PTHREAD_SIGMASK":	MakeUntypedConst(Int, "329"),
+		"SYS___SEMWAIT_SIGNAL":	MakeUntypedConst(Int, "334"),
+		"SYS___SEMWAIT_SIGNAL_NOCANCEL":	MakeUntypedConst(Int, "423"),
+		"SYS___SIGWAIT":	MakeUntypedConst(Int, "330"),
+		"SYS___SIGWAIT_NOCANCEL":	MakeUntypedConst(Int, "422"),
+		"SYS___SYSCTL":	MakeUntypedConst(Int, "202"),
+		"S_IEXEC":	MakeUntypedConst(Int, "64"),
+		"S_IFBLK":	MakeUntypedConst(Int, "24576"),
+		"S_IFCHR":	MakeUntypedConst(Int, "8192"),
+		"S_IFDIR":	MakeUntypedConst(Int, "16384"),
+		"S_IFIFO":	MakeUntypedConst(Int, "4096"),
+		"S_IFLNK":	MakeUntypedConst(Int, "40960"),
+		"S_IFMT":	MakeUntypedConst(Int, "61440"),
+		"S_IFREG":	MakeUntypedConst(Int, "32768"),
+		"S_IFSOCK":	MakeUntypedConst(Int, "49152"),
+		"S_IFWHT":	MakeUntypedConst(Int, "57344"),
+		"S_IREAD":	MakeUntypedConst(Int, "256"),
+		"S_IRGRP":	MakeUntypedConst(Int, "32"),
+		"S_IROTH":	MakeUntypedConst(Int, "4"),
+		"S_IRUSR":	MakeUntypedConst(Int, "256"),
+		"S_IRWXG":	MakeUntypedConst(Int, "56"),
+		"S_IRWXO":	MakeUntypedConst(Int, "7"),
+		"S_IRWXU":	MakeUntypedConst(Int, "448"),
+		"S_ISGID":	MakeUntypedConst(Int, "1024"),
+		"S_ISTXT":	MakeUntypedConst(Int, "512"),
+		"S_ISUID":	MakeUntypedConst(Int, "2048"),
+		"S_ISVTX":	MakeUntypedConst(Int, "512"),
+		"S_IWGRP":	MakeUntypedConst(Int, "16"),
+		"S_IWOTH":	MakeUntypedConst(Int, "2"),
+		"S_IWRITE":	MakeUntypedConst(Int, "128"),
+		"S_IWUSR":	MakeUntypedConst(Int, "128"),
+		"S_IXGRP":	MakeUntypedConst(Int, "8"),
+		"S_IXOTH":	MakeUntypedConst(Int, "1"),
+		"S_IXUSR":	MakeUntypedConst(Int, "64"),
+		"SizeofBpfHdr":	MakeUntypedConst(Int, "20"),
+		"SizeofBpfInsn":	MakeUntypedConst(Int, "8"),
+		"SizeofBpfProgram":	MakeUntypedConst(Int, "16"),
+		"SizeofBpfStat":	MakeUntypedConst(Int, "8"),
+		"SizeofBpfVersion":	MakeUntypedConst(Int, "4"),
+		"SizeofCmsghdr":	MakeUntypedConst(Int, "12"),
+		"SizeofICMPv6Filter":	MakeUntypedConst(Int, "32"),
+		"SizeofIPMreq":	MakeUntypedConst(Int, "8"),
+		"SizeofIPv6MTUInfo":	MakeUntypedConst(Int, "32"),
+		"SizeofIPv6Mreq":	MakeUntypedConst(Int, "20"),
+		"SizeofIfData":	MakeUntypedConst(Int, "96"),
+		"SizeofIfMsghdr":	MakeUntypedConst(Int, "112"),
+		"SizeofIfaMsghdr":	MakeUntypedConst(Int, "20"),
+		"SizeofIfmaMsghdr":	MakeUntypedConst(Int, "16"),
+		"SizeofIfmaMsghdr2":	MakeUntypedConst(Int, "20"),
+		"SizeofInet4Pktinfo":	MakeUntypedConst(Int, "12"),
+		"SizeofInet6Pktinfo":	MakeUntypedConst(Int, "20"),
+		"SizeofLinger":	MakeUntypedConst(Int, "8"),
+		"SizeofMsghdr":	MakeUntypedConst(Int, "48"),
+		"SizeofRtMetrics":	MakeUntypedConst(Int, "56"),
+		"SizeofRtMsghdr":	MakeUntypedConst(Int, "92"),
+		"SizeofSockaddrAny":	MakeUntypedConst(Int, "108"),
+		"SizeofSockaddrDatalink":	MakeUntypedConst(Int, "20"),
+		"SizeofSockaddrInet4":	MakeUntypedConst(Int, "16"),
+		"SizeofSockaddrInet6":	MakeUntypedConst(Int, "28"),
+		"SizeofSockaddrUnix":	MakeUntypedConst(Int, "106"),
+		"TCIFLUSH":	MakeUntypedConst(Int, "1"),
+		"TCIOFLUSH":	MakeUntypedConst(Int, "3"),
+		"TCOFLUSH":	MakeUntypedConst(Int, "2"),
+		"TCP_CONNECTIONTIMEOUT":	MakeUntypedConst(Int, "32"),
+		"TCP_KEEPALIVE":	MakeUntypedConst(Int, "16"),
+		"TCP_MAXHLEN":	MakeUntypedConst(Int, "60"),
+		"TCP_MAXOLEN":	MakeUntypedConst(Int, "40"),
+		"TCP_MAXSEG":	MakeUntypedConst(Int, "2"),
+		"TCP_MAXWIN":	MakeUntypedConst(Int, "65535"),
+		"TCP_MAX_SACK":	MakeUntypedConst(Int, "3"),
+		"TCP_MAX_WINSHIFT":	MakeUntypedConst(Int, "14"),
+		"TCP_MINMSS":	MakeUntypedConst(Int, "216"),
+		"TCP_MINMSSOVERLOAD":	MakeUntypedConst(Int, "1000"),
+		"TCP_MSS":	MakeUntypedConst(Int, "512"),
+		"TCP_NODELAY":	MakeUntypedConst(Int, "1"),
+		"TCP_NOOPT":	MakeUntypedConst(Int, "8"),
+		"TCP_NOPUSH":	MakeUntypedConst(Int, "4"),
+		"TCP_RXT_CONNDROPTIME":	MakeUntypedConst(Int, "128"),
+		"TCP_RXT_FINDROP":	MakeUntypedConst(Int, "256"),
+		"TCSAFLUSH":	MakeUntypedConst(Int, "2"),
+		"TIOCCBRK":	MakeUntypedConst(Int, "536900730"),
+		"TIOCCDTR":	MakeUntypedConst(Int, "536900728"),
+		"TIOCCONS":	MakeUntypedConst(Int, "2147775586"),
+		"TIOCDCDTIMESTAMP":	MakeUntypedConst(Int, "1074820184"),
+		"TIOCDRAIN":	MakeUntypedConst(Int, "536900702"),
+		"TIOCDSIMICROCODE":	MakeUntypedConst(Int, "536900693"),
+		"TIOCEXCL":	MakeUntypedConst(Int, "536900621"),
+		"TIOCEXT":	MakeUntypedConst(Int, "2147775584"),
+		"TIOCFLUSH":	MakeUntypedConst(Int, "2147775504"),
+		"TIOCGDRAINWAIT":	MakeUntypedConst(Int, "1074033750"),
+		"TIOCGETA":	MakeUntypedConst(Int, "1078490131"),
+		"TIOCGETD":	MakeUntypedConst(Int, "1074033690"),
+		"TIOCGPGRP":	MakeUntypedConst(Int, "1074033783"),
+		"TIOCGWINSZ":	MakeUntypedConst(Int, "1074295912"),
+		"TIOCIXOFF":	MakeUntypedConst(Int, "536900736"),
+		"TIOCIXON":	MakeUntypedConst(Int, "536900737"),
+		"TIOCMBIC":	MakeUntypedConst(Int, "2147775595"),
+		"TIOCMBIS":	MakeUntypedConst(Int, "2147775596"),
+		"TIOCMGDTRWAIT":	MakeUntypedConst(Int, "1074033754"),
+		"TIOCMGET":	MakeUntypedConst(Int, "1074033770"),
+		"TIOCMODG":	MakeUntypedConst(Int, "1074033667"),
+		"TIOCMODS":	MakeUntypedConst(Int, "2147775492"),
+		"TIOCMSDTRWAIT":	MakeUntypedConst(Int, "2147775579"),
+		"TIOCMSET":	MakeUntypedConst(Int, "2147775597"),
+		"TIOCM_CAR":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CD":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CTS":	MakeUntypedConst(Int, "32"),
+		"TIOCM_DSR":	MakeUntypedConst(Int, "256"),
+		"TIOCM_DTR":	MakeUntypedConst(Int, "2"),
+		"TIOCM_LE":	MakeUntypedConst(Int, "1"),
+		"TIOCM_RI":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RNG":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RTS":	MakeUntypedConst(Int, "4"),
+		"TIOCM_SR":	MakeUntypedConst(Int, "16"),
+		"TIOCM_ST":	MakeUntypedConst(Int, "8"),
+		"TIOCNOTTY":	MakeUntypedConst(Int, "536900721"),
+		"TIOCNXCL":	MakeUntypedConst(Int, "536900622"),
+		"TIOCOUTQ":	MakeUntypedConst(Int, "1074033779"),
+		"TIOCPKT":	MakeUntypedConst(Int, "2147775600"),
+		"TIOCPKT_DATA":	MakeUntypedConst(Int, "0"),
+		"TIOCPKT_DOSTOP":	MakeUntypedConst(Int, "32"),
+		"TIOCPKT_FLUSHREAD":	MakeUntypedConst(Int, "1"),
+		"TIOCPKT_FLUSHWRITE":	MakeUntypedConst(Int, "2"),
+		"TIOCPKT_IOCTL":	MakeUntypedConst(Int, "64"),
+		"TIOCPKT_NOSTOP":	MakeUntypedConst(Int, "16"),
+		"TIOCPKT_START":	MakeUntypedConst(Int, "8"),
+		"TIOCPKT_STOP":	MakeUntypedConst(Int, "4"),
+		"TIOCPTYGNAME":	MakeUntypedConst(Int, "1082160211"),
+		"TIOCPTYGRANT":	MakeUntypedConst(Int, "536900692"),
+		"TIOCPTYUNLK":	MakeUntypedConst(Int, "536900690"),
+		"TIOCREMOTE":	MakeUntypedConst(Int, "2147775593"),
+		"TIOCSBRK":	MakeUntypedConst(Int, "536900731"),
+		"TIOCSCONS":	MakeUntypedConst(Int, "536900707"),
+		"TIOCSCTTY":	MakeUntypedConst(Int, "536900705"),
+		"TIOCSDRAINWAIT":	MakeUntypedConst(Int, "2147775575"),
+		"TIOCSDTR":	MakeUntypedConst(Int, "536900729"),
+		"TIOCSETA":	MakeUntypedConst(Int, "2152231956"),
+		"TIOCSETAF":	MakeUntypedConst(Int, "2152231958"),
+		"TIOCSETAW":	MakeUntypedConst(Int, "2152231957"),
+		"TIOCSETD":	MakeUntypedConst(Int, "2147775515"),
+		"TIOCSIG":	MakeUntypedConst(Int, "536900703"),
+		"TIOCSPGRP":	MakeUntypedConst(Int, "2147775606"),
+		"TIOCSTART":	MakeUntypedConst(Int, "536900718"),
+		"TIOCSTAT":	MakeUntypedConst(Int, "536900709"),
+		"TIOCSTI":	MakeUntypedConst(Int, "2147578994"),
+		"TIOCSTOP":	MakeUntypedConst(Int, "536900719"),
+		"TIOCSWINSZ":	MakeUntypedConst(Int, "2148037735"),
+		"TIOCTIMESTAMP":	MakeUntypedConst(Int, "1074820185"),
+		"TIOCUCNTL":	MakeUntypedConst(Int, "2147775590"),
+		"TOSTOP":	MakeUntypedConst(Int, "4194304"),
+		"VDISCARD":	MakeUntypedConst(Int, "15"),
+		"VDSUSP":	MakeUntypedConst(Int, "11"),
+		"VEOF":	MakeUntypedConst(Int, "0"),
+		"VEOL":	MakeUntypedConst(Int, "1"),
+		"VEOL2":	MakeUntypedConst(Int, "2"),
+		"VERASE":	MakeUntypedConst(Int, "3"),
+		"VINTR":	MakeUntypedConst(Int, "8"),
+		"VKILL":	MakeUntypedConst(Int, "5"),
+		"VLNEXT":	MakeUntypedConst(Int, "14"),
+		"VMIN":	MakeUntypedConst(Int, "16"),
+		"VQUIT":	MakeUntypedConst(Int, "9"),
+		"VREPRINT":	MakeUntypedConst(Int, "6"),
+		"VSTART":	MakeUntypedConst(Int, "12"),
+		"VSTATUS":	MakeUntypedConst(Int, "18"),
+		"VSTOP":	MakeUntypedConst(Int, "13"),
+		"VSUSP":	MakeUntypedConst(Int, "10"),
+		"VT0":	MakeUntypedConst(Int, "0"),
+		"VT1":	MakeUntypedConst(Int, "65536"),
+		"VTDLY":	MakeUntypedConst(Int, "65536"),
+		"VTIME":	MakeUntypedConst(Int, "17"),
+		"VWERASE":	MakeUntypedConst(Int, "4"),
+		"WCONTINUED":	MakeUntypedConst(Int, "16"),
+		"WCOREFLAG":	MakeUntypedConst(Int, "128"),
+		"WEXITED":	MakeUntypedConst(Int, "4"),
+		"WNOHANG":	MakeUntypedConst(Int, "1"),
+		"WNOWAIT":	MakeUntypedConst(Int, "32"),
+		"WORDSIZE":	MakeUntypedConst(Int, "64"),
+		"WSTOPPED":	MakeUntypedConst(Int, "8"),
+		"WUNTRACED":	MakeUntypedConst(Int, "2"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "syscall"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_syscall
+// +build !gomacro_slim gomacro_syscall
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["syscall"] = Package{
+	Register("syscall", func() Package { return Package{
 	Binds: map[string]Value{
 		"AF_APPLETALK":	ValueOf(syscall.AF_APPLETALK),
 		"AF_ARP":	ValueOf(syscall.AF_ARP),
@@ -2209,5 +2212,1817 @@ func init() {
 		"WaitStatus":	TypeOf((*syscall.WaitStatus)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"AF_APPLETALK":	MakeUntypedConst(Int, "16"),
+		"AF_ARP":	MakeUntypedConst(Int, "35"),
+		"AF_ATM":	MakeUntypedConst(Int, "30"),
+		"AF_BLUETOOTH":	MakeUntypedConst(Int, "36"),
+		"AF_CCITT":	MakeUntypedConst(Int, "10"),
+		"AF_CHAOS":	MakeUntypedConst(Int, "5"),
+		"AF_CNT":	MakeUntypedConst(Int, "21"),
+		"AF_COIP":	MakeUntypedConst(Int, "20"),
+		"AF_DATAKIT":	MakeUntypedConst(Int, "9"),
+		"AF_DECnet":	MakeUntypedConst(Int, "12"),
+		"AF_DLI":	MakeUntypedConst(Int, "13"),
+		"AF_E164":	MakeUntypedConst(Int, "26"),
+		"AF_ECMA":	MakeUntypedConst(Int, "8"),
+		"AF_HYLINK":	MakeUntypedConst(Int, "15"),
+		"AF_IEEE80211":	MakeUntypedConst(Int, "37"),
+		"AF_IMPLINK":	MakeUntypedConst(Int, "3"),
+		"AF_INET":	MakeUntypedConst(Int, "2"),
+		"AF_INET6":	MakeUntypedConst(Int, "28"),
+		"AF_INET6_SDP":	MakeUntypedConst(Int, "42"),
+		"AF_INET_SDP":	MakeUntypedConst(Int, "40"),
+		"AF_IPX":	MakeUntypedConst(Int, "23"),
+		"AF_ISDN":	MakeUntypedConst(Int, "26"),
+		"AF_ISO":	MakeUntypedConst(Int, "7"),
+		"AF_LAT":	MakeUntypedConst(Int, "14"),
+		"AF_LINK":	MakeUntypedConst(Int, "18"),
+		"AF_LOCAL":	MakeUntypedConst(Int, "1"),
+		"AF_MAX":	MakeUntypedConst(Int, "42"),
+		"AF_NATM":	MakeUntypedConst(Int, "29"),
+		"AF_NETBIOS":	MakeUntypedConst(Int, "6"),
+		"AF_NETGRAPH":	MakeUntypedConst(Int, "32"),
+		"AF_OSI":	MakeUntypedConst(Int, "7"),
+		"AF_PUP":	MakeUntypedConst(Int, "4"),
+		"AF_ROUTE":	MakeUntypedConst(Int, "17"),
+		"AF_SCLUSTER":	MakeUntypedConst(Int, "34"),
+		"AF_SIP":	MakeUntypedConst(Int, "24"),
+		"AF_SLOW":	MakeUntypedConst(Int, "33"),
+		"AF_SNA":	MakeUntypedConst(Int, "11"),
+		"AF_UNIX":	MakeUntypedConst(Int, "1"),
+		"AF_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"AF_VENDOR00":	MakeUntypedConst(Int, "39"),
+		"AF_VENDOR01":	MakeUntypedConst(Int, "41"),
+		"AF_VENDOR02":	MakeUntypedConst(Int, "43"),
+		"AF_VENDOR03":	MakeUntypedConst(Int, "45"),
+		"AF_VENDOR04":	MakeUntypedConst(Int, "47"),
+		"AF_VENDOR05":	MakeUntypedConst(Int, "49"),
+		"AF_VENDOR06":	MakeUntypedConst(Int, "51"),
+		"AF_VENDOR07":	MakeUntypedConst(Int, "53"),
+		"AF_VENDOR08":	MakeUntypedConst(Int, "55"),
+		"AF_VENDOR09":	MakeUntypedConst(Int, "57"),
+		"AF_VENDOR10":	MakeUntypedConst(Int, "59"),
+		"AF_VENDOR11":	MakeUntypedConst(Int, "61"),
+		"AF_VENDOR12":	MakeUntypedConst(Int, "63"),
+		"AF_VENDOR13":	MakeUntypedConst(Int, "65"),
+		"AF_VENDOR14":	MakeUntypedConst(Int, "67"),
+		"AF_VENDOR15":	MakeUntypedConst(Int, "69"),
+		"AF_VENDOR16":	MakeUntypedConst(Int, "71"),
+		"AF_VENDOR17":	MakeUntypedConst(Int, "73"),
+		"AF_VENDOR18":	MakeUntypedConst(Int, "75"),
+		"AF_VENDOR19":	MakeUntypedConst(Int, "77"),
+		"AF_VENDOR20":	MakeUntypedConst(Int, "79"),
+		"AF_VENDOR21":	MakeUntypedConst(Int, "81"),
+		"AF_VENDOR22":	MakeUntypedConst(Int, "83"),
+		"AF_VENDOR23":	MakeUntypedConst(Int, "85"),
+		"AF_VENDOR24":	MakeUntypedConst(Int, "87"),
+		"AF_VENDOR25":	MakeUntypedConst(Int, "89"),
+		"AF_VENDOR26":	MakeUntypedConst(Int, "91"),
+		"AF_VENDOR27":	MakeUntypedConst(Int, "93"),
+		"AF_VENDOR28":	MakeUntypedConst(Int, "95"),
+		"AF_VENDOR29":	MakeUntypedConst(Int, "97"),
+		"AF_VENDOR30":	MakeUntypedConst(Int, "99"),
+		"AF_VENDOR31":	MakeUntypedConst(Int, "101"),
+		"AF_VENDOR32":	MakeUntypedConst(Int, "103"),
+		"AF_VENDOR33":	MakeUntypedConst(Int, "105"),
+		"AF_VENDOR34":	MakeUntypedConst(Int, "107"),
+		"AF_VENDOR35":	MakeUntypedConst(Int, "109"),
+		"AF_VENDOR36":	MakeUntypedConst(Int, "111"),
+		"AF_VENDOR37":	MakeUntypedConst(Int, "113"),
+		"AF_VENDOR38":	MakeUntypedConst(Int, "115"),
+		"AF_VENDOR39":	MakeUntypedConst(Int, "117"),
+		"AF_VENDOR40":	MakeUntypedConst(Int, "119"),
+		"AF_VENDOR41":	MakeUntypedConst(Int, "121"),
+		"AF_VENDOR42":	MakeUntypedConst(Int, "123"),
+		"AF_VENDOR43":	MakeUntypedConst(Int, "125"),
+		"AF_VENDOR44":	MakeUntypedConst(Int, "127"),
+		"AF_VENDOR45":	MakeUntypedConst(Int, "129"),
+		"AF_VENDOR46":	MakeUntypedConst(Int, "131"),
+		"AF_VENDOR47":	MakeUntypedConst(Int, "133"),
+		"B0":	MakeUntypedConst(Int, "0"),
+		"B110":	MakeUntypedConst(Int, "110"),
+		"B115200":	MakeUntypedConst(Int, "115200"),
+		"B1200":	MakeUntypedConst(Int, "1200"),
+		"B134":	MakeUntypedConst(Int, "134"),
+		"B14400":	MakeUntypedConst(Int, "14400"),
+		"B150":	MakeUntypedConst(Int, "150"),
+		"B1800":	MakeUntypedConst(Int, "1800"),
+		"B19200":	MakeUntypedConst(Int, "19200"),
+		"B200":	MakeUntypedConst(Int, "200"),
+		"B230400":	MakeUntypedConst(Int, "230400"),
+		"B2400":	MakeUntypedConst(Int, "2400"),
+		"B28800":	MakeUntypedConst(Int, "28800"),
+		"B300":	MakeUntypedConst(Int, "300"),
+		"B38400":	MakeUntypedConst(Int, "38400"),
+		"B460800":	MakeUntypedConst(Int, "460800"),
+		"B4800":	MakeUntypedConst(Int, "4800"),
+		"B50":	MakeUntypedConst(Int, "50"),
+		"B57600":	MakeUntypedConst(Int, "57600"),
+		"B600":	MakeUntypedConst(Int, "600"),
+		"B7200":	MakeUntypedConst(Int, "7200"),
+		"B75":	MakeUntypedConst(Int, "75"),
+		"B76800":	MakeUntypedConst(Int, "76800"),
+		"B921600":	MakeUntypedConst(Int, "921600"),
+		"B9600":	MakeUntypedConst(Int, "9600"),
+		"BIOCFEEDBACK":	MakeUntypedConst(Int, "2147762812"),
+		"BIOCFLUSH":	MakeUntypedConst(Int, "536887912"),
+		"BIOCGBLEN":	MakeUntypedConst(Int, "1074020966"),
+		"BIOCGDIRECTION":	MakeUntypedConst(Int, "1074020982"),
+		"BIOCGDLT":	MakeUntypedConst(Int, "1074020970"),
+		"BIOCGDLTLIST":	MakeUntypedConst(Int, "3221766777"),
+		"BIOCGETBUFMODE":	MakeUntypedConst(Int, "1074020989"),
+		"BIOCGETIF":	MakeUntypedConst(Int, "1075855979"),
+		"BIOCGETZMAX":	MakeUntypedConst(Int, "1074020991"),
+		"BIOCGHDRCMPLT":	MakeUntypedConst(Int, "1074020980"),
+		"BIOCGRSIG":	MakeUntypedConst(Int, "1074020978"),
+		"BIOCGRTIMEOUT":	MakeUntypedConst(Int, "1074283118"),
+		"BIOCGSEESENT":	MakeUntypedConst(Int, "1074020982"),
+		"BIOCGSTATS":	MakeUntypedConst(Int, "1074283119"),
+		"BIOCGTSTAMP":	MakeUntypedConst(Int, "1074020995"),
+		"BIOCIMMEDIATE":	MakeUntypedConst(Int, "2147762800"),
+		"BIOCLOCK":	MakeUntypedConst(Int, "536887930"),
+		"BIOCPROMISC":	MakeUntypedConst(Int, "536887913"),
+		"BIOCROTZBUF":	MakeUntypedConst(Int, "1074545280"),
+		"BIOCSBLEN":	MakeUntypedConst(Int, "3221504614"),
+		"BIOCSDIRECTION":	MakeUntypedConst(Int, "2147762807"),
+		"BIOCSDLT":	MakeUntypedConst(Int, "2147762808"),
+		"BIOCSETBUFMODE":	MakeUntypedConst(Int, "2147762814"),
+		"BIOCSETF":	MakeUntypedConst(Int, "2148024935"),
+		"BIOCSETFNR":	MakeUntypedConst(Int, "2148024962"),
+		"BIOCSETIF":	MakeUntypedConst(Int, "2149597804"),
+		"BIOCSETWF":	MakeUntypedConst(Int, "2148024955"),
+		"BIOCSETZBUF":	MakeUntypedConst(Int, "2148287105"),
+		"BIOCSHDRCMPLT":	MakeUntypedConst(Int, "2147762805"),
+		"BIOCSRSIG":	MakeUntypedConst(Int, "2147762803"),
+		"BIOCSRTIMEOUT":	MakeUntypedConst(Int, "2148024941"),
+		"BIOCSSEESENT":	MakeUntypedConst(Int, "2147762807"),
+		"BIOCSTSTAMP":	MakeUntypedConst(Int, "2147762820"),
+		"BIOCVERSION":	MakeUntypedConst(Int, "1074020977"),
+		"BPF_A":	MakeUntypedConst(Int, "16"),
+		"BPF_ABS":	MakeUntypedConst(Int, "32"),
+		"BPF_ADD":	MakeUntypedConst(Int, "0"),
+		"BPF_ALIGNMENT":	MakeUntypedConst(Int, "4"),
+		"BPF_ALU":	MakeUntypedConst(Int, "4"),
+		"BPF_AND":	MakeUntypedConst(Int, "80"),
+		"BPF_B":	MakeUntypedConst(Int, "16"),
+		"BPF_BUFMODE_BUFFER":	MakeUntypedConst(Int, "1"),
+		"BPF_BUFMODE_ZBUF":	MakeUntypedConst(Int, "2"),
+		"BPF_DIV":	MakeUntypedConst(Int, "48"),
+		"BPF_H":	MakeUntypedConst(Int, "8"),
+		"BPF_IMM":	MakeUntypedConst(Int, "0"),
+		"BPF_IND":	MakeUntypedConst(Int, "64"),
+		"BPF_JA":	MakeUntypedConst(Int, "0"),
+		"BPF_JEQ":	MakeUntypedConst(Int, "16"),
+		"BPF_JGE":	MakeUntypedConst(Int, "48"),
+		"BPF_JGT":	MakeUntypedConst(Int, "32"),
+		"BPF_JMP":	MakeUntypedConst(Int, "5"),
+		"BPF_JSET":	MakeUntypedConst(Int, "64"),
+		"BPF_K":	MakeUntypedConst(Int, "0"),
+		"BPF_LD":	MakeUntypedConst(Int, "0"),
+		"BPF_LDX":	MakeUntypedConst(Int, "1"),
+		"BPF_LEN":	MakeUntypedConst(Int, "128"),
+		"BPF_LSH":	MakeUntypedConst(Int, "96"),
+		"BPF_MAJOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MAXBUFSIZE":	MakeUntypedConst(Int, "524288"),
+		"BPF_MAXINSNS":	MakeUntypedConst(Int, "512"),
+		"BPF_MEM":	MakeUntypedConst(Int, "96"),
+		"BPF_MEMWORDS":	MakeUntypedConst(Int, "16"),
+		"BPF_MINBUFSIZE":	MakeUntypedConst(Int, "32"),
+		"BPF_MINOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MISC":	MakeUntypedConst(Int, "7"),
+		"BPF_MSH":	MakeUntypedConst(Int, "160"),
+		"BPF_MUL":	MakeUntypedConst(Int, "32"),
+		"BPF_NEG":	MakeUntypedConst(Int, "128"),
+		"BPF_OR":	MakeUntypedConst(Int, "64"),
+		"BPF_RELEASE":	MakeUntypedConst(Int, "199606"),
+		"BPF_RET":	MakeUntypedConst(Int, "6"),
+		"BPF_RSH":	MakeUntypedConst(Int, "112"),
+		"BPF_ST":	MakeUntypedConst(Int, "2"),
+		"BPF_STX":	MakeUntypedConst(Int, "3"),
+		"BPF_SUB":	MakeUntypedConst(Int, "16"),
+		"BPF_TAX":	MakeUntypedConst(Int, "0"),
+		"BPF_TXA":	MakeUntypedConst(Int, "128"),
+		"BPF_T_BINTIME":	MakeUntypedConst(Int, "2"),
+		"BPF_T_BINTIME_FAST":	MakeUntypedConst(Int, "258"),
+		"BPF_T_BINTIME_MONOTONIC":	MakeUntypedConst(Int, "514"),
+		"BPF_T_BINTIME_MONOTONIC_FAST":	MakeUntypedConst(Int, "770"),
+		"BPF_T_FAST":	MakeUntypedConst(Int, "256"),
+		"BPF_T_FLAG_MASK":	MakeUntypedConst(Int, "768"),
+		"BPF_T_FORMAT_MASK":	MakeUntypedConst(Int, "3"),
+		"BPF_T_MICROTIME":	MakeUntypedConst(Int, "0"),
+		"BPF_T_MICROTIME_FAST":	MakeUntypedConst(Int, "256"),
+		"BPF_T_MICROTIME_MONOTONIC":	MakeUntypedConst(Int, "512"),
+		"BPF_T_MICROTIME_MONOTONIC_FAST":	MakeUntypedConst(Int, "768"),
+		"BPF_T_MONOTONIC":	MakeUntypedConst(Int, "512"),
+		"BPF_T_MONOTONIC_FAST":	MakeUntypedConst(Int, "768"),
+		"BPF_T_NANOTIME":	MakeUntypedConst(Int, "1"),
+		"BPF_T_NANOTIME_FAST":	MakeUntypedConst(Int, "257"),
+		"BPF_T_NANOTIME_MONOTONIC":	MakeUntypedConst(Int, "513"),
+		"BPF_T_NANOTIME_MONOTONIC_FAST":	MakeUntypedConst(Int, "769"),
+		"BPF_T_NONE":	MakeUntypedConst(Int, "3"),
+		"BPF_T_NORMAL":	MakeUntypedConst(Int, "0"),
+		"BPF_W":	MakeUntypedConst(Int, "0"),
+		"BPF_X":	MakeUntypedConst(Int, "8"),
+		"BRKINT":	MakeUntypedConst(Int, "2"),
+		"CFLUSH":	MakeUntypedConst(Int, "15"),
+		"CLOCAL":	MakeUntypedConst(Int, "32768"),
+		"CREAD":	MakeUntypedConst(Int, "2048"),
+		"CS5":	MakeUntypedConst(Int, "0"),
+		"CS6":	MakeUntypedConst(Int, "256"),
+		"CS7":	MakeUntypedConst(Int, "512"),
+		"CS8":	MakeUntypedConst(Int, "768"),
+		"CSIZE":	MakeUntypedConst(Int, "768"),
+		"CSTART":	MakeUntypedConst(Int, "17"),
+		"CSTATUS":	MakeUntypedConst(Int, "20"),
+		"CSTOP":	MakeUntypedConst(Int, "19"),
+		"CSTOPB":	MakeUntypedConst(Int, "1024"),
+		"CSUSP":	MakeUntypedConst(Int, "26"),
+		"CTL_MAXNAME":	MakeUntypedConst(Int, "24"),
+		"CTL_NET":	MakeUntypedConst(Int, "4"),
+		"DLT_A429":	MakeUntypedConst(Int, "184"),
+		"DLT_A653_ICM":	MakeUntypedConst(Int, "185"),
+		"DLT_AIRONET_HEADER":	MakeUntypedConst(Int, "120"),
+		"DLT_AOS":	MakeUntypedConst(Int, "222"),
+		"DLT_APPLE_IP_OVER_IEEE1394":	MakeUntypedConst(Int, "138"),
+		"DLT_ARCNET":	MakeUntypedConst(Int, "7"),
+		"DLT_ARCNET_LINUX":	MakeUntypedConst(Int, "129"),
+		"DLT_ATM_CLIP":	MakeUntypedConst(Int, "19"),
+		"DLT_ATM_RFC1483":	MakeUntypedConst(Int, "11"),
+		"DLT_AURORA":	MakeUntypedConst(Int, "126"),
+		"DLT_AX25":	MakeUntypedConst(Int, "3"),
+		"DLT_AX25_KISS":	MakeUntypedConst(Int, "202"),
+		"DLT_BACNET_MS_TP":	MakeUntypedConst(Int, "165"),
+		"DLT_BLUETOOTH_HCI_H4":	MakeUntypedConst(Int, "187"),
+		"DLT_BLUETOOTH_HCI_H4_WITH_PHDR":	MakeUntypedConst(Int, "201"),
+		"DLT_CAN20B":	MakeUntypedConst(Int, "190"),
+		"DLT_CAN_SOCKETCAN":	MakeUntypedConst(Int, "227"),
+		"DLT_CHAOS":	MakeUntypedConst(Int, "5"),
+		"DLT_CHDLC":	MakeUntypedConst(Int, "104"),
+		"DLT_CISCO_IOS":	MakeUntypedConst(Int, "118"),
+		"DLT_C_HDLC":	MakeUntypedConst(Int, "104"),
+		"DLT_C_HDLC_WITH_DIR":	MakeUntypedConst(Int, "205"),
+		"DLT_DBUS":	MakeUntypedConst(Int, "231"),
+		"DLT_DECT":	MakeUntypedConst(Int, "221"),
+		"DLT_DOCSIS":	MakeUntypedConst(Int, "143"),
+		"DLT_DVB_CI":	MakeUntypedConst(Int, "235"),
+		"DLT_ECONET":	MakeUntypedConst(Int, "115"),
+		"DLT_EN10MB":	MakeUntypedConst(Int, "1"),
+		"DLT_EN3MB":	MakeUntypedConst(Int, "2"),
+		"DLT_ENC":	MakeUntypedConst(Int, "109"),
+		"DLT_ERF":	MakeUntypedConst(Int, "197"),
+		"DLT_ERF_ETH":	MakeUntypedConst(Int, "175"),
+		"DLT_ERF_POS":	MakeUntypedConst(Int, "176"),
+		"DLT_FC_2":	MakeUntypedConst(Int, "224"),
+		"DLT_FC_2_WITH_FRAME_DELIMS":	MakeUntypedConst(Int, "225"),
+		"DLT_FDDI":	MakeUntypedConst(Int, "10"),
+		"DLT_FLEXRAY":	MakeUntypedConst(Int, "210"),
+		"DLT_FRELAY":	MakeUntypedConst(Int, "107"),
+		"DLT_FRELAY_WITH_DIR":	MakeUntypedConst(Int, "206"),
+		"DLT_GCOM_SERIAL":	MakeUntypedConst(Int, "173"),
+		"DLT_GCOM_T1E1":	MakeUntypedConst(Int, "172"),
+		"DLT_GPF_F":	MakeUntypedConst(Int, "171"),
+		"DLT_GPF_T":	MakeUntypedConst(Int, "170"),
+		"DLT_GPRS_LLC":	MakeUntypedConst(Int, "169"),
+		"DLT_GSMTAP_ABIS":	MakeUntypedConst(Int, "218"),
+		"DLT_GSMTAP_UM":	MakeUntypedConst(Int, "217"),
+		"DLT_HHDLC":	MakeUntypedConst(Int, "121"),
+		"DLT_IBM_SN":	MakeUntypedConst(Int, "146"),
+		"DLT_IBM_SP":	MakeUntypedConst(Int, "145"),
+		"DLT_IEEE802":	MakeUntypedConst(Int, "6"),
+		"DLT_IEEE802_11":	MakeUntypedConst(Int, "105"),
+		"DLT_IEEE802_11_RADIO":	MakeUntypedConst(Int, "127"),
+		"DLT_IEEE802_11_RADIO_AVS":	MakeUntypedConst(Int, "163"),
+		"DLT_IEEE802_15_4":	MakeUntypedConst(Int, "195"),
+		"DLT_IEEE802_15_4_LINUX":	MakeUntypedConst(Int, "191"),
+		"DLT_IEEE802_15_4_NOFCS":	MakeUntypedConst(Int, "230"),
+		"DLT_IEEE802_15_4_NONASK_PHY":	MakeUntypedConst(Int, "215"),
+		"DLT_IEEE802_16_MAC_CPS":	MakeUntypedConst(Int, "188"),
+		"DLT_IEEE802_16_MAC_CPS_RADIO":	MakeUntypedConst(Int, "193"),
+		"DLT_IPFILTER":	MakeUntypedConst(Int, "116"),
+		"DLT_IPMB":	MakeUntypedConst(Int, "199"),
+		"DLT_IPMB_LINUX":	MakeUntypedConst(Int, "209"),
+		"DLT_IPNET":	MakeUntypedConst(Int, "226"),
+		"DLT_IPOIB":	MakeUntypedConst(Int, "242"),
+		"DLT_IPV4":	MakeUntypedConst(Int, "228"),
+		"DLT_IPV6":	MakeUntypedConst(Int, "229"),
+		"DLT_IP_OVER_FC":	MakeUntypedConst(Int, "122"),
+		"DLT_JUNIPER_ATM1":	MakeUntypedConst(Int, "137"),
+		"DLT_JUNIPER_ATM2":	MakeUntypedConst(Int, "135"),
+		"DLT_JUNIPER_ATM_CEMIC":	MakeUntypedConst(Int, "238"),
+		"DLT_JUNIPER_CHDLC":	MakeUntypedConst(Int, "181"),
+		"DLT_JUNIPER_ES":	MakeUntypedConst(Int, "132"),
+		"DLT_JUNIPER_ETHER":	MakeUntypedConst(Int, "178"),
+		"DLT_JUNIPER_FIBRECHANNEL":	MakeUntypedConst(Int, "234"),
+		"DLT_JUNIPER_FRELAY":	MakeUntypedConst(Int, "180"),
+		"DLT_JUNIPER_GGSN":	MakeUntypedConst(Int, "133"),
+		"DLT_JUNIPER_ISM":	MakeUntypedConst(Int, "194"),
+		"DLT_JUNIPER_MFR":	MakeUntypedConst(Int, "134"),
+		"DLT_JUNIPER_MLFR":	MakeUntypedConst(Int, "131"),
+		"DLT_JUNIPER_MLPPP":	MakeUntypedConst(Int, "130"),
+		"DLT_JUNIPER_MONITOR":	MakeUntypedConst(Int, "164"),
+		"DLT_JUNIPER_PIC_PEER":	MakeUntypedConst(Int, "174"),
+		"DLT_JUNIPER_PPP":	MakeUntypedConst(Int, "179"),
+		"DLT_JUNIPER_PPPOE":	MakeUntypedConst(Int, "167"),
+		"DLT_JUNIPER_PPPOE_ATM":	MakeUntypedConst(Int, "168"),
+		"DLT_JUNIPER_SERVICES":	MakeUntypedConst(Int, "136"),
+		"DLT_JUNIPER_SRX_E2E":	MakeUntypedConst(Int, "233"),
+		"DLT_JUNIPER_ST":	MakeUntypedConst(Int, "200"),
+		"DLT_JUNIPER_VP":	MakeUntypedConst(Int, "183"),
+		"DLT_JUNIPER_VS":	MakeUntypedConst(Int, "232"),
+		"DLT_LAPB_WITH_DIR":	MakeUntypedConst(Int, "207"),
+		"DLT_LAPD":	MakeUntypedConst(Int, "203"),
+		"DLT_LIN":	MakeUntypedConst(Int, "212"),
+		"DLT_LINUX_EVDEV":	MakeUntypedConst(Int, "216"),
+		"DLT_LINUX_IRDA":	MakeUntypedConst(Int, "144"),
+		"DLT_LINUX_LAPD":	MakeUntypedConst(Int, "177"),
+		"DLT_LINUX_PPP_WITHDIRECTION":	MakeUntypedConst(Int, "166"),
+		"DLT_LINUX_SLL":	MakeUntypedConst(Int, "113"),
+		"DLT_LOOP":	MakeUntypedConst(Int, "108"),
+		"DLT_LTALK":	MakeUntypedConst(Int, "114"),
+		"DLT_MATCHING_MAX":	MakeUntypedConst(Int, "246"),
+		"DLT_MATCHING_MIN":	MakeUntypedConst(Int, "104"),
+		"DLT_MFR":	MakeUntypedConst(Int, "182"),
+		"DLT_MOST":	MakeUntypedConst(Int, "211"),
+		"DLT_MPEG_2_TS":	MakeUntypedConst(Int, "243"),
+		"DLT_MPLS":	MakeUntypedConst(Int, "219"),
+		"DLT_MTP2":	MakeUntypedConst(Int, "140"),
+		"DLT_MTP2_WITH_PHDR":	MakeUntypedConst(Int, "139"),
+		"DLT_MTP3":	MakeUntypedConst(Int, "141"),
+		"DLT_MUX27010":	MakeUntypedConst(Int, "236"),
+		"DLT_NETANALYZER":	MakeUntypedConst(Int, "240"),
+		"DLT_NETANALYZER_TRANSPARENT":	MakeUntypedConst(Int, "241"),
+		"DLT_NFC_LLCP":	MakeUntypedConst(Int, "245"),
+		"DLT_NFLOG":	MakeUntypedConst(Int, "239"),
+		"DLT_NG40":	MakeUntypedConst(Int, "244"),
+		"DLT_NULL":	MakeUntypedConst(Int, "0"),
+		"DLT_PCI_EXP":	MakeUntypedConst(Int, "125"),
+		"DLT_PFLOG":	MakeUntypedConst(Int, "117"),
+		"DLT_PFSYNC":	MakeUntypedConst(Int, "121"),
+		"DLT_PPI":	MakeUntypedConst(Int, "192"),
+		"DLT_PPP":	MakeUntypedConst(Int, "9"),
+		"DLT_PPP_BSDOS":	MakeUntypedConst(Int, "16"),
+		"DLT_PPP_ETHER":	MakeUntypedConst(Int, "51"),
+		"DLT_PPP_PPPD":	MakeUntypedConst(Int, "166"),
+		"DLT_PPP_SERIAL":	MakeUntypedConst(Int, "50"),
+		"DLT_PPP_WITH_DIR":	MakeUntypedConst(Int, "204"),
+		"DLT_PPP_WITH_DIRECTION":	MakeUntypedConst(Int, "166"),
+		"DLT_PRISM_HEADER":	MakeUntypedConst(Int, "119"),
+		"DLT_PRONET":	MakeUntypedConst(Int, "4"),
+		"DLT_RAIF1":	MakeUntypedConst(Int, "198"),
+		"DLT_RAW":	MakeUntypedConst(Int, "12"),
+		"DLT_RIO":	MakeUntypedConst(Int, "124"),
+		"DLT_SCCP":	MakeUntypedConst(Int, "142"),
+		"DLT_SITA":	MakeUntypedConst(Int, "196"),
+		"DLT_SLIP":	MakeUntypedConst(Int, "8"),
+		"DLT_SLIP_BSDOS":	MakeUntypedConst(Int, "15"),
+		"DLT_STANAG_5066_D_PDU":	MakeUntypedConst(Int, "237"),
+		"DLT_SUNATM":	MakeUntypedConst(Int, "123"),
+		"DLT_SYMANTEC_FIREWALL":	MakeUntypedConst(Int, "99"),
+		"DLT_TZSP":	MakeUntypedConst(Int, "128"),
+		"DLT_USB":	MakeUntypedConst(Int, "186"),
+		"DLT_USB_LINUX":	MakeUntypedConst(Int, "189"),
+		"DLT_USB_LINUX_MMAPPED":	MakeUntypedConst(Int, "220"),
+		"DLT_USER0":	MakeUntypedConst(Int, "147"),
+		"DLT_USER1":	MakeUntypedConst(Int, "148"),
+		"DLT_USER10":	MakeUntypedConst(Int, "157"),
+		"DLT_USER11":	MakeUntypedConst(Int, "158"),
+		"DLT_USER12":	MakeUntypedConst(Int, "159"),
+		"DLT_USER13":	MakeUntypedConst(Int, "160"),
+		"DLT_USER14":	MakeUntypedConst(Int, "161"),
+		"DLT_USER15":	MakeUntypedConst(Int, "162"),
+		"DLT_USER2":	MakeUntypedConst(Int, "149"),
+		"DLT_USER3":	MakeUntypedConst(Int, "150"),
+		"DLT_USER4":	MakeUntypedConst(Int, "151"),
+		"DLT_USER5":	MakeUntypedConst(Int, "152"),
+		"DLT_USER6":	MakeUntypedConst(Int, "153"),
+		"DLT_USER7":	MakeUntypedConst(Int, "154"),
+		"DLT_USER8":	MakeUntypedConst(Int, "155"),
+		"DLT_USER9":	MakeUntypedConst(Int, "156"),
+		"DLT_WIHART":	MakeUntypedConst(Int, "223"),
+		"DLT_X2E_SERIAL":	MakeUntypedConst(Int, "213"),
+		"DLT_X2E_XORAYA":	MakeUntypedConst(Int, "214"),
+		"DT_BLK":	MakeUntypedConst(Int, "6"),
+		"DT_CHR":	MakeUntypedConst(Int, "2"),
+		"DT_DIR":	MakeUntypedConst(Int, "4"),
+		"DT_FIFO":	MakeUntypedConst(Int, "1"),
+		"DT_LNK":	MakeUntypedConst(Int, "10"),
+		"DT_REG":	MakeUntypedConst(Int, "8"),
+		"DT_SOCK":	MakeUntypedConst(Int, "12"),
+		"DT_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"DT_WHT":	MakeUntypedConst(Int, "14"),
+		"ECHO":	MakeUntypedConst(Int, "8"),
+		"ECHOCTL":	MakeUntypedConst(Int, "64"),
+		"ECHOE":	MakeUntypedConst(Int, "2"),
+		"ECHOK":	MakeUntypedConst(Int, "4"),
+		"ECHOKE":	MakeUntypedConst(Int, "1"),
+		"ECHONL":	MakeUntypedConst(Int, "16"),
+		"ECHOPRT":	MakeUntypedConst(Int, "32"),
+		"EVFILT_AIO":	MakeUntypedConst(Int, "-3"),
+		"EVFILT_FS":	MakeUntypedConst(Int, "-9"),
+		"EVFILT_LIO":	MakeUntypedConst(Int, "-10"),
+		"EVFILT_PROC":	MakeUntypedConst(Int, "-5"),
+		"EVFILT_READ":	MakeUntypedConst(Int, "-1"),
+		"EVFILT_SIGNAL":	MakeUntypedConst(Int, "-6"),
+		"EVFILT_SYSCOUNT":	MakeUntypedConst(Int, "11"),
+		"EVFILT_TIMER":	MakeUntypedConst(Int, "-7"),
+		"EVFILT_USER":	MakeUntypedConst(Int, "-11"),
+		"EVFILT_VNODE":	MakeUntypedConst(Int, "-4"),
+		"EVFILT_WRITE":	MakeUntypedConst(Int, "-2"),
+		"EV_ADD":	MakeUntypedConst(Int, "1"),
+		"EV_CLEAR":	MakeUntypedConst(Int, "32"),
+		"EV_DELETE":	MakeUntypedConst(Int, "2"),
+		"EV_DISABLE":	MakeUntypedConst(Int, "8"),
+		"EV_DISPATCH":	MakeUntypedConst(Int, "128"),
+		"EV_DROP":	MakeUntypedConst(Int, "4096"),
+		"EV_ENABLE":	MakeUntypedConst(Int, "4"),
+		"EV_EOF":	MakeUntypedConst(Int, "32768"),
+		"EV_ERROR":	MakeUntypedConst(Int, "16384"),
+		"EV_FLAG1":	MakeUntypedConst(Int, "8192"),
+		"EV_ONESHOT":	MakeUntypedConst(Int, "16"),
+		"EV_RECEIPT":	MakeUntypedConst(Int, "64"),
+		"EV_SYSFLAGS":	MakeUntypedConst(Int, "61440"),
+		"EXTA":	MakeUntypedConst(Int, "19200"),
+		"EXTB":	MakeUntypedConst(Int, "38400"),
+		"EXTPROC":	MakeUntypedConst(Int, "2048"),
+		"FD_CLOEXEC":	MakeUntypedConst(Int, "1"),
+		"FD_SETSIZE":	MakeUntypedConst(Int, "1024"),
+		"FLUSHO":	MakeUntypedConst(Int, "8388608"),
+		"F_CANCEL":	MakeUntypedConst(Int, "5"),
+		"F_DUP2FD":	MakeUntypedConst(Int, "10"),
+		"F_DUP2FD_CLOEXEC":	MakeUntypedConst(Int, "18"),
+		"F_DUPFD":	MakeUntypedConst(Int, "0"),
+		"F_DUPFD_CLOEXEC":	MakeUntypedConst(Int, "17"),
+		"F_GETFD":	MakeUntypedConst(Int, "1"),
+		"F_GETFL":	MakeUntypedConst(Int, "3"),
+		"F_GETLK":	MakeUntypedConst(Int, "11"),
+		"F_GETOWN":	MakeUntypedConst(Int, "5"),
+		"F_OGETLK":	MakeUntypedConst(Int, "7"),
+		"F_OK":	MakeUntypedConst(Int, "0"),
+		"F_OSETLK":	MakeUntypedConst(Int, "8"),
+		"F_OSETLKW":	MakeUntypedConst(Int, "9"),
+		"F_RDAHEAD":	MakeUntypedConst(Int, "16"),
+		"F_RDLCK":	MakeUntypedConst(Int, "1"),
+		"F_READAHEAD":	MakeUntypedConst(Int, "15"),
+		"F_SETFD":	MakeUntypedConst(Int, "2"),
+		"F_SETFL":	MakeUntypedConst(Int, "4"),
+		"F_SETLK":	MakeUntypedConst(Int, "12"),
+		"F_SETLKW":	MakeUntypedConst(Int, "13"),
+		"F_SETLK_REMOTE":	MakeUntypedConst(Int, "14"),
+		"F_SETOWN":	MakeUntypedConst(Int, "6"),
+		"F_UNLCK":	MakeUntypedConst(Int, "2"),
+		"F_UNLCKSYS":	MakeUntypedConst(Int, "4"),
+		"F_WRLCK":	MakeUntypedConst(Int, "3"),
+		"HUPCL":	MakeUntypedConst(Int, "16384"),
+		"ICANON":	MakeUntypedConst(Int, "256"),
+		"ICMP6_FILTER":	MakeUntypedConst(Int, "18"),
+		"ICRNL":	MakeUntypedConst(Int, "256"),
+		"IEXTEN":	MakeUntypedConst(Int, "1024"),
+		"IFAN_ARRIVAL":	MakeUntypedConst(Int, "0"),
+		"IFAN_DEPARTURE":	MakeUntypedConst(Int, "1"),
+		"IFF_ALLMULTI":	MakeUntypedConst(Int, "512"),
+		"IFF_ALTPHYS":	MakeUntypedConst(Int, "16384"),
+		"IFF_BROADCAST":	MakeUntypedConst(Int, "2"),
+		"IFF_CANTCHANGE":	MakeUntypedConst(Int, "2199410"),
+		"IFF_CANTCONFIG":	MakeUntypedConst(Int, "65536"),
+		"IFF_DEBUG":	MakeUntypedConst(Int, "4"),
+		"IFF_DRV_OACTIVE":	MakeUntypedConst(Int, "1024"),
+		"IFF_DRV_RUNNING":	MakeUntypedConst(Int, "64"),
+		"IFF_DYING":	MakeUntypedConst(Int, "2097152"),
+		"IFF_LINK0":	MakeUntypedConst(Int, "4096"),
+		"IFF_LINK1":	MakeUntypedConst(Int, "8192"),
+		"IFF_LINK2":	MakeUntypedConst(Int, "16384"),
+		"IFF_LOOPBACK":	MakeUntypedConst(Int, "8"),
+		"IFF_MONITOR":	MakeUntypedConst(Int, "262144"),
+		"IFF_MULTICAST":	MakeUntypedConst(Int, "32768"),
+		"IFF_NOARP":	MakeUntypedConst(Int, "128"),
+		"IFF_OACTIVE":	MakeUntypedConst(Int, "1024"),
+		"IFF_POINTOPOINT":	MakeUntypedConst(Int, "16"),
+		"IFF_PPROMISC":	MakeUntypedConst(Int, "131072"),
+		"IFF_PROMISC":	MakeUntypedConst(Int, "256"),
+		"IFF_RENAMING":	MakeUntypedConst(Int, "4194304"),
+		"IFF_RUNNING":	MakeUntypedConst(Int, "64"),
+		"IFF_SIMPLEX":	MakeUntypedConst(Int, "2048"),
+		"IFF_SMART":	MakeUntypedConst(Int, "32"),
+		"IFF_STATICARP":	MakeUntypedConst(Int, "524288"),
+		"IFF_UP":	MakeUntypedConst(Int, "1"),
+		"IFNAMSIZ":	MakeUntypedConst(Int, "16"),
+		"IFT_1822":	MakeUntypedConst(Int, "2"),
+		"IFT_A12MPPSWITCH":	MakeUntypedConst(Int, "130"),
+		"IFT_AAL2":	MakeUntypedConst(Int, "187"),
+		"IFT_AAL5":	MakeUntypedConst(Int, "49"),
+		"IFT_ADSL":	MakeUntypedConst(Int, "94"),
+		"IFT_AFLANE8023":	MakeUntypedConst(Int, "59"),
+		"IFT_AFLANE8025":	MakeUntypedConst(Int, "60"),
+		"IFT_ARAP":	MakeUntypedConst(Int, "88"),
+		"IFT_ARCNET":	MakeUntypedConst(Int, "35"),
+		"IFT_ARCNETPLUS":	MakeUntypedConst(Int, "36"),
+		"IFT_ASYNC":	MakeUntypedConst(Int, "84"),
+		"IFT_ATM":	MakeUntypedConst(Int, "37"),
+		"IFT_ATMDXI":	MakeUntypedConst(Int, "105"),
+		"IFT_ATMFUNI":	MakeUntypedConst(Int, "106"),
+		"IFT_ATMIMA":	MakeUntypedConst(Int, "107"),
+		"IFT_ATMLOGICAL":	MakeUntypedConst(Int, "80"),
+		"IFT_ATMRADIO":	MakeUntypedConst(Int, "189"),
+		"IFT_ATMSUBINTERFACE":	MakeUntypedConst(Int, "134"),
+		"IFT_ATMVCIENDPT":	MakeUntypedConst(Int, "194"),
+		"IFT_ATMVIRTUAL":	MakeUntypedConst(Int, "149"),
+		"IFT_BGPPOLICYACCOUNTING":	MakeUntypedConst(Int, "162"),
+		"IFT_BRIDGE":	MakeUntypedConst(Int, "209"),
+		"IFT_BSC":	MakeUntypedConst(Int, "83"),
+		"IFT_CARP":	MakeUntypedConst(Int, "248"),
+		"IFT_CCTEMUL":	MakeUntypedConst(Int, "61"),
+		"IFT_CEPT":	MakeUntypedConst(Int, "19"),
+		"IFT_CES":	MakeUntypedConst(Int, "133"),
+		"IFT_CHANNEL":	MakeUntypedConst(Int, "70"),
+		"IFT_CNR":	MakeUntypedConst(Int, "85"),
+		"IFT_COFFEE":	MakeUntypedConst(Int, "132"),
+		"IFT_COMPOSITELINK":	MakeUntypedConst(Int, "155"),
+		"IFT_DCN":	MakeUntypedConst(Int, "141"),
+		"IFT_DIGITALPOWERLINE":	MakeUntypedConst(Int, "138"),
+		"IFT_DIGITALWRAPPEROVERHEADCHANNEL":	MakeUntypedConst(Int, "186"),
+		"IFT_DLSW":	MakeUntypedConst(Int, "74"),
+		"IFT_DOCSCABLEDOWNSTREAM":	MakeUntypedConst(Int, "128"),
+		"IFT_DOCSCABLEMACLAYER":	MakeUntypedConst(Int, "127"),
+		"IFT_DOCSCABLEUPSTREAM":	MakeUntypedConst(Int, "129"),
+		"IFT_DS0":	MakeUntypedConst(Int, "81"),
+		"IFT_DS0BUNDLE":	MakeUntypedConst(Int, "82"),
+		"IFT_DS1FDL":	MakeUntypedConst(Int, "170"),
+		"IFT_DS3":	MakeUntypedConst(Int, "30"),
+		"IFT_DTM":	MakeUntypedConst(Int, "140"),
+		"IFT_DVBASILN":	MakeUntypedConst(Int, "172"),
+		"IFT_DVBASIOUT":	MakeUntypedConst(Int, "173"),
+		"IFT_DVBRCCDOWNSTREAM":	MakeUntypedConst(Int, "147"),
+		"IFT_DVBRCCMACLAYER":	MakeUntypedConst(Int, "146"),
+		"IFT_DVBRCCUPSTREAM":	MakeUntypedConst(Int, "148"),
+		"IFT_ENC":	MakeUntypedConst(Int, "244"),
+		"IFT_EON":	MakeUntypedConst(Int, "25"),
+		"IFT_EPLRS":	MakeUntypedConst(Int, "87"),
+		"IFT_ESCON":	MakeUntypedConst(Int, "73"),
+		"IFT_ETHER":	MakeUntypedConst(Int, "6"),
+		"IFT_FAITH":	MakeUntypedConst(Int, "242"),
+		"IFT_FAST":	MakeUntypedConst(Int, "125"),
+		"IFT_FASTETHER":	MakeUntypedConst(Int, "62"),
+		"IFT_FASTETHERFX":	MakeUntypedConst(Int, "69"),
+		"IFT_FDDI":	MakeUntypedConst(Int, "15"),
+		"IFT_FIBRECHANNEL":	MakeUntypedConst(Int, "56"),
+		"IFT_FRAMERELAYINTERCONNECT":	MakeUntypedConst(Int, "58"),
+		"IFT_FRAMERELAYMPI":	MakeUntypedConst(Int, "92"),
+		"IFT_FRDLCIENDPT":	MakeUntypedConst(Int, "193"),
+		"IFT_FRELAY":	MakeUntypedConst(Int, "32"),
+		"IFT_FRELAYDCE":	MakeUntypedConst(Int, "44"),
+		"IFT_FRF16MFRBUNDLE":	MakeUntypedConst(Int, "163"),
+		"IFT_FRFORWARD":	MakeUntypedConst(Int, "158"),
+		"IFT_G703AT2MB":	MakeUntypedConst(Int, "67"),
+		"IFT_G703AT64K":	MakeUntypedConst(Int, "66"),
+		"IFT_GIF":	MakeUntypedConst(Int, "240"),
+		"IFT_GIGABITETHERNET":	MakeUntypedConst(Int, "117"),
+		"IFT_GR303IDT":	MakeUntypedConst(Int, "178"),
+		"IFT_GR303RDT":	MakeUntypedConst(Int, "177"),
+		"IFT_H323GATEKEEPER":	MakeUntypedConst(Int, "164"),
+		"IFT_H323PROXY":	MakeUntypedConst(Int, "165"),
+		"IFT_HDH1822":	MakeUntypedConst(Int, "3"),
+		"IFT_HDLC":	MakeUntypedConst(Int, "118"),
+		"IFT_HDSL2":	MakeUntypedConst(Int, "168"),
+		"IFT_HIPERLAN2":	MakeUntypedConst(Int, "183"),
+		"IFT_HIPPI":	MakeUntypedConst(Int, "47"),
+		"IFT_HIPPIINTERFACE":	MakeUntypedConst(Int, "57"),
+		"IFT_HOSTPAD":	MakeUntypedConst(Int, "90"),
+		"IFT_HSSI":	MakeUntypedConst(Int, "46"),
+		"IFT_HY":	MakeUntypedConst(Int, "14"),
+		"IFT_IBM370PARCHAN":	MakeUntypedConst(Int, "72"),
+		"IFT_IDSL":	MakeUntypedConst(Int, "154"),
+		"IFT_IEEE1394":	MakeUntypedConst(Int, "144"),
+		"IFT_IEEE80211":	MakeUntypedConst(Int, "71"),
+		"IFT_IEEE80212":	MakeUntypedConst(Int, "55"),
+		"IFT_IEEE8023ADLAG":	MakeUntypedConst(Int, "161"),
+		"IFT_IFGSN":	MakeUntypedConst(Int, "145"),
+		"IFT_IMT":	MakeUntypedConst(Int, "190"),
+		"IFT_INFINIBAND":	MakeUntypedConst(Int, "199"),
+		"IFT_INTERLEAVE":	MakeUntypedConst(Int, "124"),
+		"IFT_IP":	MakeUntypedConst(Int, "126"),
+		"IFT_IPFORWARD":	MakeUntypedConst(Int, "142"),
+		"IFT_IPOVERATM":	MakeUntypedConst(Int, "114"),
+		"IFT_IPOVERCDLC":	MakeUntypedConst(Int, "109"),
+		"IFT_IPOVERCLAW":	MakeUntypedConst(Int, "110"),
+		"IFT_IPSWITCH":	MakeUntypedConst(Int, "78"),
+		"IFT_IPXIP":	MakeUntypedConst(Int, "249"),
+		"IFT_ISDN":	MakeUntypedConst(Int, "63"),
+		"IFT_ISDNBASIC":	MakeUntypedConst(Int, "20"),
+		"IFT_ISDNPRIMARY":	MakeUntypedConst(Int, "21"),
+		"IFT_ISDNS":	MakeUntypedConst(Int, "75"),
+		"IFT_ISDNU":	MakeUntypedConst(Int, "76"),
+		"IFT_ISO88022LLC":	MakeUntypedConst(Int, "41"),
+		"IFT_ISO88023":	MakeUntypedConst(Int, "7"),
+		"IFT_ISO88024":	MakeUntypedConst(Int, "8"),
+		"IFT_ISO88025":	MakeUntypedConst(Int, "9"),
+		"IFT_ISO88025CRFPINT":	MakeUntypedConst(Int, "98"),
+		"IFT_ISO88025DTR":	MakeUntypedConst(Int, "86"),
+		"IFT_ISO88025FIBER":	MakeUntypedConst(Int, "115"),
+		"IFT_ISO88026":	MakeUntypedConst(Int, "10"),
+		"IFT_ISUP":	MakeUntypedConst(Int, "179"),
+		"IFT_L2VLAN":	MakeUntypedConst(Int, "135"),
+		"IFT_L3IPVLAN":	MakeUntypedConst(Int, "136"),
+		"IFT_L3IPXVLAN":	MakeUntypedConst(Int, "137"),
+		"IFT_LAPB":	MakeUntypedConst(Int, "16"),
+		"IFT_LAPD":	MakeUntypedConst(Int, "77"),
+		"IFT_LAPF":	MakeUntypedConst(Int, "119"),
+		"IFT_LOCALTALK":	MakeUntypedConst(Int, "42"),
+		"IFT_LOOP":	MakeUntypedConst(Int, "24"),
+		"IFT_MEDIAMAILOVERIP":	MakeUntypedConst(Int, "139"),
+		"IFT_MFSIGLINK":	MakeUntypedConst(Int, "167"),
+		"IFT_MIOX25":	MakeUntypedConst(Int, "38"),
+		"IFT_MODEM":	MakeUntypedConst(Int, "48"),
+		"IFT_MPC":	MakeUntypedConst(Int, "113"),
+		"IFT_MPLS":	MakeUntypedConst(Int, "166"),
+		"IFT_MPLSTUNNEL":	MakeUntypedConst(Int, "150"),
+		"IFT_MSDSL":	MakeUntypedConst(Int, "143"),
+		"IFT_MVL":	MakeUntypedConst(Int, "191"),
+		"IFT_MYRINET":	MakeUntypedConst(Int, "99"),
+		"IFT_NFAS":	MakeUntypedConst(Int, "175"),
+		"IFT_NSIP":	MakeUntypedConst(Int, "27"),
+		"IFT_OPTICALCHANNEL":	MakeUntypedConst(Int, "195"),
+		"IFT_OPTICALTRANSPORT":	MakeUntypedConst(Int, "196"),
+		"IFT_OTHER":	MakeUntypedConst(Int, "1"),
+		"IFT_P10":	MakeUntypedConst(Int, "12"),
+		"IFT_P80":	MakeUntypedConst(Int, "13"),
+		"IFT_PARA":	MakeUntypedConst(Int, "34"),
+		"IFT_PFLOG":	MakeUntypedConst(Int, "246"),
+		"IFT_PFSYNC":	MakeUntypedConst(Int, "247"),
+		"IFT_PLC":	MakeUntypedConst(Int, "174"),
+		"IFT_POS":	MakeUntypedConst(Int, "171"),
+		"IFT_PPP":	MakeUntypedConst(Int, "23"),
+		"IFT_PPPMULTILINKBUNDLE":	MakeUntypedConst(Int, "108"),
+		"IFT_PROPBWAP2MP":	MakeUntypedConst(Int, "184"),
+		"IFT_PROPCNLS":	MakeUntypedConst(Int, "89"),
+		"IFT_PROPDOCSWIRELESSDOWNSTREAM":	MakeUntypedConst(Int, "181"),
+		"IFT_PROPDOCSWIRELESSMACLAYER":	MakeUntypedConst(Int, "180"),
+		"IFT_PROPDOCSWIRELESSUPSTREAM":	MakeUntypedConst(Int, "182"),
+		"IFT_PROPMUX":	MakeUntypedConst(Int, "54"),
+		"IFT_PROPVIRTUAL":	MakeUntypedConst(Int, "53"),
+		"IFT_PROPWIRELESSP2P":	MakeUntypedConst(Int, "157"),
+		"IFT_PTPSERIAL":	MakeUntypedConst(Int, "22"),
+		"IFT_PVC":	MakeUntypedConst(Int, "241"),
+		"IFT_QLLC":	MakeUntypedConst(Int, "68"),
+		"IFT_RADIOMAC":	MakeUntypedConst(Int, "188"),
+		"IFT_RADSL":	MakeUntypedConst(Int, "95"),
+		"IFT_REACHDSL":	MakeUntypedConst(Int, "192"),
+		"IFT_RFC1483":	MakeUntypedConst(Int, "159"),
+		"IFT_RS232":	MakeUntypedConst(Int, "33"),
+		"IFT_RSRB":	MakeUntypedConst(Int, "79"),
+		"IFT_SDLC":	MakeUntypedConst(Int, "17"),
+		"IFT_SDSL":	MakeUntypedConst(Int, "96"),
+		"IFT_SHDSL":	MakeUntypedConst(Int, "169"),
+		"IFT_SIP":	MakeUntypedConst(Int, "31"),
+		"IFT_SLIP":	MakeUntypedConst(Int, "28"),
+		"IFT_SMDSDXI":	MakeUntypedConst(Int, "43"),
+		"IFT_SMDSICIP":	MakeUntypedConst(Int, "52"),
+		"IFT_SONET":	MakeUntypedConst(Int, "39"),
+		"IFT_SONETOVERHEADCHANNEL":	MakeUntypedConst(Int, "185"),
+		"IFT_SONETPATH":	MakeUntypedConst(Int, "50"),
+		"IFT_SONETVT":	MakeUntypedConst(Int, "51"),
+		"IFT_SRP":	MakeUntypedConst(Int, "151"),
+		"IFT_SS7SIGLINK":	MakeUntypedConst(Int, "156"),
+		"IFT_STACKTOSTACK":	MakeUntypedConst(Int, "111"),
+		"IFT_STARLAN":	MakeUntypedConst(Int, "11"),
+		"IFT_STF":	MakeUntypedConst(Int, "215"),
+		"IFT_T1":	MakeUntypedConst(Int, "18"),
+		"IFT_TDLC":	MakeUntypedConst(Int, "116"),
+		"IFT_TERMPAD":	MakeUntypedConst(Int, "91"),
+		"IFT_TR008":	MakeUntypedConst(Int, "176"),
+		"IFT_TRANSPHDLC":	MakeUntypedConst(Int, "123"),
+		"IFT_TUNNEL":	MakeUntypedConst(Int, "131"),
+		"IFT_ULTRA":	MakeUntypedConst(Int, "29"),
+		"IFT_USB":	MakeUntypedConst(Int, "160"),
+		"IFT_V11":	MakeUntypedConst(Int, "64"),
+		"IFT_V35":	MakeUntypedConst(Int, "45"),
+		"IFT_V36":	MakeUntypedConst(Int, "65"),
+		"IFT_V37":	MakeUntypedConst(Int, "120"),
+		"IFT_VDSL":	MakeUntypedConst(Int, "97"),
+		"IFT_VIRTUALIPADDRESS":	MakeUntypedConst(Int, "112"),
+		"IFT_VOICEEM":	MakeUntypedConst(Int, "100"),
+		"IFT_VOICEENCAP":	MakeUntypedConst(Int, "103"),
+		"IFT_VOICEFXO":	MakeUntypedConst(Int, "101"),
+		"IFT_VOICEFXS":	MakeUntypedConst(Int, "102"),
+		"IFT_VOICEOVERATM":	MakeUntypedConst(Int, "152"),
+		"IFT_VOICEOVERFRAMERELAY":	MakeUntypedConst(Int, "153"),
+		"IFT_VOICEOVERIP":	MakeUntypedConst(Int, "104"),
+		"IFT_X213":	MakeUntypedConst(Int, "93"),
+		"IFT_X25":	MakeUntypedConst(Int, "5"),
+		"IFT_X25DDN":	MakeUntypedConst(Int, "4"),
+		"IFT_X25HUNTGROUP":	MakeUntypedConst(Int, "122"),
+		"IFT_X25MLP":	MakeUntypedConst(Int, "121"),
+		"IFT_X25PLE":	MakeUntypedConst(Int, "40"),
+		"IFT_XETHER":	MakeUntypedConst(Int, "26"),
+		"IGNBRK":	MakeUntypedConst(Int, "1"),
+		"IGNCR":	MakeUntypedConst(Int, "128"),
+		"IGNPAR":	MakeUntypedConst(Int, "4"),
+		"IMAXBEL":	MakeUntypedConst(Int, "8192"),
+		"INLCR":	MakeUntypedConst(Int, "64"),
+		"INPCK":	MakeUntypedConst(Int, "16"),
+		"IN_CLASSA_HOST":	MakeUntypedConst(Int, "16777215"),
+		"IN_CLASSA_MAX":	MakeUntypedConst(Int, "128"),
+		"IN_CLASSA_NET":	MakeUntypedConst(Int, "4278190080"),
+		"IN_CLASSA_NSHIFT":	MakeUntypedConst(Int, "24"),
+		"IN_CLASSB_HOST":	MakeUntypedConst(Int, "65535"),
+		"IN_CLASSB_MAX":	MakeUntypedConst(Int, "65536"),
+		"IN_CLASSB_NET":	MakeUntypedConst(Int, "4294901760"),
+		"IN_CLASSB_NSHIFT":	MakeUntypedConst(Int, "16"),
+		"IN_CLASSC_HOST":	MakeUntypedConst(Int, "255"),
+		"IN_CLASSC_NET":	MakeUntypedConst(Int, "4294967040"),
+		"IN_CLASSC_NSHIFT":	MakeUntypedConst(Int, "8"),
+		"IN_CLASSD_HOST":	MakeUntypedConst(Int, "268435455"),
+		"IN_CLASSD_NET":	MakeUntypedConst(Int, "4026531840"),
+		"IN_CLASSD_NSHIFT":	MakeUntypedConst(Int, "28"),
+		"IN_LOOPBACKNET":	MakeUntypedConst(Int, "127"),
+		"IN_RFC3021_MASK":	MakeUntypedConst(Int, "4294967294"),
+		"IPPROTO_3PC":	MakeUntypedConst(Int, "34"),
+		"IPPROTO_ADFS":	MakeUntypedConst(Int, "68"),
+		"IPPROTO_AH":	MakeUntypedConst(Int, "51"),
+		"IPPROTO_AHIP":	MakeUntypedConst(Int, "61"),
+		"IPPROTO_APES":	MakeUntypedConst(Int, "99"),
+		"IPPROTO_ARGUS":	MakeUntypedConst(Int, "13"),
+		"IPPROTO_AX25":	MakeUntypedConst(Int, "93"),
+		"IPPROTO_BHA":	MakeUntypedConst(Int, "49"),
+		"IPPROTO_BLT":	MakeUntypedConst(Int, "30"),
+		"IPPROTO_BRSATMON":	MakeUntypedConst(Int, "76"),
+		"IPPROTO_CARP":	MakeUntypedConst(Int, "112"),
+		"IPPROTO_CFTP":	MakeUntypedConst(Int, "62"),
+		"IPPROTO_CHAOS":	MakeUntypedConst(Int, "16"),
+		"IPPROTO_CMTP":	MakeUntypedConst(Int, "38"),
+		"IPPROTO_CPHB":	MakeUntypedConst(Int, "73"),
+		"IPPROTO_CPNX":	MakeUntypedConst(Int, "72"),
+		"IPPROTO_DDP":	MakeUntypedConst(Int, "37"),
+		"IPPROTO_DGP":	MakeUntypedConst(Int, "86"),
+		"IPPROTO_DIVERT":	MakeUntypedConst(Int, "258"),
+		"IPPROTO_DONE":	MakeUntypedConst(Int, "257"),
+		"IPPROTO_DSTOPTS":	MakeUntypedConst(Int, "60"),
+		"IPPROTO_EGP":	MakeUntypedConst(Int, "8"),
+		"IPPROTO_EMCON":	MakeUntypedConst(Int, "14"),
+		"IPPROTO_ENCAP":	MakeUntypedConst(Int, "98"),
+		"IPPROTO_EON":	MakeUntypedConst(Int, "80"),
+		"IPPROTO_ESP":	MakeUntypedConst(Int, "50"),
+		"IPPROTO_ETHERIP":	MakeUntypedConst(Int, "97"),
+		"IPPROTO_FRAGMENT":	MakeUntypedConst(Int, "44"),
+		"IPPROTO_GGP":	MakeUntypedConst(Int, "3"),
+		"IPPROTO_GMTP":	MakeUntypedConst(Int, "100"),
+		"IPPROTO_GRE":	MakeUntypedConst(Int, "47"),
+		"IPPROTO_HELLO":	MakeUntypedConst(Int, "63"),
+		"IPPROTO_HMP":	MakeUntypedConst(Int, "20"),
+		"IPPROTO_HOPOPTS":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_ICMP":	MakeUntypedConst(Int, "1"),
+		"IPPROTO_ICMPV6":	MakeUntypedConst(Int, "58"),
+		"IPPROTO_IDP":	MakeUntypedConst(Int, "22"),
+		"IPPROTO_IDPR":	MakeUntypedConst(Int, "35"),
+		"IPPROTO_IDRP":	MakeUntypedConst(Int, "45"),
+		"IPPROTO_IGMP":	MakeUntypedConst(Int, "2"),
+		"IPPROTO_IGP":	MakeUntypedConst(Int, "85"),
+		"IPPROTO_IGRP":	MakeUntypedConst(Int, "88"),
+		"IPPROTO_IL":	MakeUntypedConst(Int, "40"),
+		"IPPROTO_INLSP":	MakeUntypedConst(Int, "52"),
+		"IPPROTO_INP":	MakeUntypedConst(Int, "32"),
+		"IPPROTO_IP":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_IPCOMP":	MakeUntypedConst(Int, "108"),
+		"IPPROTO_IPCV":	MakeUntypedConst(Int, "71"),
+		"IPPROTO_IPEIP":	MakeUntypedConst(Int, "94"),
+		"IPPROTO_IPIP":	MakeUntypedConst(Int, "4"),
+		"IPPROTO_IPPC":	MakeUntypedConst(Int, "67"),
+		"IPPROTO_IPV4":	MakeUntypedConst(Int, "4"),
+		"IPPROTO_IPV6":	MakeUntypedConst(Int, "41"),
+		"IPPROTO_IRTP":	MakeUntypedConst(Int, "28"),
+		"IPPROTO_KRYPTOLAN":	MakeUntypedConst(Int, "65"),
+		"IPPROTO_LARP":	MakeUntypedConst(Int, "91"),
+		"IPPROTO_LEAF1":	MakeUntypedConst(Int, "25"),
+		"IPPROTO_LEAF2":	MakeUntypedConst(Int, "26"),
+		"IPPROTO_MAX":	MakeUntypedConst(Int, "256"),
+		"IPPROTO_MAXID":	MakeUntypedConst(Int, "52"),
+		"IPPROTO_MEAS":	MakeUntypedConst(Int, "19"),
+		"IPPROTO_MH":	MakeUntypedConst(Int, "135"),
+		"IPPROTO_MHRP":	MakeUntypedConst(Int, "48"),
+		"IPPROTO_MICP":	MakeUntypedConst(Int, "95"),
+		"IPPROTO_MOBILE":	MakeUntypedConst(Int, "55"),
+		"IPPROTO_MPLS":	MakeUntypedConst(Int, "137"),
+		"IPPROTO_MTP":	MakeUntypedConst(Int, "92"),
+		"IPPROTO_MUX":	MakeUntypedConst(Int, "18"),
+		"IPPROTO_ND":	MakeUntypedConst(Int, "77"),
+		"IPPROTO_NHRP":	MakeUntypedConst(Int, "54"),
+		"IPPROTO_NONE":	MakeUntypedConst(Int, "59"),
+		"IPPROTO_NSP":	MakeUntypedConst(Int, "31"),
+		"IPPROTO_NVPII":	MakeUntypedConst(Int, "11"),
+		"IPPROTO_OLD_DIVERT":	MakeUntypedConst(Int, "254"),
+		"IPPROTO_OSPFIGP":	MakeUntypedConst(Int, "89"),
+		"IPPROTO_PFSYNC":	MakeUntypedConst(Int, "240"),
+		"IPPROTO_PGM":	MakeUntypedConst(Int, "113"),
+		"IPPROTO_PIGP":	MakeUntypedConst(Int, "9"),
+		"IPPROTO_PIM":	MakeUntypedConst(Int, "103"),
+		"IPPROTO_PRM":	MakeUntypedConst(Int, "21"),
+		"IPPROTO_PUP":	MakeUntypedConst(Int, "12"),
+		"IPPROTO_PVP":	MakeUntypedConst(Int, "75"),
+		"IPPROTO_RAW":	MakeUntypedConst(Int, "255"),
+		"IPPROTO_RCCMON":	MakeUntypedConst(Int, "10"),
+		"IPPROTO_RDP":	MakeUntypedConst(Int, "27"),
+		"IPPROTO_ROUTING":	MakeUntypedConst(Int, "43"),
+		"IPPROTO_RSVP":	MakeUntypedConst(Int, "46"),
+		"IPPROTO_RVD":	MakeUntypedConst(Int, "66"),
+		"IPPROTO_SATEXPAK":	MakeUntypedConst(Int, "64"),
+		"IPPROTO_SATMON":	MakeUntypedConst(Int, "69"),
+		"IPPROTO_SCCSP":	MakeUntypedConst(Int, "96"),
+		"IPPROTO_SCTP":	MakeUntypedConst(Int, "132"),
+		"IPPROTO_SDRP":	MakeUntypedConst(Int, "42"),
+		"IPPROTO_SEND":	MakeUntypedConst(Int, "259"),
+		"IPPROTO_SEP":	MakeUntypedConst(Int, "33"),
+		"IPPROTO_SKIP":	MakeUntypedConst(Int, "57"),
+		"IPPROTO_SPACER":	MakeUntypedConst(Int, "32767"),
+		"IPPROTO_SRPC":	MakeUntypedConst(Int, "90"),
+		"IPPROTO_ST":	MakeUntypedConst(Int, "7"),
+		"IPPROTO_SVMTP":	MakeUntypedConst(Int, "82"),
+		"IPPROTO_SWIPE":	MakeUntypedConst(Int, "53"),
+		"IPPROTO_TCF":	MakeUntypedConst(Int, "87"),
+		"IPPROTO_TCP":	MakeUntypedConst(Int, "6"),
+		"IPPROTO_TLSP":	MakeUntypedConst(Int, "56"),
+		"IPPROTO_TP":	MakeUntypedConst(Int, "29"),
+		"IPPROTO_TPXX":	MakeUntypedConst(Int, "39"),
+		"IPPROTO_TRUNK1":	MakeUntypedConst(Int, "23"),
+		"IPPROTO_TRUNK2":	MakeUntypedConst(Int, "24"),
+		"IPPROTO_TTP":	MakeUntypedConst(Int, "84"),
+		"IPPROTO_UDP":	MakeUntypedConst(Int, "17"),
+		"IPPROTO_VINES":	MakeUntypedConst(Int, "83"),
+		"IPPROTO_VISA":	MakeUntypedConst(Int, "70"),
+		"IPPROTO_VMTP":	MakeUntypedConst(Int, "81"),
+		"IPPROTO_WBEXPAK":	MakeUntypedConst(Int, "79"),
+		"IPPROTO_WBMON":	MakeUntypedConst(Int, "78"),
+		"IPPROTO_WSN":	MakeUntypedConst(Int, "74"),
+		"IPPROTO_XNET":	MakeUntypedConst(Int, "15"),
+		"IPPROTO_XTP":	MakeUntypedConst(Int, "36"),
+		"IPV6_AUTOFLOWLABEL":	MakeUntypedConst(Int, "59"),
+		"IPV6_BINDANY":	MakeUntypedConst(Int, "64"),
+		"IPV6_BINDV6ONLY":	MakeUntypedConst(Int, "27"),
+		"IPV6_CHECKSUM":	MakeUntypedConst(Int, "26"),
+		"IPV6_DEFAULT_MULTICAST_HOPS":	MakeUntypedConst(Int, "1"),
+		"IPV6_DEFAULT_MULTICAST_LOOP":	MakeUntypedConst(Int, "1"),
+		"IPV6_DEFHLIM":	MakeUntypedConst(Int, "64"),
+		"IPV6_DONTFRAG":	MakeUntypedConst(Int, "62"),
+		"IPV6_DSTOPTS":	MakeUntypedConst(Int, "50"),
+		"IPV6_FAITH":	MakeUntypedConst(Int, "29"),
+		"IPV6_FLOWINFO_MASK":	MakeUntypedConst(Int, "4294967055"),
+		"IPV6_FLOWLABEL_MASK":	MakeUntypedConst(Int, "4294905600"),
+		"IPV6_FRAGTTL":	MakeUntypedConst(Int, "120"),
+		"IPV6_FW_ADD":	MakeUntypedConst(Int, "30"),
+		"IPV6_FW_DEL":	MakeUntypedConst(Int, "31"),
+		"IPV6_FW_FLUSH":	MakeUntypedConst(Int, "32"),
+		"IPV6_FW_GET":	MakeUntypedConst(Int, "34"),
+		"IPV6_FW_ZERO":	MakeUntypedConst(Int, "33"),
+		"IPV6_HLIMDEC":	MakeUntypedConst(Int, "1"),
+		"IPV6_HOPLIMIT":	MakeUntypedConst(Int, "47"),
+		"IPV6_HOPOPTS":	MakeUntypedConst(Int, "49"),
+		"IPV6_IPSEC_POLICY":	MakeUntypedConst(Int, "28"),
+		"IPV6_JOIN_GROUP":	MakeUntypedConst(Int, "12"),
+		"IPV6_LEAVE_GROUP":	MakeUntypedConst(Int, "13"),
+		"IPV6_MAXHLIM":	MakeUntypedConst(Int, "255"),
+		"IPV6_MAXOPTHDR":	MakeUntypedConst(Int, "2048"),
+		"IPV6_MAXPACKET":	MakeUntypedConst(Int, "65535"),
+		"IPV6_MAX_GROUP_SRC_FILTER":	MakeUntypedConst(Int, "512"),
+		"IPV6_MAX_MEMBERSHIPS":	MakeUntypedConst(Int, "4095"),
+		"IPV6_MAX_SOCK_SRC_FILTER":	MakeUntypedConst(Int, "128"),
+		"IPV6_MIN_MEMBERSHIPS":	MakeUntypedConst(Int, "31"),
+		"IPV6_MMTU":	MakeUntypedConst(Int, "1280"),
+		"IPV6_MSFILTER":	MakeUntypedConst(Int, "74"),
+		"IPV6_MULTICAST_HOPS":	MakeUntypedConst(Int, "10"),
+		"IPV6_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IPV6_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IPV6_NEXTHOP":	MakeUntypedConst(Int, "48"),
+		"IPV6_PATHMTU":	MakeUntypedConst(Int, "44"),
+		"IPV6_PKTINFO":	MakeUntypedConst(Int, "46"),
+		"IPV6_PORTRANGE":	MakeUntypedConst(Int, "14"),
+		"IPV6_PORTRANGE_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"IPV6_PORTRANGE_HIGH":	MakeUntypedConst(Int, "1"),
+		"IPV6_PORTRANGE_LOW":	MakeUntypedConst(Int, "2"),
+		"IPV6_PREFER_TEMPADDR":	MakeUntypedConst(Int, "63"),
+		"IPV6_RECVDSTOPTS":	MakeUntypedConst(Int, "40"),
+		"IPV6_RECVHOPLIMIT":	MakeUntypedConst(Int, "37"),
+		"IPV6_RECVHOPOPTS":	MakeUntypedConst(Int, "39"),
+		"IPV6_RECVPATHMTU":	MakeUntypedConst(Int, "43"),
+		"IPV6_RECVPKTINFO":	MakeUntypedConst(Int, "36"),
+		"IPV6_RECVRTHDR":	MakeUntypedConst(Int, "38"),
+		"IPV6_RECVTCLASS":	MakeUntypedConst(Int, "57"),
+		"IPV6_RTHDR":	MakeUntypedConst(Int, "51"),
+		"IPV6_RTHDRDSTOPTS":	MakeUntypedConst(Int, "35"),
+		"IPV6_RTHDR_LOOSE":	MakeUntypedConst(Int, "0"),
+		"IPV6_RTHDR_STRICT":	MakeUntypedConst(Int, "1"),
+		"IPV6_RTHDR_TYPE_0":	MakeUntypedConst(Int, "0"),
+		"IPV6_SOCKOPT_RESERVED1":	MakeUntypedConst(Int, "3"),
+		"IPV6_TCLASS":	MakeUntypedConst(Int, "61"),
+		"IPV6_UNICAST_HOPS":	MakeUntypedConst(Int, "4"),
+		"IPV6_USE_MIN_MTU":	MakeUntypedConst(Int, "42"),
+		"IPV6_V6ONLY":	MakeUntypedConst(Int, "27"),
+		"IPV6_VERSION":	MakeUntypedConst(Int, "96"),
+		"IPV6_VERSION_MASK":	MakeUntypedConst(Int, "240"),
+		"IP_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "12"),
+		"IP_ADD_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "70"),
+		"IP_BINDANY":	MakeUntypedConst(Int, "24"),
+		"IP_BLOCK_SOURCE":	MakeUntypedConst(Int, "72"),
+		"IP_DEFAULT_MULTICAST_LOOP":	MakeUntypedConst(Int, "1"),
+		"IP_DEFAULT_MULTICAST_TTL":	MakeUntypedConst(Int, "1"),
+		"IP_DF":	MakeUntypedConst(Int, "16384"),
+		"IP_DONTFRAG":	MakeUntypedConst(Int, "67"),
+		"IP_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "13"),
+		"IP_DROP_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "71"),
+		"IP_DUMMYNET3":	MakeUntypedConst(Int, "49"),
+		"IP_DUMMYNET_CONFIGURE":	MakeUntypedConst(Int, "60"),
+		"IP_DUMMYNET_DEL":	MakeUntypedConst(Int, "61"),
+		"IP_DUMMYNET_FLUSH":	MakeUntypedConst(Int, "62"),
+		"IP_DUMMYNET_GET":	MakeUntypedConst(Int, "64"),
+		"IP_FAITH":	MakeUntypedConst(Int, "22"),
+		"IP_FW3":	MakeUntypedConst(Int, "48"),
+		"IP_FW_ADD":	MakeUntypedConst(Int, "50"),
+		"IP_FW_DEL":	MakeUntypedConst(Int, "51"),
+		"IP_FW_FLUSH":	MakeUntypedConst(Int, "52"),
+		"IP_FW_GET":	MakeUntypedConst(Int, "54"),
+		"IP_FW_NAT_CFG":	MakeUntypedConst(Int, "56"),
+		"IP_FW_NAT_DEL":	MakeUntypedConst(Int, "57"),
+		"IP_FW_NAT_GET_CONFIG":	MakeUntypedConst(Int, "58"),
+		"IP_FW_NAT_GET_LOG":	MakeUntypedConst(Int, "59"),
+		"IP_FW_RESETLOG":	MakeUntypedConst(Int, "55"),
+		"IP_FW_TABLE_ADD":	MakeUntypedConst(Int, "40"),
+		"IP_FW_TABLE_DEL":	MakeUntypedConst(Int, "41"),
+		"IP_FW_TABLE_FLUSH":	MakeUntypedConst(Int, "42"),
+		"IP_FW_TABLE_GETSIZE":	MakeUntypedConst(Int, "43"),
+		"IP_FW_TABLE_LIST":	MakeUntypedConst(Int, "44"),
+		"IP_FW_ZERO":	MakeUntypedConst(Int, "53"),
+		"IP_HDRINCL":	MakeUntypedConst(Int, "2"),
+		"IP_IPSEC_POLICY":	MakeUntypedConst(Int, "21"),
+		"IP_MAXPACKET":	MakeUntypedConst(Int, "65535"),
+		"IP_MAX_GROUP_SRC_FILTER":	MakeUntypedConst(Int, "512"),
+		"IP_MAX_MEMBERSHIPS":	MakeUntypedConst(Int, "4095"),
+		"IP_MAX_SOCK_MUTE_FILTER":	MakeUntypedConst(Int, "128"),
+		"IP_MAX_SOCK_SRC_FILTER":	MakeUntypedConst(Int, "128"),
+		"IP_MAX_SOURCE_FILTER":	MakeUntypedConst(Int, "1024"),
+		"IP_MF":	MakeUntypedConst(Int, "8192"),
+		"IP_MINTTL":	MakeUntypedConst(Int, "66"),
+		"IP_MIN_MEMBERSHIPS":	MakeUntypedConst(Int, "31"),
+		"IP_MSFILTER":	MakeUntypedConst(Int, "74"),
+		"IP_MSS":	MakeUntypedConst(Int, "576"),
+		"IP_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IP_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IP_MULTICAST_TTL":	MakeUntypedConst(Int, "10"),
+		"IP_MULTICAST_VIF":	MakeUntypedConst(Int, "14"),
+		"IP_OFFMASK":	MakeUntypedConst(Int, "8191"),
+		"IP_ONESBCAST":	MakeUntypedConst(Int, "23"),
+		"IP_OPTIONS":	MakeUntypedConst(Int, "1"),
+		"IP_PORTRANGE":	MakeUntypedConst(Int, "19"),
+		"IP_PORTRANGE_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"IP_PORTRANGE_HIGH":	MakeUntypedConst(Int, "1"),
+		"IP_PORTRANGE_LOW":	MakeUntypedConst(Int, "2"),
+		"IP_RECVDSTADDR":	MakeUntypedConst(Int, "7"),
+		"IP_RECVIF":	MakeUntypedConst(Int, "20"),
+		"IP_RECVOPTS":	MakeUntypedConst(Int, "5"),
+		"IP_RECVRETOPTS":	MakeUntypedConst(Int, "6"),
+		"IP_RECVTOS":	MakeUntypedConst(Int, "68"),
+		"IP_RECVTTL":	MakeUntypedConst(Int, "65"),
+		"IP_RETOPTS":	MakeUntypedConst(Int, "8"),
+		"IP_RF":	MakeUntypedConst(Int, "32768"),
+		"IP_RSVP_OFF":	MakeUntypedConst(Int, "16"),
+		"IP_RSVP_ON":	MakeUntypedConst(Int, "15"),
+		"IP_RSVP_VIF_OFF":	MakeUntypedConst(Int, "18"),
+		"IP_RSVP_VIF_ON":	MakeUntypedConst(Int, "17"),
+		"IP_SENDSRCADDR":	MakeUntypedConst(Int, "7"),
+		"IP_TOS":	MakeUntypedConst(Int, "3"),
+		"IP_TTL":	MakeUntypedConst(Int, "4"),
+		"IP_UNBLOCK_SOURCE":	MakeUntypedConst(Int, "73"),
+		"ISIG":	MakeUntypedConst(Int, "128"),
+		"ISTRIP":	MakeUntypedConst(Int, "32"),
+		"IXANY":	MakeUntypedConst(Int, "2048"),
+		"IXOFF":	MakeUntypedConst(Int, "1024"),
+		"IXON":	MakeUntypedConst(Int, "512"),
+		"ImplementsGetwd":	MakeUntypedConst(Bool, "true"),
+		"LOCK_EX":	MakeUntypedConst(Int, "2"),
+		"LOCK_NB":	MakeUntypedConst(Int, "4"),
+		"LOCK_SH":	MakeUntypedConst(Int, "1"),
+		"LOCK_UN":	MakeUntypedConst(Int, "8"),
+		"MADV_AUTOSYNC":	MakeUntypedConst(Int, "7"),
+		"MADV_CORE":	MakeUntypedConst(Int, "9"),
+		"MADV_DONTNEED":	MakeUntypedConst(Int, "4"),
+		"MADV_FREE":	MakeUntypedConst(Int, "5"),
+		"MADV_NOCORE":	MakeUntypedConst(Int, "8"),
+		"MADV_NORMAL":	MakeUntypedConst(Int, "0"),
+		"MADV_NOSYNC":	MakeUntypedConst(Int, "6"),
+		"MADV_PROTECT":	MakeUntypedConst(Int, "10"),
+		"MADV_RANDOM":	MakeUntypedConst(Int, "1"),
+		"MADV_SEQUENTIAL":	MakeUntypedConst(Int, "2"),
+		"MADV_WILLNEED":	MakeUntypedConst(Int, "3"),
+		"MAP_ALIGNED_SUPER":	MakeUntypedConst(Int, "16777216"),
+		"MAP_ALIGNMENT_MASK":	MakeUntypedConst(Int, "-16777216"),
+		"MAP_ALIGNMENT_SHIFT":	MakeUntypedConst(Int, "24"),
+		"MAP_ANON":	MakeUntypedConst(Int, "4096"),
+		"MAP_ANONYMOUS":	MakeUntypedConst(Int, "4096"),
+		"MAP_COPY":	MakeUntypedConst(Int, "2"),
+		"MAP_FILE":	MakeUntypedConst(Int, "0"),
+		"MAP_FIXED":	MakeUntypedConst(Int, "16"),
+		"MAP_HASSEMAPHORE":	MakeUntypedConst(Int, "512"),
+		"MAP_NOCORE":	MakeUntypedConst(Int, "131072"),
+		"MAP_NORESERVE":	MakeUntypedConst(Int, "64"),
+		"MAP_NOSYNC":	MakeUntypedConst(Int, "2048"),
+		"MAP_PREFAULT_READ":	MakeUntypedConst(Int, "262144"),
+		"MAP_PRIVATE":	MakeUntypedConst(Int, "2"),
+		"MAP_RENAME":	MakeUntypedConst(Int, "32"),
+		"MAP_RESERVED0080":	MakeUntypedConst(Int, "128"),
+		"MAP_RESERVED0100":	MakeUntypedConst(Int, "256"),
+		"MAP_SHARED":	MakeUntypedConst(Int, "1"),
+		"MAP_STACK":	MakeUntypedConst(Int, "1024"),
+		"MCL_CURRENT":	MakeUntypedConst(Int, "1"),
+		"MCL_FUTURE":	MakeUntypedConst(Int, "2"),
+		"MSG_CMSG_CLOEXEC":	MakeUntypedConst(Int, "262144"),
+		"MSG_COMPAT":	MakeUntypedConst(Int, "32768"),
+		"MSG_CTRUNC":	MakeUntypedConst(Int, "32"),
+		"MSG_DONTROUTE":	MakeUntypedConst(Int, "4"),
+		"MSG_DONTWAIT":	MakeUntypedConst(Int, "128"),
+		"MSG_EOF":	MakeUntypedConst(Int, "256"),
+		"MSG_EOR":	MakeUntypedConst(Int, "8"),
+		"MSG_NBIO":	MakeUntypedConst(Int, "16384"),
+		"MSG_NOSIGNAL":	MakeUntypedConst(Int, "131072"),
+		"MSG_NOTIFICATION":	MakeUntypedConst(Int, "8192"),
+		"MSG_OOB":	MakeUntypedConst(Int, "1"),
+		"MSG_PEEK":	MakeUntypedConst(Int, "2"),
+		"MSG_TRUNC":	MakeUntypedConst(Int, "16"),
+		"MSG_WAITALL":	MakeUntypedConst(Int, "64"),
+		"MS_ASYNC":	MakeUntypedConst(Int, "1"),
+		"MS_INVALIDATE":	MakeUntypedConst(Int, "2"),
+		"MS_SYNC":	MakeUntypedConst(Int, "0"),
+		"NAME_MAX":	MakeUntypedConst(Int, "255"),
+		"NET_RT_DUMP":	MakeUntypedConst(Int, "1"),
+		"NET_RT_FLAGS":	MakeUntypedConst(Int, "2"),
+		"NET_RT_IFLIST":	MakeUntypedConst(Int, "3"),
+		"NET_RT_IFLISTL":	MakeUntypedConst(Int, "5"),
+		"NET_RT_IFMALIST":	MakeUntypedConst(Int, "4"),
+		"NET_RT_MAXID":	MakeUntypedConst(Int, "6"),
+		"NOFLSH":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_ATTRIB":	MakeUntypedConst(Int, "8"),
+		"NOTE_CHILD":	MakeUntypedConst(Int, "4"),
+		"NOTE_DELETE":	MakeUntypedConst(Int, "1"),
+		"NOTE_EXEC":	MakeUntypedConst(Int, "536870912"),
+		"NOTE_EXIT":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_EXTEND":	MakeUntypedConst(Int, "4"),
+		"NOTE_FFAND":	MakeUntypedConst(Int, "1073741824"),
+		"NOTE_FFCOPY":	MakeUntypedConst(Int, "3221225472"),
+		"NOTE_FFCTRLMASK":	MakeUntypedConst(Int, "3221225472"),
+		"NOTE_FFLAGSMASK":	MakeUntypedConst(Int, "16777215"),
+		"NOTE_FFNOP":	MakeUntypedConst(Int, "0"),
+		"NOTE_FFOR":	MakeUntypedConst(Int, "2147483648"),
+		"NOTE_FORK":	MakeUntypedConst(Int, "1073741824"),
+		"NOTE_LINK":	MakeUntypedConst(Int, "16"),
+		"NOTE_LOWAT":	MakeUntypedConst(Int, "1"),
+		"NOTE_PCTRLMASK":	MakeUntypedConst(Int, "4026531840"),
+		"NOTE_PDATAMASK":	MakeUntypedConst(Int, "1048575"),
+		"NOTE_RENAME":	MakeUntypedConst(Int, "32"),
+		"NOTE_REVOKE":	MakeUntypedConst(Int, "64"),
+		"NOTE_TRACK":	MakeUntypedConst(Int, "1"),
+		"NOTE_TRACKERR":	MakeUntypedConst(Int, "2"),
+		"NOTE_TRIGGER":	MakeUntypedConst(Int, "16777216"),
+		"NOTE_WRITE":	MakeUntypedConst(Int, "2"),
+		"OCRNL":	MakeUntypedConst(Int, "16"),
+		"ONLCR":	MakeUntypedConst(Int, "2"),
+		"ONLRET":	MakeUntypedConst(Int, "64"),
+		"ONOCR":	MakeUntypedConst(Int, "32"),
+		"ONOEOT":	MakeUntypedConst(Int, "8"),
+		"OPOST":	MakeUntypedConst(Int, "1"),
+		"O_ACCMODE":	MakeUntypedConst(Int, "3"),
+		"O_APPEND":	MakeUntypedConst(Int, "8"),
+		"O_ASYNC":	MakeUntypedConst(Int, "64"),
+		"O_CLOEXEC":	MakeUntypedConst(Int, "1048576"),
+		"O_CREAT":	MakeUntypedConst(Int, "512"),
+		"O_DIRECT":	MakeUntypedConst(Int, "65536"),
+		"O_DIRECTORY":	MakeUntypedConst(Int, "131072"),
+		"O_EXCL":	MakeUntypedConst(Int, "2048"),
+		"O_EXEC":	MakeUntypedConst(Int, "262144"),
+		"O_EXLOCK":	MakeUntypedConst(Int, "32"),
+		"O_FSYNC":	MakeUntypedConst(Int, "128"),
+		"O_NDELAY":	MakeUntypedConst(Int, "4"),
+		"O_NOCTTY":	MakeUntypedConst(Int, "32768"),
+		"O_NOFOLLOW":	MakeUntypedConst(Int, "256"),
+		"O_NONBLOCK":	MakeUntypedConst(Int, "4"),
+		"O_RDONLY":	MakeUntypedConst(Int, "0"),
+		"O_RDWR":	MakeUntypedConst(Int, "2"),
+		"O_SHLOCK":	MakeUntypedConst(Int, "16"),
+		"O_SYNC":	MakeUntypedConst(Int, "128"),
+		"O_TRUNC":	MakeUntypedConst(Int, "1024"),
+		"O_TTY_INIT":	MakeUntypedConst(Int, "524288"),
+		"O_WRONLY":	MakeUntypedConst(Int, "1"),
+		"PARENB":	MakeUntypedConst(Int, "4096"),
+		"PARMRK":	MakeUntypedConst(Int, "8"),
+		"PARODD":	MakeUntypedConst(Int, "8192"),
+		"PENDIN":	MakeUntypedConst(Int, "536870912"),
+		"PRIO_PGRP":	MakeUntypedConst(Int, "1"),
+		"PRIO_PROCESS":	MakeUntypedConst(Int, "0"),
+		"PRIO_USER":	MakeUntypedConst(Int, "2"),
+		"PROT_EXEC":	MakeUntypedConst(Int, "4"),
+		"PROT_NONE":	MakeUntypedConst(Int, "0"),
+		"PROT_READ":	MakeUntypedConst(Int, "1"),
+		"PROT_WRITE":	MakeUntypedConst(Int, "2"),
+		"PTRACE_CONT":	MakeUntypedConst(Int, "7"),
+		"PTRACE_KILL":	MakeUntypedConst(Int, "8"),
+		"PTRACE_TRACEME":	MakeUntypedConst(Int, "0"),
+		"RLIMIT_AS":	MakeUntypedConst(Int, "10"),
+		"RLIMIT_CORE":	MakeUntypedConst(Int, "4"),
+		"RLIMIT_CPU":	MakeUntypedConst(Int, "0"),
+		"RLIMIT_DATA":	MakeUntypedConst(Int, "2"),
+		"RLIMIT_FSIZE":	MakeUntypedConst(Int, "1"),
+		"RLIMIT_NOFILE":	MakeUntypedConst(Int, "8"),
+		"RLIMIT_STACK":	MakeUntypedConst(Int, "3"),
+		"RLIM_INFINITY":	MakeUntypedConst(Int, "9223372036854775807"),
+		"RTAX_AUTHOR":	MakeUntypedConst(Int, "6"),
+		"RTAX_BRD":	MakeUntypedConst(Int, "7"),
+		"RTAX_DST":	MakeUntypedConst(Int, "0"),
+		"RTAX_GATEWAY":	MakeUntypedConst(Int, "1"),
+		"RTAX_GENMASK":	MakeUntypedConst(Int, "3"),
+		"RTAX_IFA":	MakeUntypedConst(Int, "5"),
+		"RTAX_IFP":	MakeUntypedConst(Int, "4"),
+		"RTAX_MAX":	MakeUntypedConst(Int, "8"),
+		"RTAX_NETMASK":	MakeUntypedConst(Int, "2"),
+		"RTA_AUTHOR":	MakeUntypedConst(Int, "64"),
+		"RTA_BRD":	MakeUntypedConst(Int, "128"),
+		"RTA_DST":	MakeUntypedConst(Int, "1"),
+		"RTA_GATEWAY":	MakeUntypedConst(Int, "2"),
+		"RTA_GENMASK":	MakeUntypedConst(Int, "8"),
+		"RTA_IFA":	MakeUntypedConst(Int, "32"),
+		"RTA_IFP":	MakeUntypedConst(Int, "16"),
+		"RTA_NETMASK":	MakeUntypedConst(Int, "4"),
+		"RTF_BLACKHOLE":	MakeUntypedConst(Int, "4096"),
+		"RTF_BROADCAST":	MakeUntypedConst(Int, "4194304"),
+		"RTF_DONE":	MakeUntypedConst(Int, "64"),
+		"RTF_DYNAMIC":	MakeUntypedConst(Int, "16"),
+		"RTF_FMASK":	MakeUntypedConst(Int, "268752904"),
+		"RTF_GATEWAY":	MakeUntypedConst(Int, "2"),
+		"RTF_GWFLAG_COMPAT":	MakeUntypedConst(Int, "2147483648"),
+		"RTF_HOST":	MakeUntypedConst(Int, "4"),
+		"RTF_LLDATA":	MakeUntypedConst(Int, "1024"),
+		"RTF_LLINFO":	MakeUntypedConst(Int, "1024"),
+		"RTF_LOCAL":	MakeUntypedConst(Int, "2097152"),
+		"RTF_MODIFIED":	MakeUntypedConst(Int, "32"),
+		"RTF_MULTICAST":	MakeUntypedConst(Int, "8388608"),
+		"RTF_PINNED":	MakeUntypedConst(Int, "1048576"),
+		"RTF_PRCLONING":	MakeUntypedConst(Int, "65536"),
+		"RTF_PROTO1":	MakeUntypedConst(Int, "32768"),
+		"RTF_PROTO2":	MakeUntypedConst(Int, "16384"),
+		"RTF_PROTO3":	MakeUntypedConst(Int, "262144"),
+		"RTF_REJECT":	MakeUntypedConst(Int, "8"),
+		"RTF_RNH_LOCKED":	MakeUntypedConst(Int, "1073741824"),
+		"RTF_STATIC":	MakeUntypedConst(Int, "2048"),
+		"RTF_STICKY":	MakeUntypedConst(Int, "268435456"),
+		"RTF_UP":	MakeUntypedConst(Int, "1"),
+		"RTF_XRESOLVE":	MakeUntypedConst(Int, "512"),
+		"RTM_ADD":	MakeUntypedConst(Int, "1"),
+		"RTM_CHANGE":	MakeUntypedConst(Int, "3"),
+		"RTM_DELADDR":	MakeUntypedConst(Int, "13"),
+		"RTM_DELETE":	MakeUntypedConst(Int, "2"),
+		"RTM_DELMADDR":	MakeUntypedConst(Int, "16"),
+		"RTM_GET":	MakeUntypedConst(Int, "4"),
+		"RTM_IEEE80211":	MakeUntypedConst(Int, "18"),
+		"RTM_IFANNOUNCE":	MakeUntypedConst(Int, "17"),
+		"RTM_IFINFO":	MakeUntypedConst(Int, "14"),
+		"RTM_LOCK":	MakeUntypedConst(Int, "8"),
+		"RTM_LOSING":	MakeUntypedConst(Int, "5"),
+		"RTM_MISS":	MakeUntypedConst(Int, "7"),
+		"RTM_NEWADDR":	MakeUntypedConst(Int, "12"),
+		"RTM_NEWMADDR":	MakeUntypedConst(Int, "15"),
+		"RTM_OLDADD":	MakeUntypedConst(Int, "9"),
+		"RTM_OLDDEL":	MakeUntypedConst(Int, "10"),
+		"RTM_REDIRECT":	MakeUntypedConst(Int, "6"),
+		"RTM_RESOLVE":	MakeUntypedConst(Int, "11"),
+		"RTM_RTTUNIT":	MakeUntypedConst(Int, "1000000"),
+		"RTM_VERSION":	MakeUntypedConst(Int, "5"),
+		"RTV_EXPIRE":	MakeUntypedConst(Int, "4"),
+		"RTV_HOPCOUNT":	MakeUntypedConst(Int, "2"),
+		"RTV_MTU":	MakeUntypedConst(Int, "1"),
+		"RTV_RPIPE":	MakeUntypedConst(Int, "8"),
+		"RTV_RTT":	MakeUntypedConst(Int, "64"),
+		"RTV_RTTVAR":	MakeUntypedConst(Int, "128"),
+		"RTV_SPIPE":	MakeUntypedConst(Int, "16"),
+		"RTV_SSTHRESH":	MakeUntypedConst(Int, "32"),
+		"RTV_WEIGHT":	MakeUntypedConst(Int, "256"),
+		"RT_CACHING_CONTEXT":	MakeUntypedConst(Int, "1"),
+		"RT_DEFAULT_FIB":	MakeUntypedConst(Int, "0"),
+		"RT_NORTREF":	MakeUntypedConst(Int, "2"),
+		"RUSAGE_CHILDREN":	MakeUntypedConst(Int, "-1"),
+		"RUSAGE_SELF":	MakeUntypedConst(Int, "0"),
+		"RUSAGE_THREAD":	MakeUntypedConst(Int, "1"),
+		"SCM_BINTIME":	MakeUntypedConst(Int, "4"),
+		"SCM_CREDS":	MakeUntypedConst(Int, "3"),
+		"SCM_RIGHTS":	MakeUntypedConst(Int, "1"),
+		"SCM_TIMESTAMP":	MakeUntypedConst(Int, "2"),
+		"SHUT_RD":	MakeUntypedConst(Int, "0"),
+		"SHUT_RDWR":	MakeUntypedConst(Int, "2"),
+		"SHUT_WR":	MakeUntypedConst(Int, "1"),
+		"SIOCADDMULTI":	MakeUntypedConst(Int, "2149607729"),
+		"SIOCADDRT":	MakeUntypedConst(Int, "2150658570"),
+		"SIOCAIFADDR":	MakeUntypedConst(Int, "2151704858"),
+		"SIOCAIFGROUP":	MakeUntypedConst(Int, "2149869959"),
+		"SIOCALIFADDR":	MakeUntypedConst(Int, "2165860635"),
+		"SIOCATMARK":	MakeUntypedConst(Int, "1074033415"),
+		"SIOCDELMULTI":	MakeUntypedConst(Int, "2149607730"),
+		"SIOCDELRT":	MakeUntypedConst(Int, "2150658571"),
+		"SIOCDIFADDR":	MakeUntypedConst(Int, "2149607705"),
+		"SIOCDIFGROUP":	MakeUntypedConst(Int, "2149869961"),
+		"SIOCDIFPHYADDR":	MakeUntypedConst(Int, "2149607753"),
+		"SIOCDLIFADDR":	MakeUntypedConst(Int, "2165860637"),
+		"SIOCGDRVSPEC":	MakeUntypedConst(Int, "3223087483"),
+		"SIOCGETSGCNT":	MakeUntypedConst(Int, "3222565392"),
+		"SIOCGETVIFCNT":	MakeUntypedConst(Int, "3222565391"),
+		"SIOCGHIWAT":	MakeUntypedConst(Int, "1074033409"),
+		"SIOCGIFADDR":	MakeUntypedConst(Int, "3223349537"),
+		"SIOCGIFBRDADDR":	MakeUntypedConst(Int, "3223349539"),
+		"SIOCGIFCAP":	MakeUntypedConst(Int, "3223349535"),
+		"SIOCGIFCONF":	MakeUntypedConst(Int, "3221776676"),
+		"SIOCGIFDESCR":	MakeUntypedConst(Int, "3223349546"),
+		"SIOCGIFDSTADDR":	MakeUntypedConst(Int, "3223349538"),
+		"SIOCGIFFIB":	MakeUntypedConst(Int, "3223349596"),
+		"SIOCGIFFLAGS":	MakeUntypedConst(Int, "3223349521"),
+		"SIOCGIFGENERIC":	MakeUntypedConst(Int, "3223349562"),
+		"SIOCGIFGMEMB":	MakeUntypedConst(Int, "3223611786"),
+		"SIOCGIFGROUP":	MakeUntypedConst(Int, "3223611784"),
+		"SIOCGIFINDEX":	MakeUntypedConst(Int, "3223349536"),
+		"SIOCGIFMAC":	MakeUntypedConst(Int, "3223349542"),
+		"SIOCGIFMEDIA":	MakeUntypedConst(Int, "3223873848"),
+		"SIOCGIFMETRIC":	MakeUntypedConst(Int, "3223349527"),
+		"SIOCGIFMTU":	MakeUntypedConst(Int, "3223349555"),
+		"SIOCGIFNETMASK":	MakeUntypedConst(Int, "3223349541"),
+		"SIOCGIFPDSTADDR":	MakeUntypedConst(Int, "3223349576"),
+		"SIOCGIFPHYS":	MakeUntypedConst(Int, "3223349557"),
+		"SIOCGIFPSRCADDR":	MakeUntypedConst(Int, "3223349575"),
+		"SIOCGIFSTATUS":	MakeUntypedConst(Int, "3274795323"),
+		"SIOCGLIFADDR":	MakeUntypedConst(Int, "3239602460"),
+		"SIOCGLIFPHYADDR":	MakeUntypedConst(Int, "3239602507"),
+		"SIOCGLOWAT":	MakeUntypedConst(Int, "1074033411"),
+		"SIOCGPGRP":	MakeUntypedConst(Int, "1074033417"),
+		"SIOCGPRIVATE_0":	MakeUntypedConst(Int, "3223349584"),
+		"SIOCGPRIVATE_1":	MakeUntypedConst(Int, "3223349585"),
+		"SIOCIFCREATE":	MakeUntypedConst(Int, "3223349626"),
+		"SIOCIFCREATE2":	MakeUntypedConst(Int, "3223349628"),
+		"SIOCIFDESTROY":	MakeUntypedConst(Int, "2149607801"),
+		"SIOCIFGCLONERS":	MakeUntypedConst(Int, "3222038904"),
+		"SIOCSDRVSPEC":	MakeUntypedConst(Int, "2149345659"),
+		"SIOCSHIWAT":	MakeUntypedConst(Int, "2147775232"),
+		"SIOCSIFADDR":	MakeUntypedConst(Int, "2149607692"),
+		"SIOCSIFBRDADDR":	MakeUntypedConst(Int, "2149607699"),
+		"SIOCSIFCAP":	MakeUntypedConst(Int, "2149607710"),
+		"SIOCSIFDESCR":	MakeUntypedConst(Int, "2149607721"),
+		"SIOCSIFDSTADDR":	MakeUntypedConst(Int, "2149607694"),
+		"SIOCSIFFIB":	MakeUntypedConst(Int, "2149607773"),
+		"SIOCSIFFLAGS":	MakeUntypedConst(Int, "2149607696"),
+		"SIOCSIFGENERIC":	MakeUntypedConst(Int, "2149607737"),
+		"SIOCSIFLLADDR":	MakeUntypedConst(Int, "2149607740"),
+		"SIOCSIFMAC":	MakeUntypedConst(Int, "2149607719"),
+		"SIOCSIFMEDIA":	MakeUntypedConst(Int, "3223349559"),
+		"SIOCSIFMETRIC":	MakeUntypedConst(Int, "2149607704"),
+		"SIOCSIFMTU":	MakeUntypedConst(Int, "2149607732"),
+		"SIOCSIFNAME":	MakeUntypedConst(Int, "2149607720"),
+		"SIOCSIFNETMASK":	MakeUntypedConst(Int, "2149607702"),
+		"SIOCSIFPHYADDR":	MakeUntypedConst(Int, "2151704902"),
+		"SIOCSIFPHYS":	MakeUntypedConst(Int, "2149607734"),
+		"SIOCSIFRVNET":	MakeUntypedConst(Int, "3223349595"),
+		"SIOCSIFVNET":	MakeUntypedConst(Int, "3223349594"),
+		"SIOCSLIFPHYADDR":	MakeUntypedConst(Int, "2165860682"),
+		"SIOCSLOWAT":	MakeUntypedConst(Int, "2147775234"),
+		"SIOCSPGRP":	MakeUntypedConst(Int, "2147775240"),
+		"SOCK_CLOEXEC":	MakeUntypedConst(Int, "268435456"),
+		"SOCK_DGRAM":	MakeUntypedConst(Int, "2"),
+		"SOCK_MAXADDRLEN":	MakeUntypedConst(Int, "255"),
+		"SOCK_NONBLOCK":	MakeUntypedConst(Int, "536870912"),
+		"SOCK_RAW":	MakeUntypedConst(Int, "3"),
+		"SOCK_RDM":	MakeUntypedConst(Int, "4"),
+		"SOCK_SEQPACKET":	MakeUntypedConst(Int, "5"),
+		"SOCK_STREAM":	MakeUntypedConst(Int, "1"),
+		"SOL_SOCKET":	MakeUntypedConst(Int, "65535"),
+		"SOMAXCONN":	MakeUntypedConst(Int, "128"),
+		"SO_ACCEPTCONN":	MakeUntypedConst(Int, "2"),
+		"SO_ACCEPTFILTER":	MakeUntypedConst(Int, "4096"),
+		"SO_BINTIME":	MakeUntypedConst(Int, "8192"),
+		"SO_BROADCAST":	MakeUntypedConst(Int, "32"),
+		"SO_DEBUG":	MakeUntypedConst(Int, "1"),
+		"SO_DONTROUTE":	MakeUntypedConst(Int, "16"),
+		"SO_ERROR":	MakeUntypedConst(Int, "4103"),
+		"SO_KEEPALIVE":	MakeUntypedConst(Int, "8"),
+		"SO_LABEL":	MakeUntypedConst(Int, "4105"),
+		"SO_LINGER":	MakeUntypedConst(Int, "128"),
+		"SO_LISTENINCQLEN":	MakeUntypedConst(Int, "4115"),
+		"SO_LISTENQLEN":	MakeUntypedConst(Int, "4114"),
+		"SO_LISTENQLIMIT":	MakeUntypedConst(Int, "4113"),
+		"SO_NOSIGPIPE":	MakeUntypedConst(Int, "2048"),
+		"SO_NO_DDP":	MakeUntypedConst(Int, "32768"),
+		"SO_NO_OFFLOAD":	MakeUntypedConst(Int, "16384"),
+		"SO_OOBINLINE":	MakeUntypedConst(Int, "256"),
+		"SO_PEERLABEL":	MakeUntypedConst(Int, "4112"),
+		"SO_PROTOCOL":	MakeUntypedConst(Int, "4118"),
+		"SO_PROTOTYPE":	MakeUntypedConst(Int, "4118"),
+		"SO_RCVBUF":	MakeUntypedConst(Int, "4098"),
+		"SO_RCVLOWAT":	MakeUntypedConst(Int, "4100"),
+		"SO_RCVTIMEO":	MakeUntypedConst(Int, "4102"),
+		"SO_REUSEADDR":	MakeUntypedConst(Int, "4"),
+		"SO_REUSEPORT":	MakeUntypedConst(Int, "512"),
+		"SO_SETFIB":	MakeUntypedConst(Int, "4116"),
+		"SO_SNDBUF":	MakeUntypedConst(Int, "4097"),
+		"SO_SNDLOWAT":	MakeUntypedConst(Int, "4099"),
+		"SO_SNDTIMEO":	MakeUntypedConst(Int, "4101"),
+		"SO_TIMESTAMP":	MakeUntypedConst(Int, "1024"),
+		"SO_TYPE":	MakeUntypedConst(Int, "4104"),
+		"SO_USELOOPBACK":	MakeUntypedConst(Int, "64"),
+		"SO_USER_COOKIE":	MakeUntypedConst(Int, "4117"),
+		"SO_VENDOR":	MakeUntypedConst(Int, "2147483648"),
+		"SYS_ABORT2":	MakeUntypedConst(Int, "463"),
+		"SYS_ACCEPT":	MakeUntypedConst(Int, "30"),
+		"SYS_ACCEPT4":	MakeUntypedConst(Int, "541"),
+		"SYS_ACCESS":	MakeUntypedConst(Int, "33"),
+		"SYS_ACCT":	MakeUntypedConst(Int, "51"),
+		"SYS_ADJTIME":	MakeUntypedConst(Int, "140"),
+		"SYS_AUDIT":	MakeUntypedConst(Int, "445"),
+		"SYS_AUDITCTL":	MakeUntypedConst(Int, "453"),
+		"SYS_AUDITON":	MakeUntypedConst(Int, "446"),
+		"SYS_BIND":	MakeUntypedConst(Int, "104"),
+		"SYS_BINDAT":	MakeUntypedConst(Int, "538"),
+		"SYS_CAP_ENTER":	MakeUntypedConst(Int, "516"),
+		"SYS_CAP_GETMODE":	MakeUntypedConst(Int, "517"),
+		"SYS_CAP_GETRIGHTS":	MakeUntypedConst(Int, "515"),
+		"SYS_CAP_NEW":	MakeUntypedConst(Int, "514"),
+		"SYS_CHDIR":	MakeUntypedConst(Int, "12"),
+		"SYS_CHFLAGS":	MakeUntypedConst(Int, "34"),
+		"SYS_CHFLAGSAT":	MakeUntypedConst(Int, "540"),
+		"SYS_CHMOD":	MakeUntypedConst(Int, "15"),
+		"SYS_CHOWN":	MakeUntypedConst(Int, "16"),
+		"SYS_CHROOT":	MakeUntypedConst(Int, "61"),
+		"SYS_CLOCK_GETCPUCLOCKID2":	MakeUntypedConst(Int, "247"),
+		"SYS_CLOCK_GETRES":	MakeUntypedConst(Int, "234"),
+		"SYS_CLOCK_GETTIME":	MakeUntypedConst(Int, "232"),
+		"SYS_CLOCK_SETTIME":	MakeUntypedConst(Int, "233"),
+		"SYS_CLOSE":	MakeUntypedConst(Int, "6"),
+		"SYS_CLOSEFROM":	MakeUntypedConst(Int, "509"),
+		"SYS_CONNECT":	MakeUntypedConst(Int, "98"),
+		"SYS_CONNECTAT":	MakeUntypedConst(Int, "539"),
+		"SYS_CPUSET":	MakeUntypedConst(Int, "484"),
+		"SYS_CPUSET_GETAFFINITY":	MakeUntypedConst(Int, "487"),
+		"SYS_CPUSET_GETID":	MakeUntypedConst(Int, "486"),
+		"SYS_CPUSET_SETAFFINITY":	MakeUntypedConst(Int, "488"),
+		"SYS_CPUSET_SETID":	MakeUntypedConst(Int, "485"),
+		"SYS_DUP":	MakeUntypedConst(Int, "41"),
+		"SYS_DUP2":	MakeUntypedConst(Int, "90"),
+		"SYS_EACCESS":	MakeUntypedConst(Int, "376"),
+		"SYS_EXECVE":	MakeUntypedConst(Int, "59"),
+		"SYS_EXIT":	MakeUntypedConst(Int, "1"),
+		"SYS_EXTATTRCTL":	MakeUntypedConst(Int, "355"),
+		"SYS_EXTATTR_DELETE_FD":	MakeUntypedConst(Int, "373"),
+		"SYS_EXTATTR_DELETE_FILE":	MakeUntypedConst(Int, "358"),
+		"SYS_EXTATTR_DELETE_LINK":	MakeUntypedConst(Int, "414"),
+		"SYS_EXTATTR_GET_FD":	MakeUntypedConst(Int, "372"),
+		"SYS_EXTATTR_GET_FILE":	MakeUntypedConst(Int, "357"),
+		"SYS_EXTATTR_GET_LINK":	MakeUntypedConst(Int, "413"),
+		"SYS_EXTATTR_LIST_FD":	MakeUntypedConst(Int, "437"),
+		"SYS_EXTATTR_LIST_FILE":	MakeUntypedConst(Int, "438"),
+		"SYS_EXTATTR_LIST_LINK":	MakeUntypedConst(Int, "439"),
+		"SYS_EXTATTR_SET_FD":	MakeUntypedConst(Int, "371"),
+		"SYS_EXTATTR_SET_FILE":	MakeUntypedConst(Int, "356"),
+		"SYS_EXTATTR_SET_LINK":	MakeUntypedConst(Int, "412"),
+		"SYS_FACCESSAT":	MakeUntypedConst(Int, "489"),
+		"SYS_FCHDIR":	MakeUntypedConst(Int, "13"),
+		"SYS_FCHFLAGS":	MakeUntypedConst(Int, "35"),
+		"SYS_FCHMOD":	MakeUntypedConst(Int, "124"),
+		"SYS_FCHMODAT":	MakeUntypedConst(Int, "490"),
+		"SYS_FCHOWN":	MakeUntypedConst(Int, "123"),
+		"SYS_FCHOWNAT":	MakeUntypedConst(Int, "491"),
+		"SYS_FCNTL":	MakeUntypedConst(Int, "92"),
+		"SYS_FEXECVE":	MakeUntypedConst(Int, "492"),
+		"SYS_FFCLOCK_GETCOUNTER":	MakeUntypedConst(Int, "241"),
+		"SYS_FFCLOCK_GETESTIMATE":	MakeUntypedConst(Int, "243"),
+		"SYS_FFCLOCK_SETESTIMATE":	MakeUntypedConst(Int, "242"),
+		"SYS_FHOPEN":	MakeUntypedConst(Int, "298"),
+		"SYS_FHSTAT":	MakeUntypedConst(Int, "299"),
+		"SYS_FHSTATFS":	MakeUntypedConst(Int, "398"),
+		"SYS_FLOCK":	MakeUntypedConst(Int, "131"),
+		"SYS_FORK":	MakeUntypedConst(Int, "2"),
+		"SYS_FPATHCONF":	MakeUntypedConst(Int, "192"),
+		"SYS_FREEBSD6_FTRUNCATE":	MakeUntypedConst(Int, "201"),
+		"SYS_FREEBSD6_LSEEK":	MakeUntypedConst(Int, "199"),
+		"SYS_FREEBSD6_MMAP":	MakeUntypedConst(Int, "197"),
+		"SYS_FREEBSD6_PREAD":	MakeUntypedConst(Int, "173"),
+		"SYS_FREEBSD6_PWRITE":	MakeUntypedConst(Int, "174"),
+		"SYS_FREEBSD6_TRUNCATE":	MakeUntypedConst(Int, "200"),
+		"SYS_FSTAT":	MakeUntypedConst(Int, "551"),
+		"SYS_FSTATAT":	MakeUntypedConst(Int, "552"),
+		"SYS_FSTATFS":	MakeUntypedConst(Int, "556"),
+		"SYS_FSYNC":	MakeUntypedConst(Int, "95"),
+		"SYS_FTRUNCATE":	MakeUntypedConst(Int, "480"),
+		"SYS_FUTIMES":	MakeUntypedConst(Int, "206"),
+		"SYS_FUTIMESAT":	MakeUntypedConst(Int, "494"),
+		"SYS_GETAUDIT":	MakeUntypedConst(Int, "449"),
+		"SYS_GETAUDIT_ADDR":	MakeUntypedConst(Int, "451"),
+		"SYS_GETAUID":	MakeUntypedConst(Int, "447"),
+		"SYS_GETCONTEXT":	MakeUntypedConst(Int, "421"),
+		"SYS_GETDENTS":	MakeUntypedConst(Int, "272"),
+		"SYS_GETDIRENTRIES":	MakeUntypedConst(Int, "554"),
+		"SYS_GETDTABLESIZE":	MakeUntypedConst(Int, "89"),
+		"SYS_GETEGID":	MakeUntypedConst(Int, "43"),
+		"SYS_GETEUID":	MakeUntypedConst(Int, "25"),
+		"SYS_GETFH":	MakeUntypedConst(Int, "161"),
+		"SYS_GETFSSTAT":	MakeUntypedConst(Int, "557"),
+		"SYS_GETGID":	MakeUntypedConst(Int, "47"),
+		"SYS_GETGROUPS":	MakeUntypedConst(Int, "79"),
+		"SYS_GETITIMER":	MakeUntypedConst(Int, "86"),
+		"SYS_GETLOGIN":	MakeUntypedConst(Int, "49"),
+		"SYS_GETLOGINCLASS":	MakeUntypedConst(Int, "523"),
+		"SYS_GETPEERNAME":	MakeUntypedConst(Int, "31"),
+		"SYS_GETPGID":	MakeUntypedConst(Int, "207"),
+		"SYS_GETPGRP":	MakeUntypedConst(Int, "81"),
+		"SYS_GETPID":	MakeUntypedConst(Int, "20"),
+		"SYS_GETPPID":	MakeUntypedConst(Int, "39"),
+		"SYS_GETPRIORITY":	MakeUntypedConst(Int, "100"),
+		"SYS_GETRESGID":	MakeUntypedConst(Int, "361"),
+		"SYS_GETRESUID":	MakeUntypedConst(Int, "360"),
+		"SYS_GETRLIMIT":	MakeUntypedConst(Int, "194"),
+		"SYS_GETRUSAGE":	MakeUntypedConst(Int, "117"),
+		"SYS_GETSID":	MakeUntypedConst(Int, "310"),
+		"SYS_GETSOCKNAME":	MakeUntypedConst(Int, "32"),
+		"SYS_GETSOCKOPT":	MakeUntypedConst(Int, "118"),
+		"SYS_GETTIMEOFDAY":	MakeUntypedConst(Int, "116"),
+		"SYS_GETUID":	MakeUntypedConst(Int, "24"),
+		"SYS_IOCTL":	MakeUntypedConst(Int, "54"),
+		"SYS_ISSETUGID":	MakeUntypedConst(Int, "253"),
+		"SYS_JAIL":	MakeUntypedConst(Int, "338"),
+		"SYS_JAIL_ATTACH":	MakeUntypedConst(Int, "436"),
+		"SYS_JAIL_GET":	MakeUntypedConst(Int, "506"),
+		"SYS_JAIL_REMOVE":	MakeUntypedConst(Int, "508"),
+		"SYS_JAIL_SET":	MakeUntypedConst(Int, "507"),
+		"SYS_KENV":	MakeUntypedConst(Int, "390"),
+		"SYS_KEVENT":	MakeUntypedConst(Int, "363"),
+		"SYS_KILL":	MakeUntypedConst(Int, "37"),
+		"SYS_KLDFIND":	MakeUntypedConst(Int, "306"),
+		"SYS_KLDFIRSTMOD":	MakeUntypedConst(Int, "309"),
+		"SYS_KLDLOAD":	MakeUntypedConst(Int, "304"),
+		"SYS_KLDNEXT":	MakeUntypedConst(Int, "307"),
+		"SYS_KLDSTAT":	MakeUntypedConst(Int, "308"),
+		"SYS_KLDSYM":	MakeUntypedConst(Int, "337"),
+		"SYS_KLDUNLOAD":	MakeUntypedConst(Int, "305"),
+		"SYS_KLDUNLOADF":	MakeUntypedConst(Int, "444"),
+		"SYS_KQUEUE":	MakeUntypedConst(Int, "362"),
+		"SYS_KTIMER_CREATE":	MakeUntypedConst(Int, "235"),
+		"SYS_KTIMER_DELETE":	MakeUntypedConst(Int, "236"),
+		"SYS_KTIMER_GETOVERRUN":	MakeUntypedConst(Int, "239"),
+		"SYS_KTIMER_GETTIME":	MakeUntypedConst(Int, "238"),
+		"SYS_KTIMER_SETTIME":	MakeUntypedConst(Int, "237"),
+		"SYS_KTRACE":	MakeUntypedConst(Int, "45"),
+		"SYS_LCHFLAGS":	MakeUntypedConst(Int, "391"),
+		"SYS_LCHMOD":	MakeUntypedConst(Int, "274"),
+		"SYS_LCHOWN":	MakeUntypedConst(Int, "254"),
+		"SYS_LGETFH":	MakeUntypedConst(Int, "160"),
+		"SYS_LINK":	MakeUntypedConst(Int, "9"),
+		"SYS_LINKAT":	MakeUntypedConst(Int, "495"),
+		"SYS_LISTEN":	MakeUntypedConst(Int, "106"),
+		"SYS_LPATHCONF":	MakeUntypedConst(Int, "513"),
+		"SYS_LSEEK":	MakeUntypedConst(Int, "478"),
+		"SYS_LUTIMES":	MakeUntypedConst(Int, "276"),
+		"SYS_MAC_SYSCALL":	MakeUntypedConst(Int, "394"),
+		"SYS_MADVISE":	MakeUntypedConst(Int, "75"),
+		"SYS_MINCORE":	MakeUntypedConst(Int, "78"),
+		"SYS_MINHERIT":	MakeUntypedConst(Int, "250"),
+		"SYS_MKDIR":	MakeUntypedConst(Int, "136"),
+		"SYS_MKDIRAT":	MakeUntypedConst(Int, "496"),
+		"SYS_MKFIFO":	MakeUntypedConst(Int, "132"),
+		"SYS_MKFIFOAT":	MakeUntypedConst(Int, "497"),
+		"SYS_MKNOD":	MakeUntypedConst(Int, "14"),
+		"SYS_MKNODAT":	MakeUntypedConst(Int, "559"),
+		"SYS_MLOCK":	MakeUntypedConst(Int, "203"),
+		"SYS_MLOCKALL":	MakeUntypedConst(Int, "324"),
+		"SYS_MMAP":	MakeUntypedConst(Int, "477"),
+		"SYS_MODFIND":	MakeUntypedConst(Int, "303"),
+		"SYS_MODFNEXT":	MakeUntypedConst(Int, "302"),
+		"SYS_MODNEXT":	MakeUntypedConst(Int, "300"),
+		"SYS_MODSTAT":	MakeUntypedConst(Int, "301"),
+		"SYS_MOUNT":	MakeUntypedConst(Int, "21"),
+		"SYS_MPROTECT":	MakeUntypedConst(Int, "74"),
+		"SYS_MSYNC":	MakeUntypedConst(Int, "65"),
+		"SYS_MUNLOCK":	MakeUntypedConst(Int, "204"),
+		"SYS_MUNLOCKALL":	MakeUntypedConst(Int, "325"),
+		"SYS_MUNMAP":	MakeUntypedConst(Int, "73"),
+		"SYS_NANOSLEEP":	MakeUntypedConst(Int, "240"),
+		"SYS_NFSTAT":	MakeUntypedConst(Int, "279"),
+		"SYS_NLSTAT":	MakeUntypedConst(Int, "280"),
+		"SYS_NMOUNT":	MakeUntypedConst(Int, "378"),
+		"SYS_NSTAT":	MakeUntypedConst(Int, "278"),
+		"SYS_NTP_ADJTIME":	MakeUntypedConst(Int, "176"),
+		"SYS_NTP_GETTIME":	MakeUntypedConst(Int, "248"),
+		"SYS_OBREAK":	MakeUntypedConst(Int, "17"),
+		"SYS_OPEN":	MakeUntypedConst(Int, "5"),
+		"SYS_OPENAT":	MakeUntypedConst(Int, "499"),
+		"SYS_OPENBSD_POLL":	MakeUntypedConst(Int, "252"),
+		"SYS_OVADVISE":	MakeUntypedConst(Int, "72"),
+		"SYS_PATHCONF":	MakeUntypedConst(Int, "191"),
+		"SYS_PDFORK":	MakeUntypedConst(Int, "518"),
+		"SYS_PDGETPID":	MakeUntypedConst(Int, "520"),
+		"SYS_PDKILL":	MakeUntypedConst(Int, "519"),
+		"SYS_PIPE":	MakeUntypedConst(Int, "42"),
+		"SYS_PIPE2":	MakeUntypedConst(Int, "542"),
+		"SYS_POLL":	MakeUntypedConst(Int, "209"),
+		"SYS_POSIX_FADVISE":	MakeUntypedConst(Int, "531"),
+		"SYS_POSIX_FALLOCATE":	MakeUntypedConst(Int, "530"),
+		"SYS_POSIX_OPENPT":	MakeUntypedConst(Int, "504"),
+		"SYS_PREAD":	MakeUntypedConst(Int, "475"),
+		"SYS_PREADV":	MakeUntypedConst(Int, "289"),
+		"SYS_PROCCTL":	MakeUntypedConst(Int, "544"),
+		"SYS_PROFIL":	MakeUntypedConst(Int, "44"),
+		"SYS_PSELECT":	MakeUntypedConst(Int, "522"),
+		"SYS_PTRACE":	MakeUntypedConst(Int, "26"),
+		"SYS_PWRITE":	MakeUntypedConst(Int, "476"),
+		"SYS_PWRITEV":	MakeUntypedConst(Int, "290"),
+		"SYS_QUOTACTL":	MakeUntypedConst(Int, "148"),
+		"SYS_RCTL_ADD_RULE":	MakeUntypedConst(Int, "528"),
+		"SYS_RCTL_GET_LIMITS":	MakeUntypedConst(Int, "527"),
+		"SYS_RCTL_GET_RACCT":	MakeUntypedConst(Int, "525"),
+		"SYS_RCTL_GET_RULES":	MakeUntypedConst(Int, "526"),
+		"SYS_RCTL_REMOVE_RULE":	MakeUntypedConst(Int, "529"),
+		"SYS_READ":	MakeUntypedConst(Int, "3"),
+		"SYS_READLINK":	MakeUntypedConst(Int, "58"),
+		"SYS_READLINKAT":	MakeUntypedConst(Int, "500"),
+		"SYS_READV":	MakeUntypedConst(Int, "120"),
+		"SYS_REBOOT":	MakeUntypedConst(Int, "55"),
+		"SYS_RECVFROM":	MakeUntypedConst(Int, "29"),
+		"SYS_RECVMSG":	MakeUntypedConst(Int, "27"),
+		"SYS_RENAME":	MakeUntypedConst(Int, "128"),
+		"SYS_RENAMEAT":	MakeUntypedConst(Int, "501"),
+		"SYS_REVOKE":	MakeUntypedConst(Int, "56"),
+		"SYS_RFORK":	MakeUntypedConst(Int, "251"),
+		"SYS_RMDIR":	MakeUntypedConst(Int, "137"),
+		"SYS_RTPRIO":	MakeUntypedConst(Int, "166"),
+		"SYS_RTPRIO_THREAD":	MakeUntypedConst(Int, "466"),
+		"SYS_SBRK":	MakeUntypedConst(Int, "69"),
+		"SYS_SCHED_GETPARAM":	MakeUntypedConst(Int, "328"),
+		"SYS_SCHED_GETSCHEDULER":	MakeUntypedConst(Int, "330"),
+		"SYS_SCHED_GET_PRIORITY_MAX":	MakeUntypedConst(Int, "332"),
+		"SYS_SCHED_GET_PRIORITY_MIN":	MakeUntypedConst(Int, "333"),
+		"SYS_SCHED_RR_GET_INTERVAL":	MakeUntypedConst(Int, "334"),
+		"SYS_SCHED_SETPARAM":	MakeUntypedConst(Int, "327"),
+		"SYS_SCHED_SETSCHEDULER":	MakeUntypedConst(Int, "329"),
+		"SYS_SCHED_YIELD":	MakeUntypedConst(Int, "331"),
+		"SYS_SCTP_GENERIC_RECVMSG":	MakeUntypedConst(Int, "474"),
+		"SYS_SCTP_GENERIC_SENDMSG":	MakeUntypedConst(Int, "472"),
+		"SYS_SCTP_GENERIC_SENDMSG_IOV":	MakeUntypedConst(Int, "473"),
+		"SYS_SCTP_PEELOFF":	MakeUntypedConst(Int, "471"),
+		"SYS_SELECT":	MakeUntypedConst(Int, "93"),
+		"SYS_SENDFILE":	MakeUntypedConst(Int, "393"),
+		"SYS_SENDMSG":	MakeUntypedConst(Int, "28"),
+		"SYS_SENDTO":	MakeUntypedConst(Int, "133"),
+		"SYS_SETAUDIT":	MakeUntypedConst(Int, "450"),
+		"SYS_SETAUDIT_ADDR":	MakeUntypedConst(Int, "452"),
+		"SYS_SETAUID":	MakeUntypedConst(Int, "448"),
+		"SYS_SETCONTEXT":	MakeUntypedConst(Int, "422"),
+		"SYS_SETEGID":	MakeUntypedConst(Int, "182"),
+		"SYS_SETEUID":	MakeUntypedConst(Int, "183"),
+		"SYS_SETFIB":	MakeUntypedConst(Int, "175"),
+		"SYS_SETGID":	MakeUntypedConst(Int, "181"),
+		"SYS_SETGROUPS":	MakeUntypedConst(Int, "80"),
+		"SYS_SETITIMER":	MakeUntypedConst(Int, "83"),
+		"SYS_SETLOGIN":	MakeUntypedConst(Int, "50"),
+		"SYS_SETLOGINCLASS":	MakeUntypedConst(Int, "524"),
+		"SYS_SETPGID":	MakeUntypedConst(Int, "82"),
+		"SYS_SETPRIORITY":	MakeUntypedConst(Int, "96"),
+		"SYS_SETREGID":	MakeUntypedConst(Int, "127"),
+		"SYS_SETRESGID":	MakeUntypedConst(Int, "312"),
+		"SYS_SETRESUID":	MakeUntypedConst(Int, "311"),
+		"SYS_SETREUID":	MakeUntypedConst(Int, "126"),
+		"SYS_SETRLIMIT":	MakeUntypedConst(Int, "195"),
+		"SYS_SETSID":	MakeUntypedConst(Int, "147"),
+		"SYS_SETSOCKOPT":	MakeUntypedConst(Int, "105"),
+		"SYS_SETTIMEOFDAY":	MakeUntypedConst(Int, "122"),
+		"SYS_SETUID":	MakeUntypedConst(Int, "23"),
+		"SYS_SHM_OPEN":	MakeUntypedConst(Int, "482"),
+		"SYS_SHM_UNLINK":	MakeUntypedConst(Int, "483"),
+		"SYS_SHUTDOWN":	MakeUntypedConst(Int, "134"),
+		"SYS_SIGACTION":	MakeUntypedConst(Int, "416"),
+		"SYS_SIGALTSTACK":	MakeUntypedConst(Int, "53"),
+		"SYS_SIGPENDING":	MakeUntypedConst(Int, "343"),
+		"SYS_SIGPROCMASK":	MakeUntypedConst(Int, "340"),
+		"SYS_SIGQUEUE":	MakeUntypedConst(Int, "456"),
+		"SYS_SIGRETURN":	MakeUntypedConst(Int, "417"),
+		"SYS_SIGSUSPEND":	MakeUntypedConst(Int, "341"),
+		"SYS_SIGTIMEDWAIT":	MakeUntypedConst(Int, "345"),
+		"SYS_SIGWAIT":	MakeUntypedConst(Int, "429"),
+		"SYS_SIGWAITINFO":	MakeUntypedConst(Int, "346"),
+		"SYS_SOCKET":	MakeUntypedConst(Int, "97"),
+		"SYS_SOCKETPAIR":	MakeUntypedConst(Int, "135"),
+		"SYS_SSTK":	MakeUntypedConst(Int, "70"),
+		"SYS_STATFS":	MakeUntypedConst(Int, "555"),
+		"SYS_SWAPCONTEXT":	MakeUntypedConst(Int, "423"),
+		"SYS_SWAPOFF":	MakeUntypedConst(Int, "424"),
+		"SYS_SWAPON":	MakeUntypedConst(Int, "85"),
+		"SYS_SYMLINK":	MakeUntypedConst(Int, "57"),
+		"SYS_SYMLINKAT":	MakeUntypedConst(Int, "502"),
+		"SYS_SYNC":	MakeUntypedConst(Int, "36"),
+		"SYS_SYSARCH":	MakeUntypedConst(Int, "165"),
+		"SYS_THR_CREATE":	MakeUntypedConst(Int, "430"),
+		"SYS_THR_EXIT":	MakeUntypedConst(Int, "431"),
+		"SYS_THR_KILL":	MakeUntypedConst(Int, "433"),
+		"SYS_THR_KILL2":	MakeUntypedConst(Int, "481"),
+		"SYS_THR_NEW":	MakeUntypedConst(Int, "455"),
+		"SYS_THR_SELF":	MakeUntypedConst(Int, "432"),
+		"SYS_THR_SET_NAME":	MakeUntypedConst(Int, "464"),
+		"SYS_THR_SUSPEND":	MakeUntypedConst(Int, "442"),
+		"SYS_THR_WAKE":	MakeUntypedConst(Int, "443"),
+		"SYS_TRUNCATE":	MakeUntypedConst(Int, "479"),
+		"SYS_UMASK":	MakeUntypedConst(Int, "60"),
+		"SYS_UNDELETE":	MakeUntypedConst(Int, "205"),
+		"SYS_UNLINK":	MakeUntypedConst(Int, "10"),
+		"SYS_UNLINKAT":	MakeUntypedConst(Int, "503"),
+		"SYS_UNMOUNT":	MakeUntypedConst(Int, "22"),
+		"SYS_UTIMES":	MakeUntypedConst(Int, "138"),
+		"SYS_UTRACE":	MakeUntypedConst(Int, "335"),
+		"SYS_UUIDGEN":	MakeUntypedConst(Int, "392"),
+		"SYS_VFORK":	MakeUntypedConst(Int, "66"),
+		"SYS_WAIT4":	MakeUntypedConst(Int, "7"),
+		"SYS_WAIT6":	MakeUntypedConst(Int, "532"),
+		"SYS_WRITE":	MakeUntypedConst(Int, "4"),
+		"SYS_WRITEV":	MakeUntypedConst(Int, "121"),
+		"SYS_YIELD":	MakeUntypedConst(Int, "321"),
+		"SYS__UMTX_LOCK":	MakeUntypedConst(Int, "434"),
+		"SYS__UMTX_OP":	MakeUntypedConst(Int, "454"),
+		"SYS__UMTX_UNLOCK":	MakeUntypedConst(Int, "435"),
+		"SYS___ACL_ACLCHECK_FD":	MakeUntypedConst(Int, "354"),
+		"SYS___ACL_ACLCHECK_FILE":	MakeUntypedConst(Int, "353"),
+		"SYS___ACL_ACLCHECK_LINK":	MakeUntypedConst(Int, "428"),
+		"SYS___ACL_DELETE_FD":	MakeUntypedConst(Int, "352"),
+		"SYS___ACL_DELETE_FILE":	MakeUntypedConst(Int, "351"),
+		"SYS___ACL_DELETE_LINK":	MakeUntypedConst(Int, "427"),
+		"SYS___ACL_GET_FD":	MakeUntypedConst(Int, "349"),
+		"SYS___ACL_GET_FILE":	MakeUntypedConst(Int, "347"),
+		"SYS___ACL_GET_LINK":	MakeUntypedConst(Int, "425"),
+		"SYS___ACL_SET_FD":	MakeUntypedConst(Int, "350"),
+		"SYS___ACL_SET_FILE":	MakeUntypedConst(Int, "348"),
+		"SYS___ACL_SET_LINK":	MakeUntypedConst(Int, "426"),
+		"SYS___GETCWD":	MakeUntypedConst(Int, "326"),
+		"SYS___MAC_EXECVE":	MakeUntypedConst(Int, "415"),
+		"SYS___MAC_GET_FD":	MakeUntypedConst(Int, "386"),
+		"SYS___MAC_GET_FILE":	MakeUntypedConst(Int, "387"),
+		"SYS___MAC_GET_LINK":	MakeUntypedConst(Int, "410"),
+		"SYS___MAC_GET_PID":	MakeUntypedConst(Int, "409"),
+		"SYS___MAC_GET_PROC":	MakeUntypedConst(Int, "384"),
+		"SYS___MAC_SET_FD":	MakeUntypedConst(Int, "388"),
+		"SYS___MAC_SET_FILE":	MakeUntypedConst(Int, "389"),
+		"SYS___MAC_SET_LINK":	MakeUntypedConst(Int, "411"),
+		"SYS___MAC_SET_PROC":	MakeUntypedConst(Int, "385"),
+		"SYS___SETUGID":	MakeUntypedConst(Int, "374"),
+		"SYS___SYSCTL":	MakeUntypedConst(Int, "202"),
+		"S_IFBLK":	MakeUntypedConst(Int, "24576"),
+		"S_IFCHR":	MakeUntypedConst(Int, "8192"),
+		"S_IFDIR":	MakeUntypedConst(Int, "16384"),
+		"S_IFIFO":	MakeUntypedConst(Int, "4096"),
+		"S_IFLNK":	MakeUntypedConst(Int, "40960"),
+		"S_IFMT":	MakeUntypedConst(Int, "61440"),
+		"S_IFREG":	MakeUntypedConst(Int, "32768"),
+		"S_IFSOCK":	MakeUntypedConst(Int, "49152"),
+		"S_IRUSR":	MakeUntypedConst(Int, "256"),
+		"S_ISGID":	MakeUntypedConst(Int, "1024"),
+		"S_ISUID":	MakeUntypedConst(Int, "2048"),
+		"S_ISVTX":	MakeUntypedConst(Int, "512"),
+		"S_IWUSR":	MakeUntypedConst(Int, "128"),
+		"S_IXUSR":	MakeUntypedConst(Int, "64"),
+		"SizeofBpfHdr":	MakeUntypedConst(Int, "20"),
+		"SizeofBpfInsn":	MakeUntypedConst(Int, "8"),
+		"SizeofBpfProgram":	MakeUntypedConst(Int, "8"),
+		"SizeofBpfStat":	MakeUntypedConst(Int, "8"),
+		"SizeofBpfVersion":	MakeUntypedConst(Int, "4"),
+		"SizeofBpfZbuf":	MakeUntypedConst(Int, "12"),
+		"SizeofBpfZbufHeader":	MakeUntypedConst(Int, "32"),
+		"SizeofCmsghdr":	MakeUntypedConst(Int, "12"),
+		"SizeofICMPv6Filter":	MakeUntypedConst(Int, "32"),
+		"SizeofIPMreq":	MakeUntypedConst(Int, "8"),
+		"SizeofIPMreqn":	MakeUntypedConst(Int, "12"),
+		"SizeofIPv6MTUInfo":	MakeUntypedConst(Int, "32"),
+		"SizeofIPv6Mreq":	MakeUntypedConst(Int, "20"),
+		"SizeofIfAnnounceMsghdr":	MakeUntypedConst(Int, "24"),
+		"SizeofIfData":	MakeUntypedConst(Int, "80"),
+		"SizeofIfMsghdr":	MakeUntypedConst(Int, "96"),
+		"SizeofIfaMsghdr":	MakeUntypedConst(Int, "20"),
+		"SizeofIfmaMsghdr":	MakeUntypedConst(Int, "16"),
+		"SizeofInet6Pktinfo":	MakeUntypedConst(Int, "20"),
+		"SizeofLinger":	MakeUntypedConst(Int, "8"),
+		"SizeofMsghdr":	MakeUntypedConst(Int, "28"),
+		"SizeofRtMetrics":	MakeUntypedConst(Int, "56"),
+		"SizeofRtMsghdr":	MakeUntypedConst(Int, "92"),
+		"SizeofSockaddrAny":	MakeUntypedConst(Int, "108"),
+		"SizeofSockaddrDatalink":	MakeUntypedConst(Int, "54"),
+		"SizeofSockaddrInet4":	MakeUntypedConst(Int, "16"),
+		"SizeofSockaddrInet6":	MakeUntypedConst(Int, "28"),
+		"SizeofSockaddrUnix":	MakeUntypedConst(Int, "106"),
+		"TCIFLUSH":	MakeUntypedConst(Int, "1"),
+		"TCIOFLUSH":	MakeUntypedConst(Int, "3"),
+		"TCOFLUSH":	MakeUntypedConst(Int, "2"),
+		"TCP_CA_NAME_MAX":	MakeUntypedConst(Int, "16"),
+		"TCP_CONGESTION":	MakeUntypedConst(Int, "64"),
+		"TCP_INFO":	MakeUntypedConst(Int, "32"),
+		"TCP_KEEPCNT":	MakeUntypedConst(Int, "1024"),
+		"TCP_KEEPIDLE":	MakeUntypedConst(Int, "256"),
+		"TCP_KEEPINIT":	MakeUntypedConst(Int, "128"),
+		"TCP_KEEPINTVL":	MakeUntypedConst(Int, "512"),
+		"TCP_MAXBURST":	MakeUntypedConst(Int, "4"),
+		"TCP_MAXHLEN":	MakeUntypedConst(Int, "60"),
+		"TCP_MAXOLEN":	MakeUntypedConst(Int, "40"),
+		"TCP_MAXSEG":	MakeUntypedConst(Int, "2"),
+		"TCP_MAXWIN":	MakeUntypedConst(Int, "65535"),
+		"TCP_MAX_SACK":	MakeUntypedConst(Int, "4"),
+		"TCP_MAX_WINSHIFT":	MakeUntypedConst(Int, "14"),
+		"TCP_MD5SIG":	MakeUntypedConst(Int, "16"),
+		"TCP_MINMSS":	MakeUntypedConst(Int, "216"),
+		"TCP_MSS":	MakeUntypedConst(Int, "536"),
+		"TCP_NODELAY":	MakeUntypedConst(Int, "1"),
+		"TCP_NOOPT":	MakeUntypedConst(Int, "8"),
+		"TCP_NOPUSH":	MakeUntypedConst(Int, "4"),
+		"TCP_VENDOR":	MakeUntypedConst(Int, "2147483648"),
+		"TCSAFLUSH":	MakeUntypedConst(Int, "2"),
+		"TIOCCBRK":	MakeUntypedConst(Int, "536900730"),
+		"TIOCCDTR":	MakeUntypedConst(Int, "536900728"),
+		"TIOCCONS":	MakeUntypedConst(Int, "2147775586"),
+		"TIOCDRAIN":	MakeUntypedConst(Int, "536900702"),
+		"TIOCEXCL":	MakeUntypedConst(Int, "536900621"),
+		"TIOCEXT":	MakeUntypedConst(Int, "2147775584"),
+		"TIOCFLUSH":	MakeUntypedConst(Int, "2147775504"),
+		"TIOCGDRAINWAIT":	MakeUntypedConst(Int, "1074033750"),
+		"TIOCGETA":	MakeUntypedConst(Int, "1076655123"),
+		"TIOCGETD":	MakeUntypedConst(Int, "1074033690"),
+		"TIOCGPGRP":	MakeUntypedConst(Int, "1074033783"),
+		"TIOCGPTN":	MakeUntypedConst(Int, "1074033679"),
+		"TIOCGSID":	MakeUntypedConst(Int, "1074033763"),
+		"TIOCGWINSZ":	MakeUntypedConst(Int, "1074295912"),
+		"TIOCMBIC":	MakeUntypedConst(Int, "2147775595"),
+		"TIOCMBIS":	MakeUntypedConst(Int, "2147775596"),
+		"TIOCMGDTRWAIT":	MakeUntypedConst(Int, "1074033754"),
+		"TIOCMGET":	MakeUntypedConst(Int, "1074033770"),
+		"TIOCMSDTRWAIT":	MakeUntypedConst(Int, "2147775579"),
+		"TIOCMSET":	MakeUntypedConst(Int, "2147775597"),
+		"TIOCM_CAR":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CD":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CTS":	MakeUntypedConst(Int, "32"),
+		"TIOCM_DCD":	MakeUntypedConst(Int, "64"),
+		"TIOCM_DSR":	MakeUntypedConst(Int, "256"),
+		"TIOCM_DTR":	MakeUntypedConst(Int, "2"),
+		"TIOCM_LE":	MakeUntypedConst(Int, "1"),
+		"TIOCM_RI":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RNG":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RTS":	MakeUntypedConst(Int, "4"),
+		"TIOCM_SR":	MakeUntypedConst(Int, "16"),
+		"TIOCM_ST":	MakeUntypedConst(Int, "8"),
+		"TIOCNOTTY":	MakeUntypedConst(Int, "536900721"),
+		"TIOCNXCL":	MakeUntypedConst(Int, "536900622"),
+		"TIOCOUTQ":	MakeUntypedConst(Int, "1074033779"),
+		"TIOCPKT":	MakeUntypedConst(Int, "2147775600"),
+		"TIOCPKT_DATA":	MakeUntypedConst(Int, "0"),
+		"TIOCPKT_DOSTOP":	MakeUntypedConst(Int, "32"),
+		"TIOCPKT_FLUSHREAD":	MakeUntypedConst(Int, "1"),
+		"TIOCPKT_FLUSHWRITE":	MakeUntypedConst(Int, "2"),
+		"TIOCPKT_IOCTL":	MakeUntypedConst(Int, "64"),
+		"TIOCPKT_NOSTOP":	MakeUntypedConst(Int, "16"),
+		"TIOCPKT_START":	MakeUntypedConst(Int, "8"),
+		"TIOCPKT_STOP":	MakeUntypedConst(Int, "4"),
+		"TIOCPTMASTER":	MakeUntypedConst(Int, "536900636"),
+		"TIOCSBRK":	MakeUntypedConst(Int, "536900731"),
+		"TIOCSCTTY":	MakeUntypedConst(Int, "536900705"),
+		"TIOCSDRAINWAIT":	MakeUntypedConst(Int, "2147775575"),
+		"TIOCSDTR":	MakeUntypedConst(Int, "536900729"),
+		"TIOCSETA":	MakeUntypedConst(Int, "2150396948"),
+		"TIOCSETAF":	MakeUntypedConst(Int, "2150396950"),
+		"TIOCSETAW":	MakeUntypedConst(Int, "2150396949"),
+		"TIOCSETD":	MakeUntypedConst(Int, "2147775515"),
+		"TIOCSIG":	MakeUntypedConst(Int, "537162847"),
+		"TIOCSPGRP":	MakeUntypedConst(Int, "2147775606"),
+		"TIOCSTART":	MakeUntypedConst(Int, "536900718"),
+		"TIOCSTAT":	MakeUntypedConst(Int, "536900709"),
+		"TIOCSTI":	MakeUntypedConst(Int, "2147578994"),
+		"TIOCSTOP":	MakeUntypedConst(Int, "536900719"),
+		"TIOCSWINSZ":	MakeUntypedConst(Int, "2148037735"),
+		"TIOCTIMESTAMP":	MakeUntypedConst(Int, "1074295897"),
+		"TIOCUCNTL":	MakeUntypedConst(Int, "2147775590"),
+		"TOSTOP":	MakeUntypedConst(Int, "4194304"),
+		"VDISCARD":	MakeUntypedConst(Int, "15"),
+		"VDSUSP":	MakeUntypedConst(Int, "11"),
+		"VEOF":	MakeUntypedConst(Int, "0"),
+		"VEOL":	MakeUntypedConst(Int, "1"),
+		"VEOL2":	MakeUntypedConst(Int, "2"),
+		"VERASE":	MakeUntypedConst(Int, "3"),
+		"VERASE2":	MakeUntypedConst(Int, "7"),
+		"VINTR":	MakeUntypedConst(Int, "8"),
+		"VKILL":	MakeUntypedConst(Int, "5"),
+		"VLNEXT":	MakeUntypedConst(Int, "14"),
+		"VMIN":	MakeUntypedConst(Int, "16"),
+		"VQUIT":	MakeUntypedConst(Int, "9"),
+		"VREPRINT":	MakeUntypedConst(Int, "6"),
+		"VSTART":	MakeUntypedConst(Int, "12"),
+		"VSTATUS":	MakeUntypedConst(Int, "18"),
+		"VSTOP":	MakeUntypedConst(Int, "13"),
+		"VSUSP":	MakeUntypedConst(Int, "10"),
+		"VTIME":	MakeUntypedConst(Int, "17"),
+		"VWERASE":	MakeUntypedConst(Int, "4"),
+		"WCONTINUED":	MakeUntypedConst(Int, "4"),
+		"WCOREFLAG":	MakeUntypedConst(Int, "128"),
+		"WEXITED":	MakeUntypedConst(Int, "16"),
+		"WLINUXCLONE":	MakeUntypedConst(Int, "2147483648"),
+		"WNOHANG":	MakeUntypedConst(Int, "1"),
+		"WNOWAIT":	MakeUntypedConst(Int, "8"),
+		"WSTOPPED":	MakeUntypedConst(Int, "2"),
+		"WTRAPPED":	MakeUntypedConst(Int, "32"),
+		"WUNTRACED":	MakeUntypedConst(Int, "2"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "syscall"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_syscall
+// +build !gomacro_slim gomacro_syscall
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["syscall"] = Package{
+	Register("syscall", func() Package { return Package{
 	Binds: map[string]Value{
 		"AF_ALG":	ValueOf(syscall.AF_ALG),
 		"AF_APPLETALK":	ValueOf(syscall.AF_APPLETALK),
@@ -2345,5 +2348,1859 @@ func init() {
 		"WaitStatus":	TypeOf((*syscall.WaitStatus)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"AF_ALG":	MakeUntypedConst(Int, "38"),
+		"AF_APPLETALK":	MakeUntypedConst(Int, "5"),
+		"AF_ASH":	MakeUntypedConst(Int, "18"),
+		"AF_ATMPVC":	MakeUntypedConst(Int, "8"),
+		"AF_ATMSVC":	MakeUntypedConst(Int, "20"),
+		"AF_AX25":	MakeUntypedConst(Int, "3"),
+		"AF_BLUETOOTH":	MakeUntypedConst(Int, "31"),
+		"AF_BRIDGE":	MakeUntypedConst(Int, "7"),
+		"AF_CAIF":	MakeUntypedConst(Int, "37"),
+		"AF_CAN":	MakeUntypedConst(Int, "29"),
+		"AF_DECnet":	MakeUntypedConst(Int, "12"),
+		"AF_ECONET":	MakeUntypedConst(Int, "19"),
+		"AF_FILE":	MakeUntypedConst(Int, "1"),
+		"AF_IEEE802154":	MakeUntypedConst(Int, "36"),
+		"AF_INET":	MakeUntypedConst(Int, "2"),
+		"AF_INET6":	MakeUntypedConst(Int, "10"),
+		"AF_IPX":	MakeUntypedConst(Int, "4"),
+		"AF_IRDA":	MakeUntypedConst(Int, "23"),
+		"AF_ISDN":	MakeUntypedConst(Int, "34"),
+		"AF_IUCV":	MakeUntypedConst(Int, "32"),
+		"AF_KEY":	MakeUntypedConst(Int, "15"),
+		"AF_LLC":	MakeUntypedConst(Int, "26"),
+		"AF_LOCAL":	MakeUntypedConst(Int, "1"),
+		"AF_MAX":	MakeUntypedConst(Int, "41"),
+		"AF_NETBEUI":	MakeUntypedConst(Int, "13"),
+		"AF_NETLINK":	MakeUntypedConst(Int, "16"),
+		"AF_NETROM":	MakeUntypedConst(Int, "6"),
+		"AF_NFC":	MakeUntypedConst(Int, "39"),
+		"AF_PACKET":	MakeUntypedConst(Int, "17"),
+		"AF_PHONET":	MakeUntypedConst(Int, "35"),
+		"AF_PPPOX":	MakeUntypedConst(Int, "24"),
+		"AF_RDS":	MakeUntypedConst(Int, "21"),
+		"AF_ROSE":	MakeUntypedConst(Int, "11"),
+		"AF_ROUTE":	MakeUntypedConst(Int, "16"),
+		"AF_RXRPC":	MakeUntypedConst(Int, "33"),
+		"AF_SECURITY":	MakeUntypedConst(Int, "14"),
+		"AF_SNA":	MakeUntypedConst(Int, "22"),
+		"AF_TIPC":	MakeUntypedConst(Int, "30"),
+		"AF_UNIX":	MakeUntypedConst(Int, "1"),
+		"AF_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"AF_VSOCK":	MakeUntypedConst(Int, "40"),
+		"AF_WANPIPE":	MakeUntypedConst(Int, "25"),
+		"AF_X25":	MakeUntypedConst(Int, "9"),
+		"ARPHRD_ADAPT":	MakeUntypedConst(Int, "264"),
+		"ARPHRD_APPLETLK":	MakeUntypedConst(Int, "8"),
+		"ARPHRD_ARCNET":	MakeUntypedConst(Int, "7"),
+		"ARPHRD_ASH":	MakeUntypedConst(Int, "781"),
+		"ARPHRD_ATM":	MakeUntypedConst(Int, "19"),
+		"ARPHRD_AX25":	MakeUntypedConst(Int, "3"),
+		"ARPHRD_BIF":	MakeUntypedConst(Int, "775"),
+		"ARPHRD_CAIF":	MakeUntypedConst(Int, "822"),
+		"ARPHRD_CAN":	MakeUntypedConst(Int, "280"),
+		"ARPHRD_CHAOS":	MakeUntypedConst(Int, "5"),
+		"ARPHRD_CISCO":	MakeUntypedConst(Int, "513"),
+		"ARPHRD_CSLIP":	MakeUntypedConst(Int, "257"),
+		"ARPHRD_CSLIP6":	MakeUntypedConst(Int, "259"),
+		"ARPHRD_DDCMP":	MakeUntypedConst(Int, "517"),
+		"ARPHRD_DLCI":	MakeUntypedConst(Int, "15"),
+		"ARPHRD_ECONET":	MakeUntypedConst(Int, "782"),
+		"ARPHRD_EETHER":	MakeUntypedConst(Int, "2"),
+		"ARPHRD_ETHER":	MakeUntypedConst(Int, "1"),
+		"ARPHRD_EUI64":	MakeUntypedConst(Int, "27"),
+		"ARPHRD_FCAL":	MakeUntypedConst(Int, "785"),
+		"ARPHRD_FCFABRIC":	MakeUntypedConst(Int, "787"),
+		"ARPHRD_FCPL":	MakeUntypedConst(Int, "786"),
+		"ARPHRD_FCPP":	MakeUntypedConst(Int, "784"),
+		"ARPHRD_FDDI":	MakeUntypedConst(Int, "774"),
+		"ARPHRD_FRAD":	MakeUntypedConst(Int, "770"),
+		"ARPHRD_HDLC":	MakeUntypedConst(Int, "513"),
+		"ARPHRD_HIPPI":	MakeUntypedConst(Int, "780"),
+		"ARPHRD_HWX25":	MakeUntypedConst(Int, "272"),
+		"ARPHRD_IEEE1394":	MakeUntypedConst(Int, "24"),
+		"ARPHRD_IEEE802":	MakeUntypedConst(Int, "6"),
+		"ARPHRD_IEEE80211":	MakeUntypedConst(Int, "801"),
+		"ARPHRD_IEEE80211_PRISM":	MakeUntypedConst(Int, "802"),
+		"ARPHRD_IEEE80211_RADIOTAP":	MakeUntypedConst(Int, "803"),
+		"ARPHRD_IEEE802154":	MakeUntypedConst(Int, "804"),
+		"ARPHRD_IEEE802154_MONITOR":	MakeUntypedConst(Int, "805"),
+		"ARPHRD_IEEE802_TR":	MakeUntypedConst(Int, "800"),
+		"ARPHRD_INFINIBAND":	MakeUntypedConst(Int, "32"),
+		"ARPHRD_IP6GRE":	MakeUntypedConst(Int, "823"),
+		"ARPHRD_IPDDP":	MakeUntypedConst(Int, "777"),
+		"ARPHRD_IPGRE":	MakeUntypedConst(Int, "778"),
+		"ARPHRD_IRDA":	MakeUntypedConst(Int, "783"),
+		"ARPHRD_LAPB":	MakeUntypedConst(Int, "516"),
+		"ARPHRD_LOCALTLK":	MakeUntypedConst(Int, "773"),
+		"ARPHRD_LOOPBACK":	MakeUntypedConst(Int, "772"),
+		"ARPHRD_METRICOM":	MakeUntypedConst(Int, "23"),
+		"ARPHRD_NETLINK":	MakeUntypedConst(Int, "824"),
+		"ARPHRD_NETROM":	MakeUntypedConst(Int, "0"),
+		"ARPHRD_NONE":	MakeUntypedConst(Int, "65534"),
+		"ARPHRD_PHONET":	MakeUntypedConst(Int, "820"),
+		"ARPHRD_PHONET_PIPE":	MakeUntypedConst(Int, "821"),
+		"ARPHRD_PIMREG":	MakeUntypedConst(Int, "779"),
+		"ARPHRD_PPP":	MakeUntypedConst(Int, "512"),
+		"ARPHRD_PRONET":	MakeUntypedConst(Int, "4"),
+		"ARPHRD_RAWHDLC":	MakeUntypedConst(Int, "518"),
+		"ARPHRD_ROSE":	MakeUntypedConst(Int, "270"),
+		"ARPHRD_RSRVD":	MakeUntypedConst(Int, "260"),
+		"ARPHRD_SIT":	MakeUntypedConst(Int, "776"),
+		"ARPHRD_SKIP":	MakeUntypedConst(Int, "771"),
+		"ARPHRD_SLIP":	MakeUntypedConst(Int, "256"),
+		"ARPHRD_SLIP6":	MakeUntypedConst(Int, "258"),
+		"ARPHRD_TUNNEL":	MakeUntypedConst(Int, "768"),
+		"ARPHRD_TUNNEL6":	MakeUntypedConst(Int, "769"),
+		"ARPHRD_VOID":	MakeUntypedConst(Int, "65535"),
+		"ARPHRD_X25":	MakeUntypedConst(Int, "271"),
+		"B0":	MakeUntypedConst(Int, "0"),
+		"B1000000":	MakeUntypedConst(Int, "4104"),
+		"B110":	MakeUntypedConst(Int, "3"),
+		"B115200":	MakeUntypedConst(Int, "4098"),
+		"B1152000":	MakeUntypedConst(Int, "4105"),
+		"B1200":	MakeUntypedConst(Int, "9"),
+		"B134":	MakeUntypedConst(Int, "4"),
+		"B150":	MakeUntypedConst(Int, "5"),
+		"B1500000":	MakeUntypedConst(Int, "4106"),
+		"B1800":	MakeUntypedConst(Int, "10"),
+		"B19200":	MakeUntypedConst(Int, "14"),
+		"B200":	MakeUntypedConst(Int, "6"),
+		"B2000000":	MakeUntypedConst(Int, "4107"),
+		"B230400":	MakeUntypedConst(Int, "4099"),
+		"B2400":	MakeUntypedConst(Int, "11"),
+		"B2500000":	MakeUntypedConst(Int, "4108"),
+		"B300":	MakeUntypedConst(Int, "7"),
+		"B3000000":	MakeUntypedConst(Int, "4109"),
+		"B3500000":	MakeUntypedConst(Int, "4110"),
+		"B38400":	MakeUntypedConst(Int, "15"),
+		"B4000000":	MakeUntypedConst(Int, "4111"),
+		"B460800":	MakeUntypedConst(Int, "4100"),
+		"B4800":	MakeUntypedConst(Int, "12"),
+		"B50":	MakeUntypedConst(Int, "1"),
+		"B500000":	MakeUntypedConst(Int, "4101"),
+		"B57600":	MakeUntypedConst(Int, "4097"),
+		"B576000":	MakeUntypedConst(Int, "4102"),
+		"B600":	MakeUntypedConst(Int, "8"),
+		"B75":	MakeUntypedConst(Int, "2"),
+		"B921600":	MakeUntypedConst(Int, "4103"),
+		"B9600":	MakeUntypedConst(Int, "13"),
+		"BPF_A":	MakeUntypedConst(Int, "16"),
+		"BPF_ABS":	MakeUntypedConst(Int, "32"),
+		"BPF_ADD":	MakeUntypedConst(Int, "0"),
+		"BPF_ALU":	MakeUntypedConst(Int, "4"),
+		"BPF_AND":	MakeUntypedConst(Int, "80"),
+		"BPF_B":	MakeUntypedConst(Int, "16"),
+		"BPF_DIV":	MakeUntypedConst(Int, "48"),
+		"BPF_H":	MakeUntypedConst(Int, "8"),
+		"BPF_IMM":	MakeUntypedConst(Int, "0"),
+		"BPF_IND":	MakeUntypedConst(Int, "64"),
+		"BPF_JA":	MakeUntypedConst(Int, "0"),
+		"BPF_JEQ":	MakeUntypedConst(Int, "16"),
+		"BPF_JGE":	MakeUntypedConst(Int, "48"),
+		"BPF_JGT":	MakeUntypedConst(Int, "32"),
+		"BPF_JMP":	MakeUntypedConst(Int, "5"),
+		"BPF_JSET":	MakeUntypedConst(Int, "64"),
+		"BPF_K":	MakeUntypedConst(Int, "0"),
+		"BPF_LD":	MakeUntypedConst(Int, "0"),
+		"BPF_LDX":	MakeUntypedConst(Int, "1"),
+		"BPF_LEN":	MakeUntypedConst(Int, "128"),
+		"BPF_LSH":	MakeUntypedConst(Int, "96"),
+		"BPF_MAJOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MAXINSNS":	MakeUntypedConst(Int, "4096"),
+		"BPF_MEM":	MakeUntypedConst(Int, "96"),
+		"BPF_MEMWORDS":	MakeUntypedConst(Int, "16"),
+		"BPF_MINOR_VERSION":	MakeUntypedConst(Int, "1"),
+		"BPF_MISC":	MakeUntypedConst(Int, "7"),
+		"BPF_MOD":	MakeUntypedConst(Int, "144"),
+		"BPF_MSH":	MakeUntypedConst(Int, "160"),
+		"BPF_MUL":	MakeUntypedConst(Int, "32"),
+		"BPF_NEG":	MakeUntypedConst(Int, "128"),
+		"BPF_OR":	MakeUntypedConst(Int, "64"),
+		"BPF_RET":	MakeUntypedConst(Int, "6"),
+		"BPF_RSH":	MakeUntypedConst(Int, "112"),
+		"BPF_ST":	MakeUntypedConst(Int, "2"),
+		"BPF_STX":	MakeUntypedConst(Int, "3"),
+		"BPF_SUB":	MakeUntypedConst(Int, "16"),
+		"BPF_TAX":	MakeUntypedConst(Int, "0"),
+		"BPF_TXA":	MakeUntypedConst(Int, "128"),
+		"BPF_W":	MakeUntypedConst(Int, "0"),
+		"BPF_X":	MakeUntypedConst(Int, "8"),
+		"BPF_XOR":	MakeUntypedConst(Int, "160"),
+		"BRKINT":	MakeUntypedConst(Int, "2"),
+		"CFLUSH":	MakeUntypedConst(Int, "15"),
+		"CLOCAL":	MakeUntypedConst(Int, "2048"),
+		"CLONE_CHILD_CLEARTID":	MakeUntypedConst(Int, "2097152"),
+		"CLONE_CHILD_SETTID":	MakeUntypedConst(Int, "16777216"),
+		"CLONE_DETACHED":	MakeUntypedConst(Int, "4194304"),
+		"CLONE_FILES":	MakeUntypedConst(Int, "1024"),
+		"CLONE_FS":	MakeUntypedConst(Int, "512"),
+		"CLONE_IO":	MakeUntypedConst(Int, "2147483648"),
+		"CLONE_NEWIPC":	MakeUntypedConst(Int, "134217728"),
+		"CLONE_NEWNET":	MakeUntypedConst(Int, "1073741824"),
+		"CLONE_NEWNS":	MakeUntypedConst(Int, "131072"),
+		"CLONE_NEWPID":	MakeUntypedConst(Int, "536870912"),
+		"CLONE_NEWUSER":	MakeUntypedConst(Int, "268435456"),
+		"CLONE_NEWUTS":	MakeUntypedConst(Int, "67108864"),
+		"CLONE_PARENT":	MakeUntypedConst(Int, "32768"),
+		"CLONE_PARENT_SETTID":	MakeUntypedConst(Int, "1048576"),
+		"CLONE_PTRACE":	MakeUntypedConst(Int, "8192"),
+		"CLONE_SETTLS":	MakeUntypedConst(Int, "524288"),
+		"CLONE_SIGHAND":	MakeUntypedConst(Int, "2048"),
+		"CLONE_SYSVSEM":	MakeUntypedConst(Int, "262144"),
+		"CLONE_THREAD":	MakeUntypedConst(Int, "65536"),
+		"CLONE_UNTRACED":	MakeUntypedConst(Int, "8388608"),
+		"CLONE_VFORK":	MakeUntypedConst(Int, "16384"),
+		"CLONE_VM":	MakeUntypedConst(Int, "256"),
+		"CREAD":	MakeUntypedConst(Int, "128"),
+		"CS5":	MakeUntypedConst(Int, "0"),
+		"CS6":	MakeUntypedConst(Int, "16"),
+		"CS7":	MakeUntypedConst(Int, "32"),
+		"CS8":	MakeUntypedConst(Int, "48"),
+		"CSIGNAL":	MakeUntypedConst(Int, "255"),
+		"CSIZE":	MakeUntypedConst(Int, "48"),
+		"CSTART":	MakeUntypedConst(Int, "17"),
+		"CSTATUS":	MakeUntypedConst(Int, "0"),
+		"CSTOP":	MakeUntypedConst(Int, "19"),
+		"CSTOPB":	MakeUntypedConst(Int, "64"),
+		"CSUSP":	MakeUntypedConst(Int, "26"),
+		"DT_BLK":	MakeUntypedConst(Int, "6"),
+		"DT_CHR":	MakeUntypedConst(Int, "2"),
+		"DT_DIR":	MakeUntypedConst(Int, "4"),
+		"DT_FIFO":	MakeUntypedConst(Int, "1"),
+		"DT_LNK":	MakeUntypedConst(Int, "10"),
+		"DT_REG":	MakeUntypedConst(Int, "8"),
+		"DT_SOCK":	MakeUntypedConst(Int, "12"),
+		"DT_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"DT_WHT":	MakeUntypedConst(Int, "14"),
+		"ECHO":	MakeUntypedConst(Int, "8"),
+		"ECHOCTL":	MakeUntypedConst(Int, "512"),
+		"ECHOE":	MakeUntypedConst(Int, "16"),
+		"ECHOK":	MakeUntypedConst(Int, "32"),
+		"ECHOKE":	MakeUntypedConst(Int, "2048"),
+		"ECHONL":	MakeUntypedConst(Int, "64"),
+		"ECHOPRT":	MakeUntypedConst(Int, "1024"),
+		"ENCODING_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"ENCODING_FM_MARK":	MakeUntypedConst(Int, "3"),
+		"ENCODING_FM_SPACE":	MakeUntypedConst(Int, "4"),
+		"ENCODING_MANCHESTER":	MakeUntypedConst(Int, "5"),
+		"ENCODING_NRZ":	MakeUntypedConst(Int, "1"),
+		"ENCODING_NRZI":	MakeUntypedConst(Int, "2"),
+		"EPOLLERR":	MakeUntypedConst(Int, "8"),
+		"EPOLLET":	MakeUntypedConst(Int, "2147483648"),
+		"EPOLLHUP":	MakeUntypedConst(Int, "16"),
+		"EPOLLIN":	MakeUntypedConst(Int, "1"),
+		"EPOLLMSG":	MakeUntypedConst(Int, "1024"),
+		"EPOLLONESHOT":	MakeUntypedConst(Int, "1073741824"),
+		"EPOLLOUT":	MakeUntypedConst(Int, "4"),
+		"EPOLLPRI":	MakeUntypedConst(Int, "2"),
+		"EPOLLRDBAND":	MakeUntypedConst(Int, "128"),
+		"EPOLLRDHUP":	MakeUntypedConst(Int, "8192"),
+		"EPOLLRDNORM":	MakeUntypedConst(Int, "64"),
+		"EPOLLWAKEUP":	MakeUntypedConst(Int, "536870912"),
+		"EPOLLWRBAND":	MakeUntypedConst(Int, "512"),
+		"EPOLLWRNORM":	MakeUntypedConst(Int, "256"),
+		"EPOLL_CLOEXEC":	MakeUntypedConst(Int, "524288"),
+		"EPOLL_CTL_ADD":	MakeUntypedConst(Int, "1"),
+		"EPOLL_CTL_DEL":	MakeUntypedConst(Int, "2"),
+		"EPOLL_CTL_MOD":	MakeUntypedConst(Int, "3"),
+		"ETH_P_1588":	MakeUntypedConst(Int, "35063"),
+		"ETH_P_8021AD":	MakeUntypedConst(Int, "34984"),
+		"ETH_P_8021AH":	MakeUntypedConst(Int, "35047"),
+		"ETH_P_8021Q":	MakeUntypedConst(Int, "33024"),
+		"ETH_P_802_2":	MakeUntypedConst(Int, "4"),
+		"ETH_P_802_3":	MakeUntypedConst(Int, "1"),
+		"ETH_P_802_3_MIN":	MakeUntypedConst(Int, "1536"),
+		"ETH_P_802_EX1":	MakeUntypedConst(Int, "34997"),
+		"ETH_P_AARP":	MakeUntypedConst(Int, "33011"),
+		"ETH_P_AF_IUCV":	MakeUntypedConst(Int, "64507"),
+		"ETH_P_ALL":	MakeUntypedConst(Int, "3"),
+		"ETH_P_AOE":	MakeUntypedConst(Int, "34978"),
+		"ETH_P_ARCNET":	MakeUntypedConst(Int, "26"),
+		"ETH_P_ARP":	MakeUntypedConst(Int, "2054"),
+		"ETH_P_ATALK":	MakeUntypedConst(Int, "32923"),
+		"ETH_P_ATMFATE":	MakeUntypedConst(Int, "34948"),
+		"ETH_P_ATMMPOA":	MakeUntypedConst(Int, "34892"),
+		"ETH_P_AX25":	MakeUntypedConst(Int, "2"),
+		"ETH_P_BATMAN":	MakeUntypedConst(Int, "17157"),
+		"ETH_P_BPQ":	MakeUntypedConst(Int, "2303"),
+		"ETH_P_CAIF":	MakeUntypedConst(Int, "247"),
+		"ETH_P_CAN":	MakeUntypedConst(Int, "12"),
+		"ETH_P_CANFD":	MakeUntypedConst(Int, "13"),
+		"ETH_P_CONTROL":	MakeUntypedConst(Int, "22"),
+		"ETH_P_CUST":	MakeUntypedConst(Int, "24582"),
+		"ETH_P_DDCMP":	MakeUntypedConst(Int, "6"),
+		"ETH_P_DEC":	MakeUntypedConst(Int, "24576"),
+		"ETH_P_DIAG":	MakeUntypedConst(Int, "24581"),
+		"ETH_P_DNA_DL":	MakeUntypedConst(Int, "24577"),
+		"ETH_P_DNA_RC":	MakeUntypedConst(Int, "24578"),
+		"ETH_P_DNA_RT":	MakeUntypedConst(Int, "24579"),
+		"ETH_P_DSA":	MakeUntypedConst(Int, "27"),
+		"ETH_P_ECONET":	MakeUntypedConst(Int, "24"),
+		"ETH_P_EDSA":	MakeUntypedConst(Int, "56026"),
+		"ETH_P_FCOE":	MakeUntypedConst(Int, "35078"),
+		"ETH_P_FIP":	MakeUntypedConst(Int, "35092"),
+		"ETH_P_HDLC":	MakeUntypedConst(Int, "25"),
+		"ETH_P_IEEE802154":	MakeUntypedConst(Int, "246"),
+		"ETH_P_IEEEPUP":	MakeUntypedConst(Int, "2560"),
+		"ETH_P_IEEEPUPAT":	MakeUntypedConst(Int, "2561"),
+		"ETH_P_IP":	MakeUntypedConst(Int, "2048"),
+		"ETH_P_IPV6":	MakeUntypedConst(Int, "34525"),
+		"ETH_P_IPX":	MakeUntypedConst(Int, "33079"),
+		"ETH_P_IRDA":	MakeUntypedConst(Int, "23"),
+		"ETH_P_LAT":	MakeUntypedConst(Int, "24580"),
+		"ETH_P_LINK_CTL":	MakeUntypedConst(Int, "34924"),
+		"ETH_P_LOCALTALK":	MakeUntypedConst(Int, "9"),
+		"ETH_P_LOOP":	MakeUntypedConst(Int, "96"),
+		"ETH_P_MOBITEX":	MakeUntypedConst(Int, "21"),
+		"ETH_P_MPLS_MC":	MakeUntypedConst(Int, "34888"),
+		"ETH_P_MPLS_UC":	MakeUntypedConst(Int, "34887"),
+		"ETH_P_MVRP":	MakeUntypedConst(Int, "35061"),
+		"ETH_P_PAE":	MakeUntypedConst(Int, "34958"),
+		"ETH_P_PAUSE":	MakeUntypedConst(Int, "34824"),
+		"ETH_P_PHONET":	MakeUntypedConst(Int, "245"),
+		"ETH_P_PPPTALK":	MakeUntypedConst(Int, "16"),
+		"ETH_P_PPP_DISC":	MakeUntypedConst(Int, "34915"),
+		"ETH_P_PPP_MP":	MakeUntypedConst(Int, "8"),
+		"ETH_P_PPP_SES":	MakeUntypedConst(Int, "34916"),
+		"ETH_P_PRP":	MakeUntypedConst(Int, "35067"),
+		"ETH_P_PUP":	MakeUntypedConst(Int, "512"),
+		"ETH_P_PUPAT":	MakeUntypedConst(Int, "513"),
+		"ETH_P_QINQ1":	MakeUntypedConst(Int, "37120"),
+		"ETH_P_QINQ2":	MakeUntypedConst(Int, "37376"),
+		"ETH_P_QINQ3":	MakeUntypedConst(Int, "37632"),
+		"ETH_P_RARP":	MakeUntypedConst(Int, "32821"),
+		"ETH_P_SCA":	MakeUntypedConst(Int, "24583"),
+		"ETH_P_SLOW":	MakeUntypedConst(Int, "34825"),
+		"ETH_P_SNAP":	MakeUntypedConst(Int, "5"),
+		"ETH_P_TDLS":	MakeUntypedConst(Int, "35085"),
+		"ETH_P_TEB":	MakeUntypedConst(Int, "25944"),
+		"ETH_P_TIPC":	MakeUntypedConst(Int, "35018"),
+		"ETH_P_TRAILER":	MakeUntypedConst(Int, "28"),
+		"ETH_P_TR_802_2":	MakeUntypedConst(Int, "17"),
+		"ETH_P_WAN_PPP":	MakeUntypedConst(Int, "7"),
+		"ETH_P_WCCP":	MakeUntypedConst(Int, "34878"),
+		"ETH_P_X25":	MakeUntypedConst(Int, "2053"),
+		"EXTA":	MakeUntypedConst(Int, "14"),
+		"EXTB":	MakeUntypedConst(Int, "15"),
+		"EXTPROC":	MakeUntypedConst(Int, "65536"),
+		"FD_CLOEXEC":	MakeUntypedConst(Int, "1"),
+		"FD_SETSIZE":	MakeUntypedConst(Int, "1024"),
+		"FLUSHO":	MakeUntypedConst(Int, "4096"),
+		"F_DUPFD":	MakeUntypedConst(Int, "0"),
+		"F_DUPFD_CLOEXEC":	MakeUntypedConst(Int, "1030"),
+		"F_EXLCK":	MakeUntypedConst(Int, "4"),
+		"F_GETFD":	MakeUntypedConst(Int, "1"),
+		"F_GETFL":	MakeUntypedConst(Int, "3"),
+		"F_GETLEASE":	MakeUntypedConst(Int, "1025"),
+		"F_GETLK":	MakeUntypedConst(Int, "5"),
+		"F_GETLK64":	MakeUntypedConst(Int, "5"),
+		"F_GETOWN":	MakeUntypedConst(Int, "9"),
+		"F_GETOWN_EX":	MakeUntypedConst(Int, "16"),
+		"F_GETPIPE_SZ":	MakeUntypedConst(Int, "1032"),
+		"F_GETSIG":	MakeUntypedConst(Int, "11"),
+		"F_LOCK":	MakeUntypedConst(Int, "1"),
+		"F_NOTIFY":	MakeUntypedConst(Int, "1026"),
+		"F_OK":	MakeUntypedConst(Int, "0"),
+		"F_RDLCK":	MakeUntypedConst(Int, "0"),
+		"F_SETFD":	MakeUntypedConst(Int, "2"),
+		"F_SETFL":	MakeUntypedConst(Int, "4"),
+		"F_SETLEASE":	MakeUntypedConst(Int, "1024"),
+		"F_SETLK":	MakeUntypedConst(Int, "6"),
+		"F_SETLK64":	MakeUntypedConst(Int, "6"),
+		"F_SETLKW":	MakeUntypedConst(Int, "7"),
+		"F_SETLKW64":	MakeUntypedConst(Int, "7"),
+		"F_SETOWN":	MakeUntypedConst(Int, "8"),
+		"F_SETOWN_EX":	MakeUntypedConst(Int, "15"),
+		"F_SETPIPE_SZ":	MakeUntypedConst(Int, "1031"),
+		"F_SETSIG":	MakeUntypedConst(Int, "10"),
+		"F_SHLCK":	MakeUntypedConst(Int, "8"),
+		"F_TEST":	MakeUntypedConst(Int, "3"),
+		"F_TLOCK":	MakeUntypedConst(Int, "2"),
+		"F_ULOCK":	MakeUntypedConst(Int, "0"),
+		"F_UNLCK":	MakeUntypedConst(Int, "2"),
+		"F_WRLCK":	MakeUntypedConst(Int, "1"),
+		"HUPCL":	MakeUntypedConst(Int, "1024"),
+		"ICANON":	MakeUntypedConst(Int, "2"),
+		"ICMPV6_FILTER":	MakeUntypedConst(Int, "1"),
+		"ICRNL":	MakeUntypedConst(Int, "256"),
+		"IEXTEN":	MakeUntypedConst(Int, "32768"),
+		"IFA_ADDRESS":	MakeUntypedConst(Int, "1"),
+		"IFA_ANYCAST":	MakeUntypedConst(Int, "5"),
+		"IFA_BROADCAST":	MakeUntypedConst(Int, "4"),
+		"IFA_CACHEINFO":	MakeUntypedConst(Int, "6"),
+		"IFA_F_DADFAILED":	MakeUntypedConst(Int, "8"),
+		"IFA_F_DEPRECATED":	MakeUntypedConst(Int, "32"),
+		"IFA_F_HOMEADDRESS":	MakeUntypedConst(Int, "16"),
+		"IFA_F_NODAD":	MakeUntypedConst(Int, "2"),
+		"IFA_F_OPTIMISTIC":	MakeUntypedConst(Int, "4"),
+		"IFA_F_PERMANENT":	MakeUntypedConst(Int, "128"),
+		"IFA_F_SECONDARY":	MakeUntypedConst(Int, "1"),
+		"IFA_F_TEMPORARY":	MakeUntypedConst(Int, "1"),
+		"IFA_F_TENTATIVE":	MakeUntypedConst(Int, "64"),
+		"IFA_LABEL":	MakeUntypedConst(Int, "3"),
+		"IFA_LOCAL":	MakeUntypedConst(Int, "2"),
+		"IFA_MAX":	MakeUntypedConst(Int, "7"),
+		"IFA_MULTICAST":	MakeUntypedConst(Int, "7"),
+		"IFA_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"IFF_802_1Q_VLAN":	MakeUntypedConst(Int, "1"),
+		"IFF_ALLMULTI":	MakeUntypedConst(Int, "512"),
+		"IFF_ATTACH_QUEUE":	MakeUntypedConst(Int, "512"),
+		"IFF_AUTOMEDIA":	MakeUntypedConst(Int, "16384"),
+		"IFF_BONDING":	MakeUntypedConst(Int, "32"),
+		"IFF_BRIDGE_PORT":	MakeUntypedConst(Int, "16384"),
+		"IFF_BROADCAST":	MakeUntypedConst(Int, "2"),
+		"IFF_DEBUG":	MakeUntypedConst(Int, "4"),
+		"IFF_DETACH_QUEUE":	MakeUntypedConst(Int, "1024"),
+		"IFF_DISABLE_NETPOLL":	MakeUntypedConst(Int, "4096"),
+		"IFF_DONT_BRIDGE":	MakeUntypedConst(Int, "2048"),
+		"IFF_DORMANT":	MakeUntypedConst(Int, "131072"),
+		"IFF_DYNAMIC":	MakeUntypedConst(Int, "32768"),
+		"IFF_EBRIDGE":	MakeUntypedConst(Int, "2"),
+		"IFF_ECHO":	MakeUntypedConst(Int, "262144"),
+		"IFF_ISATAP":	MakeUntypedConst(Int, "128"),
+		"IFF_LIVE_ADDR_CHANGE":	MakeUntypedConst(Int, "1048576"),
+		"IFF_LOOPBACK":	MakeUntypedConst(Int, "8"),
+		"IFF_LOWER_UP":	MakeUntypedConst(Int, "65536"),
+		"IFF_MACVLAN":	MakeUntypedConst(Int, "2097152"),
+		"IFF_MACVLAN_PORT":	MakeUntypedConst(Int, "8192"),
+		"IFF_MASTER":	MakeUntypedConst(Int, "1024"),
+		"IFF_MASTER_8023AD":	MakeUntypedConst(Int, "8"),
+		"IFF_MASTER_ALB":	MakeUntypedConst(Int, "16"),
+		"IFF_MASTER_ARPMON":	MakeUntypedConst(Int, "256"),
+		"IFF_MULTICAST":	MakeUntypedConst(Int, "4096"),
+		"IFF_MULTI_QUEUE":	MakeUntypedConst(Int, "256"),
+		"IFF_NOARP":	MakeUntypedConst(Int, "128"),
+		"IFF_NOFILTER":	MakeUntypedConst(Int, "4096"),
+		"IFF_NOTRAILERS":	MakeUntypedConst(Int, "32"),
+		"IFF_NO_PI":	MakeUntypedConst(Int, "4096"),
+		"IFF_ONE_QUEUE":	MakeUntypedConst(Int, "8192"),
+		"IFF_OVS_DATAPATH":	MakeUntypedConst(Int, "32768"),
+		"IFF_PERSIST":	MakeUntypedConst(Int, "2048"),
+		"IFF_POINTOPOINT":	MakeUntypedConst(Int, "16"),
+		"IFF_PORTSEL":	MakeUntypedConst(Int, "8192"),
+		"IFF_PROMISC":	MakeUntypedConst(Int, "256"),
+		"IFF_RUNNING":	MakeUntypedConst(Int, "64"),
+		"IFF_SLAVE":	MakeUntypedConst(Int, "2048"),
+		"IFF_SLAVE_INACTIVE":	MakeUntypedConst(Int, "4"),
+		"IFF_SLAVE_NEEDARP":	MakeUntypedConst(Int, "64"),
+		"IFF_SUPP_NOFCS":	MakeUntypedConst(Int, "524288"),
+		"IFF_TAP":	MakeUntypedConst(Int, "2"),
+		"IFF_TEAM_PORT":	MakeUntypedConst(Int, "262144"),
+		"IFF_TUN":	MakeUntypedConst(Int, "1"),
+		"IFF_TUN_EXCL":	MakeUntypedConst(Int, "32768"),
+		"IFF_TX_SKB_SHARING":	MakeUntypedConst(Int, "65536"),
+		"IFF_UNICAST_FLT":	MakeUntypedConst(Int, "131072"),
+		"IFF_UP":	MakeUntypedConst(Int, "1"),
+		"IFF_VNET_HDR":	MakeUntypedConst(Int, "16384"),
+		"IFF_VOLATILE":	MakeUntypedConst(Int, "461914"),
+		"IFF_WAN_HDLC":	MakeUntypedConst(Int, "512"),
+		"IFF_XMIT_DST_RELEASE":	MakeUntypedConst(Int, "1024"),
+		"IFLA_ADDRESS":	MakeUntypedConst(Int, "1"),
+		"IFLA_BROADCAST":	MakeUntypedConst(Int, "2"),
+		"IFLA_COST":	MakeUntypedConst(Int, "8"),
+		"IFLA_IFALIAS":	MakeUntypedConst(Int, "20"),
+		"IFLA_IFNAME":	MakeUntypedConst(Int, "3"),
+		"IFLA_LINK":	MakeUntypedConst(Int, "5"),
+		"IFLA_LINKINFO":	MakeUntypedConst(Int, "18"),
+		"IFLA_LINKMODE":	MakeUntypedConst(Int, "17"),
+		"IFLA_MAP":	MakeUntypedConst(Int, "14"),
+		"IFLA_MASTER":	MakeUntypedConst(Int, "10"),
+		"IFLA_MAX":	MakeUntypedConst(Int, "36"),
+		"IFLA_MTU":	MakeUntypedConst(Int, "4"),
+		"IFLA_NET_NS_PID":	MakeUntypedConst(Int, "19"),
+		"IFLA_OPERSTATE":	MakeUntypedConst(Int, "16"),
+		"IFLA_PRIORITY":	MakeUntypedConst(Int, "9"),
+		"IFLA_PROTINFO":	MakeUntypedConst(Int, "12"),
+		"IFLA_QDISC":	MakeUntypedConst(Int, "6"),
+		"IFLA_STATS":	MakeUntypedConst(Int, "7"),
+		"IFLA_TXQLEN":	MakeUntypedConst(Int, "13"),
+		"IFLA_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"IFLA_WEIGHT":	MakeUntypedConst(Int, "15"),
+		"IFLA_WIRELESS":	MakeUntypedConst(Int, "11"),
+		"IFNAMSIZ":	MakeUntypedConst(Int, "16"),
+		"IGNBRK":	MakeUntypedConst(Int, "1"),
+		"IGNCR":	MakeUntypedConst(Int, "128"),
+		"IGNPAR":	MakeUntypedConst(Int, "4"),
+		"IMAXBEL":	MakeUntypedConst(Int, "8192"),
+		"INLCR":	MakeUntypedConst(Int, "64"),
+		"INPCK":	MakeUntypedConst(Int, "16"),
+		"IN_ACCESS":	MakeUntypedConst(Int, "1"),
+		"IN_ALL_EVENTS":	MakeUntypedConst(Int, "4095"),
+		"IN_ATTRIB":	MakeUntypedConst(Int, "4"),
+		"IN_CLASSA_HOST":	MakeUntypedConst(Int, "16777215"),
+		"IN_CLASSA_MAX":	MakeUntypedConst(Int, "128"),
+		"IN_CLASSA_NET":	MakeUntypedConst(Int, "4278190080"),
+		"IN_CLASSA_NSHIFT":	MakeUntypedConst(Int, "24"),
+		"IN_CLASSB_HOST":	MakeUntypedConst(Int, "65535"),
+		"IN_CLASSB_MAX":	MakeUntypedConst(Int, "65536"),
+		"IN_CLASSB_NET":	MakeUntypedConst(Int, "4294901760"),
+		"IN_CLASSB_NSHIFT":	MakeUntypedConst(Int, "16"),
+		"IN_CLASSC_HOST":	MakeUntypedConst(Int, "255"),
+		"IN_CLASSC_NET":	MakeUntypedConst(Int, "4294967040"),
+		"IN_CLASSC_NSHIFT":	MakeUntypedConst(Int, "8"),
+		"IN_CLOEXEC":	MakeUntypedConst(Int, "524288"),
+		"IN_CLOSE":	MakeUntypedConst(Int, "24"),
+		"IN_CLOSE_NOWRITE":	MakeUntypedConst(Int, "16"),
+		"IN_CLOSE_WRITE":	MakeUntypedConst(Int, "8"),
+		"IN_CREATE":	MakeUntypedConst(Int, "256"),
+		"IN_DELETE":	MakeUntypedConst(Int, "512"),
+		"IN_DELETE_SELF":	MakeUntypedConst(Int, "1024"),
+		"IN_DONT_FOLLOW":	MakeUntypedConst(Int, "33554432"),
+		"IN_EXCL_UNLINK":	MakeUntypedConst(Int, "67108864"),
+		"IN_IGNORED":	MakeUntypedConst(Int, "32768"),
+		"IN_ISDIR":	MakeUntypedConst(Int, "1073741824"),
+		"IN_LOOPBACKNET":	MakeUntypedConst(Int, "127"),
+		"IN_MASK_ADD":	MakeUntypedConst(Int, "536870912"),
+		"IN_MODIFY":	MakeUntypedConst(Int, "2"),
+		"IN_MOVE":	MakeUntypedConst(Int, "192"),
+		"IN_MOVED_FROM":	MakeUntypedConst(Int, "64"),
+		"IN_MOVED_TO":	MakeUntypedConst(Int, "128"),
+		"IN_MOVE_SELF":	MakeUntypedConst(Int, "2048"),
+		"IN_NONBLOCK":	MakeUntypedConst(Int, "2048"),
+		"IN_ONESHOT":	MakeUntypedConst(Int, "2147483648"),
+		"IN_ONLYDIR":	MakeUntypedConst(Int, "16777216"),
+		"IN_OPEN":	MakeUntypedConst(Int, "32"),
+		"IN_Q_OVERFLOW":	MakeUntypedConst(Int, "16384"),
+		"IN_UNMOUNT":	MakeUntypedConst(Int, "8192"),
+		"IPPROTO_AH":	MakeUntypedConst(Int, "51"),
+		"IPPROTO_BEETPH":	MakeUntypedConst(Int, "94"),
+		"IPPROTO_COMP":	MakeUntypedConst(Int, "108"),
+		"IPPROTO_DCCP":	MakeUntypedConst(Int, "33"),
+		"IPPROTO_DSTOPTS":	MakeUntypedConst(Int, "60"),
+		"IPPROTO_EGP":	MakeUntypedConst(Int, "8"),
+		"IPPROTO_ENCAP":	MakeUntypedConst(Int, "98"),
+		"IPPROTO_ESP":	MakeUntypedConst(Int, "50"),
+		"IPPROTO_FRAGMENT":	MakeUntypedConst(Int, "44"),
+		"IPPROTO_GRE":	MakeUntypedConst(Int, "47"),
+		"IPPROTO_HOPOPTS":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_ICMP":	MakeUntypedConst(Int, "1"),
+		"IPPROTO_ICMPV6":	MakeUntypedConst(Int, "58"),
+		"IPPROTO_IDP":	MakeUntypedConst(Int, "22"),
+		"IPPROTO_IGMP":	MakeUntypedConst(Int, "2"),
+		"IPPROTO_IP":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_IPIP":	MakeUntypedConst(Int, "4"),
+		"IPPROTO_IPV6":	MakeUntypedConst(Int, "41"),
+		"IPPROTO_MH":	MakeUntypedConst(Int, "135"),
+		"IPPROTO_MTP":	MakeUntypedConst(Int, "92"),
+		"IPPROTO_NONE":	MakeUntypedConst(Int, "59"),
+		"IPPROTO_PIM":	MakeUntypedConst(Int, "103"),
+		"IPPROTO_PUP":	MakeUntypedConst(Int, "12"),
+		"IPPROTO_RAW":	MakeUntypedConst(Int, "255"),
+		"IPPROTO_ROUTING":	MakeUntypedConst(Int, "43"),
+		"IPPROTO_RSVP":	MakeUntypedConst(Int, "46"),
+		"IPPROTO_SCTP":	MakeUntypedConst(Int, "132"),
+		"IPPROTO_TCP":	MakeUntypedConst(Int, "6"),
+		"IPPROTO_TP":	MakeUntypedConst(Int, "29"),
+		"IPPROTO_UDP":	MakeUntypedConst(Int, "17"),
+		"IPPROTO_UDPLITE":	MakeUntypedConst(Int, "136"),
+		"IPV6_2292DSTOPTS":	MakeUntypedConst(Int, "4"),
+		"IPV6_2292HOPLIMIT":	MakeUntypedConst(Int, "8"),
+		"IPV6_2292HOPOPTS":	MakeUntypedConst(Int, "3"),
+		"IPV6_2292PKTINFO":	MakeUntypedConst(Int, "2"),
+		"IPV6_2292PKTOPTIONS":	MakeUntypedConst(Int, "6"),
+		"IPV6_2292RTHDR":	MakeUntypedConst(Int, "5"),
+		"IPV6_ADDRFORM":	MakeUntypedConst(Int, "1"),
+		"IPV6_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "20"),
+		"IPV6_AUTHHDR":	MakeUntypedConst(Int, "10"),
+		"IPV6_CHECKSUM":	MakeUntypedConst(Int, "7"),
+		"IPV6_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "21"),
+		"IPV6_DSTOPTS":	MakeUntypedConst(Int, "59"),
+		"IPV6_HOPLIMIT":	MakeUntypedConst(Int, "52"),
+		"IPV6_HOPOPTS":	MakeUntypedConst(Int, "54"),
+		"IPV6_IPSEC_POLICY":	MakeUntypedConst(Int, "34"),
+		"IPV6_JOIN_ANYCAST":	MakeUntypedConst(Int, "27"),
+		"IPV6_JOIN_GROUP":	MakeUntypedConst(Int, "20"),
+		"IPV6_LEAVE_ANYCAST":	MakeUntypedConst(Int, "28"),
+		"IPV6_LEAVE_GROUP":	MakeUntypedConst(Int, "21"),
+		"IPV6_MTU":	MakeUntypedConst(Int, "24"),
+		"IPV6_MTU_DISCOVER":	MakeUntypedConst(Int, "23"),
+		"IPV6_MULTICAST_HOPS":	MakeUntypedConst(Int, "18"),
+		"IPV6_MULTICAST_IF":	MakeUntypedConst(Int, "17"),
+		"IPV6_MULTICAST_LOOP":	MakeUntypedConst(Int, "19"),
+		"IPV6_NEXTHOP":	MakeUntypedConst(Int, "9"),
+		"IPV6_PKTINFO":	MakeUntypedConst(Int, "50"),
+		"IPV6_PMTUDISC_DO":	MakeUntypedConst(Int, "2"),
+		"IPV6_PMTUDISC_DONT":	MakeUntypedConst(Int, "0"),
+		"IPV6_PMTUDISC_PROBE":	MakeUntypedConst(Int, "3"),
+		"IPV6_PMTUDISC_WANT":	MakeUntypedConst(Int, "1"),
+		"IPV6_RECVDSTOPTS":	MakeUntypedConst(Int, "58"),
+		"IPV6_RECVERR":	MakeUntypedConst(Int, "25"),
+		"IPV6_RECVHOPLIMIT":	MakeUntypedConst(Int, "51"),
+		"IPV6_RECVHOPOPTS":	MakeUntypedConst(Int, "53"),
+		"IPV6_RECVPKTINFO":	MakeUntypedConst(Int, "49"),
+		"IPV6_RECVRTHDR":	MakeUntypedConst(Int, "56"),
+		"IPV6_RECVTCLASS":	MakeUntypedConst(Int, "66"),
+		"IPV6_ROUTER_ALERT":	MakeUntypedConst(Int, "22"),
+		"IPV6_RTHDR":	MakeUntypedConst(Int, "57"),
+		"IPV6_RTHDRDSTOPTS":	MakeUntypedConst(Int, "55"),
+		"IPV6_RTHDR_LOOSE":	MakeUntypedConst(Int, "0"),
+		"IPV6_RTHDR_STRICT":	MakeUntypedConst(Int, "1"),
+		"IPV6_RTHDR_TYPE_0":	MakeUntypedConst(Int, "0"),
+		"IPV6_RXDSTOPTS":	MakeUntypedConst(Int, "59"),
+		"IPV6_RXHOPOPTS":	MakeUntypedConst(Int, "54"),
+		"IPV6_TCLASS":	MakeUntypedConst(Int, "67"),
+		"IPV6_UNICAST_HOPS":	MakeUntypedConst(Int, "16"),
+		"IPV6_V6ONLY":	MakeUntypedConst(Int, "26"),
+		"IPV6_XFRM_POLICY":	MakeUntypedConst(Int, "35"),
+		"IP_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "35"),
+		"IP_ADD_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "39"),
+		"IP_BLOCK_SOURCE":	MakeUntypedConst(Int, "38"),
+		"IP_DEFAULT_MULTICAST_LOOP":	MakeUntypedConst(Int, "1"),
+		"IP_DEFAULT_MULTICAST_TTL":	MakeUntypedConst(Int, "1"),
+		"IP_DF":	MakeUntypedConst(Int, "16384"),
+		"IP_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "36"),
+		"IP_DROP_SOURCE_MEMBERSHIP":	MakeUntypedConst(Int, "40"),
+		"IP_FREEBIND":	MakeUntypedConst(Int, "15"),
+		"IP_HDRINCL":	MakeUntypedConst(Int, "3"),
+		"IP_IPSEC_POLICY":	MakeUntypedConst(Int, "16"),
+		"IP_MAXPACKET":	MakeUntypedConst(Int, "65535"),
+		"IP_MAX_MEMBERSHIPS":	MakeUntypedConst(Int, "20"),
+		"IP_MF":	MakeUntypedConst(Int, "8192"),
+		"IP_MINTTL":	MakeUntypedConst(Int, "21"),
+		"IP_MSFILTER":	MakeUntypedConst(Int, "41"),
+		"IP_MSS":	MakeUntypedConst(Int, "576"),
+		"IP_MTU":	MakeUntypedConst(Int, "14"),
+		"IP_MTU_DISCOVER":	MakeUntypedConst(Int, "10"),
+		"IP_MULTICAST_ALL":	MakeUntypedConst(Int, "49"),
+		"IP_MULTICAST_IF":	MakeUntypedConst(Int, "32"),
+		"IP_MULTICAST_LOOP":	MakeUntypedConst(Int, "34"),
+		"IP_MULTICAST_TTL":	MakeUntypedConst(Int, "33"),
+		"IP_OFFMASK":	MakeUntypedConst(Int, "8191"),
+		"IP_OPTIONS":	MakeUntypedConst(Int, "4"),
+		"IP_ORIGDSTADDR":	MakeUntypedConst(Int, "20"),
+		"IP_PASSSEC":	MakeUntypedConst(Int, "18"),
+		"IP_PKTINFO":	MakeUntypedConst(Int, "8"),
+		"IP_PKTOPTIONS":	MakeUntypedConst(Int, "9"),
+		"IP_PMTUDISC":	MakeUntypedConst(Int, "10"),
+		"IP_PMTUDISC_DO":	MakeUntypedConst(Int, "2"),
+		"IP_PMTUDISC_DONT":	MakeUntypedConst(Int, "0"),
+		"IP_PMTUDISC_PROBE":	MakeUntypedConst(Int, "3"),
+		"IP_PMTUDISC_WANT":	MakeUntypedConst(Int, "1"),
+		"IP_RECVERR":	MakeUntypedConst(Int, "11"),
+		"IP_RECVOPTS":	MakeUntypedConst(Int, "6"),
+		"IP_RECVORIGDSTADDR":	MakeUntypedConst(Int, "20"),
+		"IP_RECVRETOPTS":	MakeUntypedConst(Int, "7"),
+		"IP_RECVTOS":	MakeUntypedConst(Int, "13"),
+		"IP_RECVTTL":	MakeUntypedConst(Int, "12"),
+		"IP_RETOPTS":	MakeUntypedConst(Int, "7"),
+		"IP_RF":	MakeUntypedConst(Int, "32768"),
+		"IP_ROUTER_ALERT":	MakeUntypedConst(Int, "5"),
+		"IP_TOS":	MakeUntypedConst(Int, "1"),
+		"IP_TRANSPARENT":	MakeUntypedConst(Int, "19"),
+		"IP_TTL":	MakeUntypedConst(Int, "2"),
+		"IP_UNBLOCK_SOURCE":	MakeUntypedConst(Int, "37"),
+		"IP_UNICAST_IF":	MakeUntypedConst(Int, "50"),
+		"IP_XFRM_POLICY":	MakeUntypedConst(Int, "17"),
+		"ISIG":	MakeUntypedConst(Int, "1"),
+		"ISTRIP":	MakeUntypedConst(Int, "32"),
+		"IUCLC":	MakeUntypedConst(Int, "512"),
+		"IUTF8":	MakeUntypedConst(Int, "16384"),
+		"IXANY":	MakeUntypedConst(Int, "2048"),
+		"IXOFF":	MakeUntypedConst(Int, "4096"),
+		"IXON":	MakeUntypedConst(Int, "1024"),
+		"ImplementsGetwd":	MakeUntypedConst(Bool, "true"),
+		"LINUX_REBOOT_CMD_CAD_OFF":	MakeUntypedConst(Int, "0"),
+		"LINUX_REBOOT_CMD_CAD_ON":	MakeUntypedConst(Int, "2309737967"),
+		"LINUX_REBOOT_CMD_HALT":	MakeUntypedConst(Int, "3454992675"),
+		"LINUX_REBOOT_CMD_KEXEC":	MakeUntypedConst(Int, "1163412803"),
+		"LINUX_REBOOT_CMD_POWER_OFF":	MakeUntypedConst(Int, "1126301404"),
+		"LINUX_REBOOT_CMD_RESTART":	MakeUntypedConst(Int, "19088743"),
+		"LINUX_REBOOT_CMD_RESTART2":	MakeUntypedConst(Int, "2712847316"),
+		"LINUX_REBOOT_CMD_SW_SUSPEND":	MakeUntypedConst(Int, "3489725666"),
+		"LINUX_REBOOT_MAGIC1":	MakeUntypedConst(Int, "4276215469"),
+		"LINUX_REBOOT_MAGIC2":	MakeUntypedConst(Int, "672274793"),
+		"LOCK_EX":	MakeUntypedConst(Int, "2"),
+		"LOCK_NB":	MakeUntypedConst(Int, "4"),
+		"LOCK_SH":	MakeUntypedConst(Int, "1"),
+		"LOCK_UN":	MakeUntypedConst(Int, "8"),
+		"MADV_DODUMP":	MakeUntypedConst(Int, "17"),
+		"MADV_DOFORK":	MakeUntypedConst(Int, "11"),
+		"MADV_DONTDUMP":	MakeUntypedConst(Int, "16"),
+		"MADV_DONTFORK":	MakeUntypedConst(Int, "10"),
+		"MADV_DONTNEED":	MakeUntypedConst(Int, "4"),
+		"MADV_HUGEPAGE":	MakeUntypedConst(Int, "14"),
+		"MADV_HWPOISON":	MakeUntypedConst(Int, "100"),
+		"MADV_MERGEABLE":	MakeUntypedConst(Int, "12"),
+		"MADV_NOHUGEPAGE":	MakeUntypedConst(Int, "15"),
+		"MADV_NORMAL":	MakeUntypedConst(Int, "0"),
+		"MADV_RANDOM":	MakeUntypedConst(Int, "1"),
+		"MADV_REMOVE":	MakeUntypedConst(Int, "9"),
+		"MADV_SEQUENTIAL":	MakeUntypedConst(Int, "2"),
+		"MADV_UNMERGEABLE":	MakeUntypedConst(Int, "13"),
+		"MADV_WILLNEED":	MakeUntypedConst(Int, "3"),
+		"MAP_ANON":	MakeUntypedConst(Int, "32"),
+		"MAP_ANONYMOUS":	MakeUntypedConst(Int, "32"),
+		"MAP_DENYWRITE":	MakeUntypedConst(Int, "2048"),
+		"MAP_EXECUTABLE":	MakeUntypedConst(Int, "4096"),
+		"MAP_FILE":	MakeUntypedConst(Int, "0"),
+		"MAP_FIXED":	MakeUntypedConst(Int, "16"),
+		"MAP_GROWSDOWN":	MakeUntypedConst(Int, "256"),
+		"MAP_HUGETLB":	MakeUntypedConst(Int, "262144"),
+		"MAP_HUGE_MASK":	MakeUntypedConst(Int, "63"),
+		"MAP_HUGE_SHIFT":	MakeUntypedConst(Int, "26"),
+		"MAP_LOCKED":	MakeUntypedConst(Int, "8192"),
+		"MAP_NONBLOCK":	MakeUntypedConst(Int, "65536"),
+		"MAP_NORESERVE":	MakeUntypedConst(Int, "16384"),
+		"MAP_POPULATE":	MakeUntypedConst(Int, "32768"),
+		"MAP_PRIVATE":	MakeUntypedConst(Int, "2"),
+		"MAP_SHARED":	MakeUntypedConst(Int, "1"),
+		"MAP_STACK":	MakeUntypedConst(Int, "131072"),
+		"MAP_TYPE":	MakeUntypedConst(Int, "15"),
+		"MCL_CURRENT":	MakeUntypedConst(Int, "1"),
+		"MCL_FUTURE":	MakeUntypedConst(Int, "2"),
+		"MNT_DETACH":	MakeUntypedConst(Int, "2"),
+		"MNT_EXPIRE":	MakeUntypedConst(Int, "4"),
+		"MNT_FORCE":	MakeUntypedConst(Int, "1"),
+		"MSG_CMSG_CLOEXEC":	MakeUntypedConst(Int, "1073741824"),
+		"MSG_CONFIRM":	MakeUntypedConst(Int, "2048"),
+		"MSG_CTRUNC":	MakeUntypedConst(Int, "8"),
+		"MSG_DONTROUTE":	MakeUntypedConst(Int, "4"),
+		"MSG_DONTWAIT":	MakeUntypedConst(Int, "64"),
+		"MSG_EOR":	MakeUntypedConst(Int, "128"),
+		"MSG_ERRQUEUE":	MakeUntypedConst(Int, "8192"),
+		"MSG_FASTOPEN":	MakeUntypedConst(Int, "536870912"),
+		"MSG_FIN":	MakeUntypedConst(Int, "512"),
+		"MSG_MORE":	MakeUntypedConst(Int, "32768"),
+		"MSG_NOSIGNAL":	MakeUntypedConst(Int, "16384"),
+		"MSG_OOB":	MakeUntypedConst(Int, "1"),
+		"MSG_PEEK":	MakeUntypedConst(Int, "2"),
+		"MSG_PROXY":	MakeUntypedConst(Int, "16"),
+		"MSG_RST":	MakeUntypedConst(Int, "4096"),
+		"MSG_SYN":	MakeUntypedConst(Int, "1024"),
+		"MSG_TRUNC":	MakeUntypedConst(Int, "32"),
+		"MSG_TRYHARD":	MakeUntypedConst(Int, "4"),
+		"MSG_WAITALL":	MakeUntypedConst(Int, "256"),
+		"MSG_WAITFORONE":	MakeUntypedConst(Int, "65536"),
+		"MS_ACTIVE":	MakeUntypedConst(Int, "1073741824"),
+		"MS_ASYNC":	MakeUntypedConst(Int, "1"),
+		"MS_BIND":	MakeUntypedConst(Int, "4096"),
+		"MS_DIRSYNC":	MakeUntypedConst(Int, "128"),
+		"MS_INVALIDATE":	MakeUntypedConst(Int, "2"),
+		"MS_I_VERSION":	MakeUntypedConst(Int, "8388608"),
+		"MS_KERNMOUNT":	MakeUntypedConst(Int, "4194304"),
+		"MS_MANDLOCK":	MakeUntypedConst(Int, "64"),
+		"MS_MGC_MSK":	MakeUntypedConst(Int, "4294901760"),
+		"MS_MGC_VAL":	MakeUntypedConst(Int, "3236757504"),
+		"MS_MOVE":	MakeUntypedConst(Int, "8192"),
+		"MS_NOATIME":	MakeUntypedConst(Int, "1024"),
+		"MS_NODEV":	MakeUntypedConst(Int, "4"),
+		"MS_NODIRATIME":	MakeUntypedConst(Int, "2048"),
+		"MS_NOEXEC":	MakeUntypedConst(Int, "8"),
+		"MS_NOSUID":	MakeUntypedConst(Int, "2"),
+		"MS_NOUSER":	MakeUntypedConst(Int, "-2147483648"),
+		"MS_POSIXACL":	MakeUntypedConst(Int, "65536"),
+		"MS_PRIVATE":	MakeUntypedConst(Int, "262144"),
+		"MS_RDONLY":	MakeUntypedConst(Int, "1"),
+		"MS_REC":	MakeUntypedConst(Int, "16384"),
+		"MS_RELATIME":	MakeUntypedConst(Int, "2097152"),
+		"MS_REMOUNT":	MakeUntypedConst(Int, "32"),
+		"MS_RMT_MASK":	MakeUntypedConst(Int, "8388689"),
+		"MS_SHARED":	MakeUntypedConst(Int, "1048576"),
+		"MS_SILENT":	MakeUntypedConst(Int, "32768"),
+		"MS_SLAVE":	MakeUntypedConst(Int, "524288"),
+		"MS_STRICTATIME":	MakeUntypedConst(Int, "16777216"),
+		"MS_SYNC":	MakeUntypedConst(Int, "4"),
+		"MS_SYNCHRONOUS":	MakeUntypedConst(Int, "16"),
+		"MS_UNBINDABLE":	MakeUntypedConst(Int, "131072"),
+		"NAME_MAX":	MakeUntypedConst(Int, "255"),
+		"NETLINK_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "1"),
+		"NETLINK_AUDIT":	MakeUntypedConst(Int, "9"),
+		"NETLINK_BROADCAST_ERROR":	MakeUntypedConst(Int, "4"),
+		"NETLINK_CONNECTOR":	MakeUntypedConst(Int, "11"),
+		"NETLINK_CRYPTO":	MakeUntypedConst(Int, "21"),
+		"NETLINK_DNRTMSG":	MakeUntypedConst(Int, "14"),
+		"NETLINK_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "2"),
+		"NETLINK_ECRYPTFS":	MakeUntypedConst(Int, "19"),
+		"NETLINK_FIB_LOOKUP":	MakeUntypedConst(Int, "10"),
+		"NETLINK_FIREWALL":	MakeUntypedConst(Int, "3"),
+		"NETLINK_GENERIC":	MakeUntypedConst(Int, "16"),
+		"NETLINK_INET_DIAG":	MakeUntypedConst(Int, "4"),
+		"NETLINK_IP6_FW":	MakeUntypedConst(Int, "13"),
+		"NETLINK_ISCSI":	MakeUntypedConst(Int, "8"),
+		"NETLINK_KOBJECT_UEVENT":	MakeUntypedConst(Int, "15"),
+		"NETLINK_NETFILTER":	MakeUntypedConst(Int, "12"),
+		"NETLINK_NFLOG":	MakeUntypedConst(Int, "5"),
+		"NETLINK_NO_ENOBUFS":	MakeUntypedConst(Int, "5"),
+		"NETLINK_PKTINFO":	MakeUntypedConst(Int, "3"),
+		"NETLINK_RDMA":	MakeUntypedConst(Int, "20"),
+		"NETLINK_ROUTE":	MakeUntypedConst(Int, "0"),
+		"NETLINK_RX_RING":	MakeUntypedConst(Int, "6"),
+		"NETLINK_SCSITRANSPORT":	MakeUntypedConst(Int, "18"),
+		"NETLINK_SELINUX":	MakeUntypedConst(Int, "7"),
+		"NETLINK_SOCK_DIAG":	MakeUntypedConst(Int, "4"),
+		"NETLINK_TX_RING":	MakeUntypedConst(Int, "7"),
+		"NETLINK_UNUSED":	MakeUntypedConst(Int, "1"),
+		"NETLINK_USERSOCK":	MakeUntypedConst(Int, "2"),
+		"NETLINK_XFRM":	MakeUntypedConst(Int, "6"),
+		"NLA_ALIGNTO":	MakeUntypedConst(Int, "4"),
+		"NLA_F_NESTED":	MakeUntypedConst(Int, "32768"),
+		"NLA_F_NET_BYTEORDER":	MakeUntypedConst(Int, "16384"),
+		"NLA_HDRLEN":	MakeUntypedConst(Int, "4"),
+		"NLMSG_ALIGNTO":	MakeUntypedConst(Int, "4"),
+		"NLMSG_DONE":	MakeUntypedConst(Int, "3"),
+		"NLMSG_ERROR":	MakeUntypedConst(Int, "2"),
+		"NLMSG_HDRLEN":	MakeUntypedConst(Int, "16"),
+		"NLMSG_MIN_TYPE":	MakeUntypedConst(Int, "16"),
+		"NLMSG_NOOP":	MakeUntypedConst(Int, "1"),
+		"NLMSG_OVERRUN":	MakeUntypedConst(Int, "4"),
+		"NLM_F_ACK":	MakeUntypedConst(Int, "4"),
+		"NLM_F_APPEND":	MakeUntypedConst(Int, "2048"),
+		"NLM_F_ATOMIC":	MakeUntypedConst(Int, "1024"),
+		"NLM_F_CREATE":	MakeUntypedConst(Int, "1024"),
+		"NLM_F_DUMP":	MakeUntypedConst(Int, "768"),
+		"NLM_F_DUMP_INTR":	MakeUntypedConst(Int, "16"),
+		"NLM_F_ECHO":	MakeUntypedConst(Int, "8"),
+		"NLM_F_EXCL":	MakeUntypedConst(Int, "512"),
+		"NLM_F_MATCH":	MakeUntypedConst(Int, "512"),
+		"NLM_F_MULTI":	MakeUntypedConst(Int, "2"),
+		"NLM_F_REPLACE":	MakeUntypedConst(Int, "256"),
+		"NLM_F_REQUEST":	MakeUntypedConst(Int, "1"),
+		"NLM_F_ROOT":	MakeUntypedConst(Int, "256"),
+		"NOFLSH":	MakeUntypedConst(Int, "128"),
+		"OCRNL":	MakeUntypedConst(Int, "8"),
+		"OFDEL":	MakeUntypedConst(Int, "128"),
+		"OFILL":	MakeUntypedConst(Int, "64"),
+		"OLCUC":	MakeUntypedConst(Int, "2"),
+		"ONLCR":	MakeUntypedConst(Int, "4"),
+		"ONLRET":	MakeUntypedConst(Int, "32"),
+		"ONOCR":	MakeUntypedConst(Int, "16"),
+		"OPOST":	MakeUntypedConst(Int, "1"),
+		"O_ACCMODE":	MakeUntypedConst(Int, "3"),
+		"O_APPEND":	MakeUntypedConst(Int, "1024"),
+		"O_ASYNC":	MakeUntypedConst(Int, "8192"),
+		"O_CLOEXEC":	MakeUntypedConst(Int, "524288"),
+		"O_CREAT":	MakeUntypedConst(Int, "64"),
+		"O_DIRECT":	MakeUntypedConst(Int, "65536"),
+		"O_DIRECTORY":	MakeUntypedConst(Int, "16384"),
+		"O_DSYNC":	MakeUntypedConst(Int, "4096"),
+		"O_EXCL":	MakeUntypedConst(Int, "128"),
+		"O_FSYNC":	MakeUntypedConst(Int, "1052672"),
+		"O_LARGEFILE":	MakeUntypedConst(Int, "0"),
+		"O_NDELAY":	MakeUntypedConst(Int, "2048"),
+		"O_NOATIME":	MakeUntypedConst(Int, "262144"),
+		"O_NOCTTY":	MakeUntypedConst(Int, "256"),
+		"O_NOFOLLOW":	MakeUntypedConst(Int, "32768"),
+		"O_NONBLOCK":	MakeUntypedConst(Int, "2048"),
+		"O_PATH":	MakeUntypedConst(Int, "2097152"),
+		"O_RDONLY":	MakeUntypedConst(Int, "0"),
+		"O_RDWR":	MakeUntypedConst(Int, "2"),
+		"O_RSYNC":	MakeUntypedConst(Int, "1052672"),
+		"O_SYNC":	MakeUntypedConst(Int, "1052672"),
+		"O_TMPFILE":	MakeUntypedConst(Int, "4259840"),
+		"O_TRUNC":	MakeUntypedConst(Int, "512"),
+		"O_WRONLY":	MakeUntypedConst(Int, "1"),
+		"PACKET_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "1"),
+		"PACKET_AUXDATA":	MakeUntypedConst(Int, "8"),
+		"PACKET_BROADCAST":	MakeUntypedConst(Int, "1"),
+		"PACKET_COPY_THRESH":	MakeUntypedConst(Int, "7"),
+		"PACKET_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "2"),
+		"PACKET_FANOUT":	MakeUntypedConst(Int, "18"),
+		"PACKET_FANOUT_CPU":	MakeUntypedConst(Int, "2"),
+		"PACKET_FANOUT_FLAG_DEFRAG":	MakeUntypedConst(Int, "32768"),
+		"PACKET_FANOUT_FLAG_ROLLOVER":	MakeUntypedConst(Int, "4096"),
+		"PACKET_FANOUT_HASH":	MakeUntypedConst(Int, "0"),
+		"PACKET_FANOUT_LB":	MakeUntypedConst(Int, "1"),
+		"PACKET_FANOUT_RND":	MakeUntypedConst(Int, "4"),
+		"PACKET_FANOUT_ROLLOVER":	MakeUntypedConst(Int, "3"),
+		"PACKET_FASTROUTE":	MakeUntypedConst(Int, "6"),
+		"PACKET_HDRLEN":	MakeUntypedConst(Int, "11"),
+		"PACKET_HOST":	MakeUntypedConst(Int, "0"),
+		"PACKET_LOOPBACK":	MakeUntypedConst(Int, "5"),
+		"PACKET_LOSS":	MakeUntypedConst(Int, "14"),
+		"PACKET_MR_ALLMULTI":	MakeUntypedConst(Int, "2"),
+		"PACKET_MR_MULTICAST":	MakeUntypedConst(Int, "0"),
+		"PACKET_MR_PROMISC":	MakeUntypedConst(Int, "1"),
+		"PACKET_MR_UNICAST":	MakeUntypedConst(Int, "3"),
+		"PACKET_MULTICAST":	MakeUntypedConst(Int, "2"),
+		"PACKET_ORIGDEV":	MakeUntypedConst(Int, "9"),
+		"PACKET_OTHERHOST":	MakeUntypedConst(Int, "3"),
+		"PACKET_OUTGOING":	MakeUntypedConst(Int, "4"),
+		"PACKET_RECV_OUTPUT":	MakeUntypedConst(Int, "3"),
+		"PACKET_RESERVE":	MakeUntypedConst(Int, "12"),
+		"PACKET_RX_RING":	MakeUntypedConst(Int, "5"),
+		"PACKET_STATISTICS":	MakeUntypedConst(Int, "6"),
+		"PACKET_TIMESTAMP":	MakeUntypedConst(Int, "17"),
+		"PACKET_TX_HAS_OFF":	MakeUntypedConst(Int, "19"),
+		"PACKET_TX_RING":	MakeUntypedConst(Int, "13"),
+		"PACKET_TX_TIMESTAMP":	MakeUntypedConst(Int, "16"),
+		"PACKET_VERSION":	MakeUntypedConst(Int, "10"),
+		"PACKET_VNET_HDR":	MakeUntypedConst(Int, "15"),
+		"PARENB":	MakeUntypedConst(Int, "256"),
+		"PARITY_CRC16_PR0":	MakeUntypedConst(Int, "2"),
+		"PARITY_CRC16_PR0_CCITT":	MakeUntypedConst(Int, "4"),
+		"PARITY_CRC16_PR1":	MakeUntypedConst(Int, "3"),
+		"PARITY_CRC16_PR1_CCITT":	MakeUntypedConst(Int, "5"),
+		"PARITY_CRC32_PR0_CCITT":	MakeUntypedConst(Int, "6"),
+		"PARITY_CRC32_PR1_CCITT":	MakeUntypedConst(Int, "7"),
+		"PARITY_DEFAULT":	MakeUntypedConst(Int, "0"),
+		"PARITY_NONE":	MakeUntypedConst(Int, "1"),
+		"PARMRK":	MakeUntypedConst(Int, "8"),
+		"PARODD":	MakeUntypedConst(Int, "512"),
+		"PENDIN":	MakeUntypedConst(Int, "16384"),
+		"PRIO_PGRP":	MakeUntypedConst(Int, "1"),
+		"PRIO_PROCESS":	MakeUntypedConst(Int, "0"),
+		"PRIO_USER":	MakeUntypedConst(Int, "2"),
+		"PROT_EXEC":	MakeUntypedConst(Int, "4"),
+		"PROT_GROWSDOWN":	MakeUntypedConst(Int, "16777216"),
+		"PROT_GROWSUP":	MakeUntypedConst(Int, "33554432"),
+		"PROT_NONE":	MakeUntypedConst(Int, "0"),
+		"PROT_READ":	MakeUntypedConst(Int, "1"),
+		"PROT_WRITE":	MakeUntypedConst(Int, "2"),
+		"PR_CAPBSET_DROP":	MakeUntypedConst(Int, "24"),
+		"PR_CAPBSET_READ":	MakeUntypedConst(Int, "23"),
+		"PR_ENDIAN_BIG":	MakeUntypedConst(Int, "0"),
+		"PR_ENDIAN_LITTLE":	MakeUntypedConst(Int, "1"),
+		"PR_ENDIAN_PPC_LITTLE":	MakeUntypedConst(Int, "2"),
+		"PR_FPEMU_NOPRINT":	MakeUntypedConst(Int, "1"),
+		"PR_FPEMU_SIGFPE":	MakeUntypedConst(Int, "2"),
+		"PR_FP_EXC_ASYNC":	MakeUntypedConst(Int, "2"),
+		"PR_FP_EXC_DISABLED":	MakeUntypedConst(Int, "0"),
+		"PR_FP_EXC_DIV":	MakeUntypedConst(Int, "65536"),
+		"PR_FP_EXC_INV":	MakeUntypedConst(Int, "1048576"),
+		"PR_FP_EXC_NONRECOV":	MakeUntypedConst(Int, "1"),
+		"PR_FP_EXC_OVF":	MakeUntypedConst(Int, "131072"),
+		"PR_FP_EXC_PRECISE":	MakeUntypedConst(Int, "3"),
+		"PR_FP_EXC_RES":	MakeUntypedConst(Int, "524288"),
+		"PR_FP_EXC_SW_ENABLE":	MakeUntypedConst(Int, "128"),
+		"PR_FP_EXC_UND":	MakeUntypedConst(Int, "262144"),
+		"PR_GET_CHILD_SUBREAPER":	MakeUntypedConst(Int, "37"),
+		"PR_GET_DUMPABLE":	MakeUntypedConst(Int, "3"),
+		"PR_GET_ENDIAN":	MakeUntypedConst(Int, "19"),
+		"PR_GET_FPEMU":	MakeUntypedConst(Int, "9"),
+		"PR_GET_FPEXC":	MakeUntypedConst(Int, "11"),
+		"PR_GET_KEEPCAPS":	MakeUntypedConst(Int, "7"),
+		"PR_GET_NAME":	MakeUntypedConst(Int, "16"),
+		"PR_GET_NO_NEW_PRIVS":	MakeUntypedConst(Int, "39"),
+		"PR_GET_PDEATHSIG":	MakeUntypedConst(Int, "2"),
+		"PR_GET_SECCOMP":	MakeUntypedConst(Int, "21"),
+		"PR_GET_SECUREBITS":	MakeUntypedConst(Int, "27"),
+		"PR_GET_TID_ADDRESS":	MakeUntypedConst(Int, "40"),
+		"PR_GET_TIMERSLACK":	MakeUntypedConst(Int, "30"),
+		"PR_GET_TIMING":	MakeUntypedConst(Int, "13"),
+		"PR_GET_TSC":	MakeUntypedConst(Int, "25"),
+		"PR_GET_UNALIGN":	MakeUntypedConst(Int, "5"),
+		"PR_MCE_KILL":	MakeUntypedConst(Int, "33"),
+		"PR_MCE_KILL_CLEAR":	MakeUntypedConst(Int, "0"),
+		"PR_MCE_KILL_DEFAULT":	MakeUntypedConst(Int, "2"),
+		"PR_MCE_KILL_EARLY":	MakeUntypedConst(Int, "1"),
+		"PR_MCE_KILL_GET":	MakeUntypedConst(Int, "34"),
+		"PR_MCE_KILL_LATE":	MakeUntypedConst(Int, "0"),
+		"PR_MCE_KILL_SET":	MakeUntypedConst(Int, "1"),
+		"PR_SET_CHILD_SUBREAPER":	MakeUntypedConst(Int, "36"),
+		"PR_SET_DUMPABLE":	MakeUntypedConst(Int, "4"),
+		"PR_SET_ENDIAN":	MakeUntypedConst(Int, "20"),
+		"PR_SET_FPEMU":	MakeUntypedConst(Int, "10"),
+		"PR_SET_FPEXC":	MakeUntypedConst(Int, "12"),
+		"PR_SET_KEEPCAPS":	MakeUntypedConst(Int, "8"),
+		"PR_SET_MM":	MakeUntypedConst(Int, "35"),
+		"PR_SET_MM_ARG_END":	MakeUntypedConst(Int, "9"),
+		"PR_SET_MM_ARG_START":	MakeUntypedConst(Int, "8"),
+		"PR_SET_MM_AUXV":	MakeUntypedConst(Int, "12"),
+		"PR_SET_MM_BRK":	MakeUntypedConst(Int, "7"),
+		"PR_SET_MM_END_CODE":	MakeUntypedConst(Int, "2"),
+		"PR_SET_MM_END_DATA":	MakeUntypedConst(Int, "4"),
+		"PR_SET_MM_ENV_END":	MakeUntypedConst(Int, "11"),
+		"PR_SET_MM_ENV_START":	MakeUntypedConst(Int, "10"),
+		"PR_SET_MM_EXE_FILE":	MakeUntypedConst(Int, "13"),
+		"PR_SET_MM_START_BRK":	MakeUntypedConst(Int, "6"),
+		"PR_SET_MM_START_CODE":	MakeUntypedConst(Int, "1"),
+		"PR_SET_MM_START_DATA":	MakeUntypedConst(Int, "3"),
+		"PR_SET_MM_START_STACK":	MakeUntypedConst(Int, "5"),
+		"PR_SET_NAME":	MakeUntypedConst(Int, "15"),
+		"PR_SET_NO_NEW_PRIVS":	MakeUntypedConst(Int, "38"),
+		"PR_SET_PDEATHSIG":	MakeUntypedConst(Int, "1"),
+		"PR_SET_PTRACER":	MakeUntypedConst(Int, "1499557217"),
+		"PR_SET_PTRACER_ANY":	MakeUntypedConst(Int, "-1"),
+		"PR_SET_SECCOMP":	MakeUntypedConst(Int, "22"),
+		"PR_SET_SECUREBITS":	MakeUntypedConst(Int, "28"),
+		"PR_SET_TIMERSLACK":	MakeUntypedConst(Int, "29"),
+		"PR_SET_TIMING":	MakeUntypedConst(Int, "14"),
+		"PR_SET_TSC":	MakeUntypedConst(Int, "26"),
+		"PR_SET_UNALIGN":	MakeUntypedConst(Int, "6"),
+		"PR_TASK_PERF_EVENTS_DISABLE":	MakeUntypedConst(Int, "31"),
+		"PR_TASK_PERF_EVENTS_ENABLE":	MakeUntypedConst(Int, "32"),
+		"PR_TIMING_STATISTICAL":	MakeUntypedConst(Int, "0"),
+		"PR_TIMING_TIMESTAMP":	MakeUntypedConst(Int, "1"),
+		"PR_TSC_ENABLE":	MakeUntypedConst(Int, "1"),
+		"PR_TSC_SIGSEGV":	MakeUntypedConst(Int, "2"),
+		"PR_UNALIGN_NOPRINT":	MakeUntypedConst(Int, "1"),
+		"PR_UNALIGN_SIGBUS":	MakeUntypedConst(Int, "2"),
+		"PTRACE_ATTACH":	MakeUntypedConst(Int, "16"),
+		"PTRACE_CONT":	MakeUntypedConst(Int, "7"),
+		"PTRACE_DETACH":	MakeUntypedConst(Int, "17"),
+		"PTRACE_EVENT_CLONE":	MakeUntypedConst(Int, "3"),
+		"PTRACE_EVENT_EXEC":	MakeUntypedConst(Int, "4"),
+		"PTRACE_EVENT_EXIT":	MakeUntypedConst(Int, "6"),
+		"PTRACE_EVENT_FORK":	MakeUntypedConst(Int, "1"),
+		"PTRACE_EVENT_SECCOMP":	MakeUntypedConst(Int, "7"),
+		"PTRACE_EVENT_STOP":	MakeUntypedConst(Int, "128"),
+		"PTRACE_EVENT_VFORK":	MakeUntypedConst(Int, "2"),
+		"PTRACE_EVENT_VFORK_DONE":	MakeUntypedConst(Int, "5"),
+		"PTRACE_GETEVENTMSG":	MakeUntypedConst(Int, "16897"),
+		"PTRACE_GETREGS":	MakeUntypedConst(Int, "12"),
+		"PTRACE_GETREGSET":	MakeUntypedConst(Int, "16900"),
+		"PTRACE_GETSIGINFO":	MakeUntypedConst(Int, "16898"),
+		"PTRACE_GETSIGMASK":	MakeUntypedConst(Int, "16906"),
+		"PTRACE_INTERRUPT":	MakeUntypedConst(Int, "16903"),
+		"PTRACE_KILL":	MakeUntypedConst(Int, "8"),
+		"PTRACE_LISTEN":	MakeUntypedConst(Int, "16904"),
+		"PTRACE_O_EXITKILL":	MakeUntypedConst(Int, "1048576"),
+		"PTRACE_O_MASK":	MakeUntypedConst(Int, "1048831"),
+		"PTRACE_O_TRACECLONE":	MakeUntypedConst(Int, "8"),
+		"PTRACE_O_TRACEEXEC":	MakeUntypedConst(Int, "16"),
+		"PTRACE_O_TRACEEXIT":	MakeUntypedConst(Int, "64"),
+		"PTRACE_O_TRACEFORK":	MakeUntypedConst(Int, "2"),
+		"PTRACE_O_TRACESECCOMP":	MakeUntypedConst(Int, "128"),
+		"PTRACE_O_TRACESYSGOOD":	MakeUntypedConst(Int, "1"),
+		"PTRACE_O_TRACEVFORK":	MakeUntypedConst(Int, "4"),
+		"PTRACE_O_TRACEVFORKDONE":	MakeUntypedConst(Int, "32"),
+		"PTRACE_PEEKDATA":	MakeUntypedConst(Int, "2"),
+		"PTRACE_PEEKSIGINFO":	MakeUntypedConst(Int, "16905"),
+		"PTRACE_PEEKSIGINFO_SHARED":	MakeUntypedConst(Int, "1"),
+		"PTRACE_PEEKTEXT":	MakeUntypedConst(Int, "1"),
+		"PTRACE_PEEKUSR":	MakeUntypedConst(Int, "3"),
+		"PTRACE_POKEDATA":	MakeUntypedConst(Int, "5"),
+		"PTRACE_POKETEXT":	MakeUntypedConst(Int, "4"),
+		"PTRACE_POKEUSR":	MakeUntypedConst(Int, "6"),
+		"PTRACE_SEIZE":	MakeUntypedConst(Int, "16902"),
+		"PTRACE_SETOPTIONS":	MakeUntypedConst(Int, "16896"),
+		"PTRACE_SETREGS":	MakeUntypedConst(Int, "13"),
+		"PTRACE_SETREGSET":	MakeUntypedConst(Int, "16901"),
+		"PTRACE_SETSIGINFO":	MakeUntypedConst(Int, "16899"),
+		"PTRACE_SETSIGMASK":	MakeUntypedConst(Int, "16907"),
+		"PTRACE_SINGLESTEP":	MakeUntypedConst(Int, "9"),
+		"PTRACE_SYSCALL":	MakeUntypedConst(Int, "24"),
+		"PTRACE_TRACEME":	MakeUntypedConst(Int, "0"),
+		"PathMax":	MakeUntypedConst(Int, "4096"),
+		"RLIMIT_AS":	MakeUntypedConst(Int, "9"),
+		"RLIMIT_CORE":	MakeUntypedConst(Int, "4"),
+		"RLIMIT_CPU":	MakeUntypedConst(Int, "0"),
+		"RLIMIT_DATA":	MakeUntypedConst(Int, "2"),
+		"RLIMIT_FSIZE":	MakeUntypedConst(Int, "1"),
+		"RLIMIT_NOFILE":	MakeUntypedConst(Int, "7"),
+		"RLIMIT_STACK":	MakeUntypedConst(Int, "3"),
+		"RLIM_INFINITY":	MakeUntypedConst(Int, "-1"),
+		"RTAX_ADVMSS":	MakeUntypedConst(Int, "8"),
+		"RTAX_CWND":	MakeUntypedConst(Int, "7"),
+		"RTAX_FEATURES":	MakeUntypedConst(Int, "12"),
+		"RTAX_FEATURE_ALLFRAG":	MakeUntypedConst(Int, "8"),
+		"RTAX_FEATURE_ECN":	MakeUntypedConst(Int, "1"),
+		"RTAX_FEATURE_SACK":	MakeUntypedConst(Int, "2"),
+		"RTAX_FEATURE_TIMESTAMP":	MakeUntypedConst(Int, "4"),
+		"RTAX_HOPLIMIT":	MakeUntypedConst(Int, "10"),
+		"RTAX_INITCWND":	MakeUntypedConst(Int, "11"),
+		"RTAX_INITRWND":	MakeUntypedConst(Int, "14"),
+		"RTAX_LOCK":	MakeUntypedConst(Int, "1"),
+		"RTAX_MAX":	MakeUntypedConst(Int, "15"),
+		"RTAX_MTU":	MakeUntypedConst(Int, "2"),
+		"RTAX_QUICKACK":	MakeUntypedConst(Int, "15"),
+		"RTAX_REORDERING":	MakeUntypedConst(Int, "9"),
+		"RTAX_RTO_MIN":	MakeUntypedConst(Int, "13"),
+		"RTAX_RTT":	MakeUntypedConst(Int, "4"),
+		"RTAX_RTTVAR":	MakeUntypedConst(Int, "5"),
+		"RTAX_SSTHRESH":	MakeUntypedConst(Int, "6"),
+		"RTAX_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RTAX_WINDOW":	MakeUntypedConst(Int, "3"),
+		"RTA_ALIGNTO":	MakeUntypedConst(Int, "4"),
+		"RTA_CACHEINFO":	MakeUntypedConst(Int, "12"),
+		"RTA_DST":	MakeUntypedConst(Int, "1"),
+		"RTA_FLOW":	MakeUntypedConst(Int, "11"),
+		"RTA_GATEWAY":	MakeUntypedConst(Int, "5"),
+		"RTA_IIF":	MakeUntypedConst(Int, "3"),
+		"RTA_MAX":	MakeUntypedConst(Int, "17"),
+		"RTA_METRICS":	MakeUntypedConst(Int, "8"),
+		"RTA_MULTIPATH":	MakeUntypedConst(Int, "9"),
+		"RTA_OIF":	MakeUntypedConst(Int, "4"),
+		"RTA_PREFSRC":	MakeUntypedConst(Int, "7"),
+		"RTA_PRIORITY":	MakeUntypedConst(Int, "6"),
+		"RTA_SRC":	MakeUntypedConst(Int, "2"),
+		"RTA_TABLE":	MakeUntypedConst(Int, "15"),
+		"RTA_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RTCF_DIRECTSRC":	MakeUntypedConst(Int, "67108864"),
+		"RTCF_DOREDIRECT":	MakeUntypedConst(Int, "16777216"),
+		"RTCF_LOG":	MakeUntypedConst(Int, "33554432"),
+		"RTCF_MASQ":	MakeUntypedConst(Int, "4194304"),
+		"RTCF_NAT":	MakeUntypedConst(Int, "8388608"),
+		"RTCF_VALVE":	MakeUntypedConst(Int, "2097152"),
+		"RTF_ADDRCLASSMASK":	MakeUntypedConst(Int, "4160749568"),
+		"RTF_ADDRCONF":	MakeUntypedConst(Int, "262144"),
+		"RTF_ALLONLINK":	MakeUntypedConst(Int, "131072"),
+		"RTF_BROADCAST":	MakeUntypedConst(Int, "268435456"),
+		"RTF_CACHE":	MakeUntypedConst(Int, "16777216"),
+		"RTF_DEFAULT":	MakeUntypedConst(Int, "65536"),
+		"RTF_DYNAMIC":	MakeUntypedConst(Int, "16"),
+		"RTF_FLOW":	MakeUntypedConst(Int, "33554432"),
+		"RTF_GATEWAY":	MakeUntypedConst(Int, "2"),
+		"RTF_HOST":	MakeUntypedConst(Int, "4"),
+		"RTF_INTERFACE":	MakeUntypedConst(Int, "1073741824"),
+		"RTF_IRTT":	MakeUntypedConst(Int, "256"),
+		"RTF_LINKRT":	MakeUntypedConst(Int, "1048576"),
+		"RTF_LOCAL":	MakeUntypedConst(Int, "2147483648"),
+		"RTF_MODIFIED":	MakeUntypedConst(Int, "32"),
+		"RTF_MSS":	MakeUntypedConst(Int, "64"),
+		"RTF_MTU":	MakeUntypedConst(Int, "64"),
+		"RTF_MULTICAST":	MakeUntypedConst(Int, "536870912"),
+		"RTF_NAT":	MakeUntypedConst(Int, "134217728"),
+		"RTF_NOFORWARD":	MakeUntypedConst(Int, "4096"),
+		"RTF_NONEXTHOP":	MakeUntypedConst(Int, "2097152"),
+		"RTF_NOPMTUDISC":	MakeUntypedConst(Int, "16384"),
+		"RTF_POLICY":	MakeUntypedConst(Int, "67108864"),
+		"RTF_REINSTATE":	MakeUntypedConst(Int, "8"),
+		"RTF_REJECT":	MakeUntypedConst(Int, "512"),
+		"RTF_STATIC":	MakeUntypedConst(Int, "1024"),
+		"RTF_THROW":	MakeUntypedConst(Int, "8192"),
+		"RTF_UP":	MakeUntypedConst(Int, "1"),
+		"RTF_WINDOW":	MakeUntypedConst(Int, "128"),
+		"RTF_XRESOLVE":	MakeUntypedConst(Int, "2048"),
+		"RTM_BASE":	MakeUntypedConst(Int, "16"),
+		"RTM_DELACTION":	MakeUntypedConst(Int, "49"),
+		"RTM_DELADDR":	MakeUntypedConst(Int, "21"),
+		"RTM_DELADDRLABEL":	MakeUntypedConst(Int, "73"),
+		"RTM_DELLINK":	MakeUntypedConst(Int, "17"),
+		"RTM_DELMDB":	MakeUntypedConst(Int, "85"),
+		"RTM_DELNEIGH":	MakeUntypedConst(Int, "29"),
+		"RTM_DELQDISC":	MakeUntypedConst(Int, "37"),
+		"RTM_DELROUTE":	MakeUntypedConst(Int, "25"),
+		"RTM_DELRULE":	MakeUntypedConst(Int, "33"),
+		"RTM_DELTCLASS":	MakeUntypedConst(Int, "41"),
+		"RTM_DELTFILTER":	MakeUntypedConst(Int, "45"),
+		"RTM_F_CLONED":	MakeUntypedConst(Int, "512"),
+		"RTM_F_EQUALIZE":	MakeUntypedConst(Int, "1024"),
+		"RTM_F_NOTIFY":	MakeUntypedConst(Int, "256"),
+		"RTM_F_PREFIX":	MakeUntypedConst(Int, "2048"),
+		"RTM_GETACTION":	MakeUntypedConst(Int, "50"),
+		"RTM_GETADDR":	MakeUntypedConst(Int, "22"),
+		"RTM_GETADDRLABEL":	MakeUntypedConst(Int, "74"),
+		"RTM_GETANYCAST":	MakeUntypedConst(Int, "62"),
+		"RTM_GETDCB":	MakeUntypedConst(Int, "78"),
+		"RTM_GETLINK":	MakeUntypedConst(Int, "18"),
+		"RTM_GETMDB":	MakeUntypedConst(Int, "86"),
+		"RTM_GETMULTICAST":	MakeUntypedConst(Int, "58"),
+		"RTM_GETNEIGH":	MakeUntypedConst(Int, "30"),
+		"RTM_GETNEIGHTBL":	MakeUntypedConst(Int, "66"),
+		"RTM_GETNETCONF":	MakeUntypedConst(Int, "82"),
+		"RTM_GETQDISC":	MakeUntypedConst(Int, "38"),
+		"RTM_GETROUTE":	MakeUntypedConst(Int, "26"),
+		"RTM_GETRULE":	MakeUntypedConst(Int, "34"),
+		"RTM_GETTCLASS":	MakeUntypedConst(Int, "42"),
+		"RTM_GETTFILTER":	MakeUntypedConst(Int, "46"),
+		"RTM_MAX":	MakeUntypedConst(Int, "87"),
+		"RTM_NEWACTION":	MakeUntypedConst(Int, "48"),
+		"RTM_NEWADDR":	MakeUntypedConst(Int, "20"),
+		"RTM_NEWADDRLABEL":	MakeUntypedConst(Int, "72"),
+		"RTM_NEWLINK":	MakeUntypedConst(Int, "16"),
+		"RTM_NEWMDB":	MakeUntypedConst(Int, "84"),
+		"RTM_NEWNDUSEROPT":	MakeUntypedConst(Int, "68"),
+		"RTM_NEWNEIGH":	MakeUntypedConst(Int, "28"),
+		"RTM_NEWNEIGHTBL":	MakeUntypedConst(Int, "64"),
+		"RTM_NEWNETCONF":	MakeUntypedConst(Int, "80"),
+		"RTM_NEWPREFIX":	MakeUntypedConst(Int, "52"),
+		"RTM_NEWQDISC":	MakeUntypedConst(Int, "36"),
+		"RTM_NEWROUTE":	MakeUntypedConst(Int, "24"),
+		"RTM_NEWRULE":	MakeUntypedConst(Int, "32"),
+		"RTM_NEWTCLASS":	MakeUntypedConst(Int, "40"),
+		"RTM_NEWTFILTER":	MakeUntypedConst(Int, "44"),
+		"RTM_NR_FAMILIES":	MakeUntypedConst(Int, "18"),
+		"RTM_NR_MSGTYPES":	MakeUntypedConst(Int, "72"),
+		"RTM_SETDCB":	MakeUntypedConst(Int, "79"),
+		"RTM_SETLINK":	MakeUntypedConst(Int, "19"),
+		"RTM_SETNEIGHTBL":	MakeUntypedConst(Int, "67"),
+		"RTNH_ALIGNTO":	MakeUntypedConst(Int, "4"),
+		"RTNH_F_DEAD":	MakeUntypedConst(Int, "1"),
+		"RTNH_F_ONLINK":	MakeUntypedConst(Int, "4"),
+		"RTNH_F_PERVASIVE":	MakeUntypedConst(Int, "2"),
+		"RTNLGRP_IPV4_IFADDR":	MakeUntypedConst(Int, "5"),
+		"RTNLGRP_IPV4_MROUTE":	MakeUntypedConst(Int, "6"),
+		"RTNLGRP_IPV4_ROUTE":	MakeUntypedConst(Int, "7"),
+		"RTNLGRP_IPV4_RULE":	MakeUntypedConst(Int, "8"),
+		"RTNLGRP_IPV6_IFADDR":	MakeUntypedConst(Int, "9"),
+		"RTNLGRP_IPV6_IFINFO":	MakeUntypedConst(Int, "12"),
+		"RTNLGRP_IPV6_MROUTE":	MakeUntypedConst(Int, "10"),
+		"RTNLGRP_IPV6_PREFIX":	MakeUntypedConst(Int, "18"),
+		"RTNLGRP_IPV6_ROUTE":	MakeUntypedConst(Int, "11"),
+		"RTNLGRP_IPV6_RULE":	MakeUntypedConst(Int, "19"),
+		"RTNLGRP_LINK":	MakeUntypedConst(Int, "1"),
+		"RTNLGRP_ND_USEROPT":	MakeUntypedConst(Int, "20"),
+		"RTNLGRP_NEIGH":	MakeUntypedConst(Int, "3"),
+		"RTNLGRP_NONE":	MakeUntypedConst(Int, "0"),
+		"RTNLGRP_NOTIFY":	MakeUntypedConst(Int, "2"),
+		"RTNLGRP_TC":	MakeUntypedConst(Int, "4"),
+		"RTN_ANYCAST":	MakeUntypedConst(Int, "4"),
+		"RTN_BLACKHOLE":	MakeUntypedConst(Int, "6"),
+		"RTN_BROADCAST":	MakeUntypedConst(Int, "3"),
+		"RTN_LOCAL":	MakeUntypedConst(Int, "2"),
+		"RTN_MAX":	MakeUntypedConst(Int, "11"),
+		"RTN_MULTICAST":	MakeUntypedConst(Int, "5"),
+		"RTN_NAT":	MakeUntypedConst(Int, "10"),
+		"RTN_PROHIBIT":	MakeUntypedConst(Int, "8"),
+		"RTN_THROW":	MakeUntypedConst(Int, "9"),
+		"RTN_UNICAST":	MakeUntypedConst(Int, "1"),
+		"RTN_UNREACHABLE":	MakeUntypedConst(Int, "7"),
+		"RTN_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RTN_XRESOLVE":	MakeUntypedConst(Int, "11"),
+		"RTPROT_BIRD":	MakeUntypedConst(Int, "12"),
+		"RTPROT_BOOT":	MakeUntypedConst(Int, "3"),
+		"RTPROT_DHCP":	MakeUntypedConst(Int, "16"),
+		"RTPROT_DNROUTED":	MakeUntypedConst(Int, "13"),
+		"RTPROT_GATED":	MakeUntypedConst(Int, "8"),
+		"RTPROT_KERNEL":	MakeUntypedConst(Int, "2"),
+		"RTPROT_MROUTED":	MakeUntypedConst(Int, "17"),
+		"RTPROT_MRT":	MakeUntypedConst(Int, "10"),
+		"RTPROT_NTK":	MakeUntypedConst(Int, "15"),
+		"RTPROT_RA":	MakeUntypedConst(Int, "9"),
+		"RTPROT_REDIRECT":	MakeUntypedConst(Int, "1"),
+		"RTPROT_STATIC":	MakeUntypedConst(Int, "4"),
+		"RTPROT_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RTPROT_XORP":	MakeUntypedConst(Int, "14"),
+		"RTPROT_ZEBRA":	MakeUntypedConst(Int, "11"),
+		"RT_CLASS_DEFAULT":	MakeUntypedConst(Int, "253"),
+		"RT_CLASS_LOCAL":	MakeUntypedConst(Int, "255"),
+		"RT_CLASS_MAIN":	MakeUntypedConst(Int, "254"),
+		"RT_CLASS_MAX":	MakeUntypedConst(Int, "255"),
+		"RT_CLASS_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RT_SCOPE_HOST":	MakeUntypedConst(Int, "254"),
+		"RT_SCOPE_LINK":	MakeUntypedConst(Int, "253"),
+		"RT_SCOPE_NOWHERE":	MakeUntypedConst(Int, "255"),
+		"RT_SCOPE_SITE":	MakeUntypedConst(Int, "200"),
+		"RT_SCOPE_UNIVERSE":	MakeUntypedConst(Int, "0"),
+		"RT_TABLE_COMPAT":	MakeUntypedConst(Int, "252"),
+		"RT_TABLE_DEFAULT":	MakeUntypedConst(Int, "253"),
+		"RT_TABLE_LOCAL":	MakeUntypedConst(Int, "255"),
+		"RT_TABLE_MAIN":	MakeUntypedConst(Int, "254"),
+		"RT_TABLE_MAX":	MakeUntypedConst(Int, "4294967295"),
+		"RT_TABLE_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"RUSAGE_CHILDREN":	MakeUntypedConst(Int, "-1"),
+		"RUSAGE_SELF":	MakeUntypedConst(Int, "0"),
+		"RUSAGE_THREAD":	MakeUntypedConst(Int, "1"),
+		"SCM_CREDENTIALS":	MakeUntypedConst(Int, "2"),
+		"SCM_RIGHTS":	MakeUntypedConst(Int, "1"),
+		"SCM_TIMESTAMP":	MakeUntypedConst(Int, "29"),
+		"SCM_TIMESTAMPING":	MakeUntypedConst(Int, "37"),
+		"SCM_TIMESTAMPNS":	MakeUntypedConst(Int, "35"),
+		"SCM_WIFI_STATUS":	MakeUntypedConst(Int, "41"),
+		"SHUT_RD":	MakeUntypedConst(Int, "0"),
+		"SHUT_RDWR":	MakeUntypedConst(Int, "2"),
+		"SHUT_WR":	MakeUntypedConst(Int, "1"),
+		"SIOCADDDLCI":	MakeUntypedConst(Int, "35200"),
+		"SIOCADDMULTI":	MakeUntypedConst(Int, "35121"),
+		"SIOCADDRT":	MakeUntypedConst(Int, "35083"),
+		"SIOCATMARK":	MakeUntypedConst(Int, "35077"),
+		"SIOCDARP":	MakeUntypedConst(Int, "35155"),
+		"SIOCDELDLCI":	MakeUntypedConst(Int, "35201"),
+		"SIOCDELMULTI":	MakeUntypedConst(Int, "35122"),
+		"SIOCDELRT":	MakeUntypedConst(Int, "35084"),
+		"SIOCDEVPRIVATE":	MakeUntypedConst(Int, "35312"),
+		"SIOCDIFADDR":	MakeUntypedConst(Int, "35126"),
+		"SIOCDRARP":	MakeUntypedConst(Int, "35168"),
+		"SIOCGARP":	MakeUntypedConst(Int, "35156"),
+		"SIOCGIFADDR":	MakeUntypedConst(Int, "35093"),
+		"SIOCGIFBR":	MakeUntypedConst(Int, "35136"),
+		"SIOCGIFBRDADDR":	MakeUntypedConst(Int, "35097"),
+		"SIOCGIFCONF":	MakeUntypedConst(Int, "35090"),
+		"SIOCGIFCOUNT":	MakeUntypedConst(Int, "35128"),
+		"SIOCGIFDSTADDR":	MakeUntypedConst(Int, "35095"),
+		"SIOCGIFENCAP":	MakeUntypedConst(Int, "35109"),
+		"SIOCGIFFLAGS":	MakeUntypedConst(Int, "35091"),
+		"SIOCGIFHWADDR":	MakeUntypedConst(Int, "35111"),
+		"SIOCGIFINDEX":	MakeUntypedConst(Int, "35123"),
+		"SIOCGIFMAP":	MakeUntypedConst(Int, "35184"),
+		"SIOCGIFMEM":	MakeUntypedConst(Int, "35103"),
+		"SIOCGIFMETRIC":	MakeUntypedConst(Int, "35101"),
+		"SIOCGIFMTU":	MakeUntypedConst(Int, "35105"),
+		"SIOCGIFNAME":	MakeUntypedConst(Int, "35088"),
+		"SIOCGIFNETMASK":	MakeUntypedConst(Int, "35099"),
+		"SIOCGIFPFLAGS":	MakeUntypedConst(Int, "35125"),
+		"SIOCGIFSLAVE":	MakeUntypedConst(Int, "35113"),
+		"SIOCGIFTXQLEN":	MakeUntypedConst(Int, "35138"),
+		"SIOCGPGRP":	MakeUntypedConst(Int, "35076"),
+		"SIOCGRARP":	MakeUntypedConst(Int, "35169"),
+		"SIOCGSTAMP":	MakeUntypedConst(Int, "35078"),
+		"SIOCGSTAMPNS":	MakeUntypedConst(Int, "35079"),
+		"SIOCPROTOPRIVATE":	MakeUntypedConst(Int, "35296"),
+		"SIOCRTMSG":	MakeUntypedConst(Int, "35085"),
+		"SIOCSARP":	MakeUntypedConst(Int, "35157"),
+		"SIOCSIFADDR":	MakeUntypedConst(Int, "35094"),
+		"SIOCSIFBR":	MakeUntypedConst(Int, "35137"),
+		"SIOCSIFBRDADDR":	MakeUntypedConst(Int, "35098"),
+		"SIOCSIFDSTADDR":	MakeUntypedConst(Int, "35096"),
+		"SIOCSIFENCAP":	MakeUntypedConst(Int, "35110"),
+		"SIOCSIFFLAGS":	MakeUntypedConst(Int, "35092"),
+		"SIOCSIFHWADDR":	MakeUntypedConst(Int, "35108"),
+		"SIOCSIFHWBROADCAST":	MakeUntypedConst(Int, "35127"),
+		"SIOCSIFLINK":	MakeUntypedConst(Int, "35089"),
+		"SIOCSIFMAP":	MakeUntypedConst(Int, "35185"),
+		"SIOCSIFMEM":	MakeUntypedConst(Int, "35104"),
+		"SIOCSIFMETRIC":	MakeUntypedConst(Int, "35102"),
+		"SIOCSIFMTU":	MakeUntypedConst(Int, "35106"),
+		"SIOCSIFNAME":	MakeUntypedConst(Int, "35107"),
+		"SIOCSIFNETMASK":	MakeUntypedConst(Int, "35100"),
+		"SIOCSIFPFLAGS":	MakeUntypedConst(Int, "35124"),
+		"SIOCSIFSLAVE":	MakeUntypedConst(Int, "35120"),
+		"SIOCSIFTXQLEN":	MakeUntypedConst(Int, "35139"),
+		"SIOCSPGRP":	MakeUntypedConst(Int, "35074"),
+		"SIOCSRARP":	MakeUntypedConst(Int, "35170"),
+		"SOCK_CLOEXEC":	MakeUntypedConst(Int, "524288"),
+		"SOCK_DCCP":	MakeUntypedConst(Int, "6"),
+		"SOCK_DGRAM":	MakeUntypedConst(Int, "2"),
+		"SOCK_NONBLOCK":	MakeUntypedConst(Int, "2048"),
+		"SOCK_PACKET":	MakeUntypedConst(Int, "10"),
+		"SOCK_RAW":	MakeUntypedConst(Int, "3"),
+		"SOCK_RDM":	MakeUntypedConst(Int, "4"),
+		"SOCK_SEQPACKET":	MakeUntypedConst(Int, "5"),
+		"SOCK_STREAM":	MakeUntypedConst(Int, "1"),
+		"SOL_AAL":	MakeUntypedConst(Int, "265"),
+		"SOL_ATM":	MakeUntypedConst(Int, "264"),
+		"SOL_DECNET":	MakeUntypedConst(Int, "261"),
+		"SOL_ICMPV6":	MakeUntypedConst(Int, "58"),
+		"SOL_IP":	MakeUntypedConst(Int, "0"),
+		"SOL_IPV6":	MakeUntypedConst(Int, "41"),
+		"SOL_IRDA":	MakeUntypedConst(Int, "266"),
+		"SOL_PACKET":	MakeUntypedConst(Int, "263"),
+		"SOL_RAW":	MakeUntypedConst(Int, "255"),
+		"SOL_SOCKET":	MakeUntypedConst(Int, "1"),
+		"SOL_TCP":	MakeUntypedConst(Int, "6"),
+		"SOL_X25":	MakeUntypedConst(Int, "262"),
+		"SOMAXCONN":	MakeUntypedConst(Int, "128"),
+		"SO_ACCEPTCONN":	MakeUntypedConst(Int, "30"),
+		"SO_ATTACH_FILTER":	MakeUntypedConst(Int, "26"),
+		"SO_BINDTODEVICE":	MakeUntypedConst(Int, "25"),
+		"SO_BROADCAST":	MakeUntypedConst(Int, "6"),
+		"SO_BSDCOMPAT":	MakeUntypedConst(Int, "14"),
+		"SO_BUSY_POLL":	MakeUntypedConst(Int, "46"),
+		"SO_DEBUG":	MakeUntypedConst(Int, "1"),
+		"SO_DETACH_FILTER":	MakeUntypedConst(Int, "27"),
+		"SO_DOMAIN":	MakeUntypedConst(Int, "39"),
+		"SO_DONTROUTE":	MakeUntypedConst(Int, "5"),
+		"SO_ERROR":	MakeUntypedConst(Int, "4"),
+		"SO_GET_FILTER":	MakeUntypedConst(Int, "26"),
+		"SO_KEEPALIVE":	MakeUntypedConst(Int, "9"),
+		"SO_LINGER":	MakeUntypedConst(Int, "13"),
+		"SO_LOCK_FILTER":	MakeUntypedConst(Int, "44"),
+		"SO_MARK":	MakeUntypedConst(Int, "36"),
+		"SO_MAX_PACING_RATE":	MakeUntypedConst(Int, "47"),
+		"SO_NOFCS":	MakeUntypedConst(Int, "43"),
+		"SO_NO_CHECK":	MakeUntypedConst(Int, "11"),
+		"SO_OOBINLINE":	MakeUntypedConst(Int, "10"),
+		"SO_PASSCRED":	MakeUntypedConst(Int, "16"),
+		"SO_PASSSEC":	MakeUntypedConst(Int, "34"),
+		"SO_PEEK_OFF":	MakeUntypedConst(Int, "42"),
+		"SO_PEERCRED":	MakeUntypedConst(Int, "17"),
+		"SO_PEERNAME":	MakeUntypedConst(Int, "28"),
+		"SO_PEERSEC":	MakeUntypedConst(Int, "31"),
+		"SO_PRIORITY":	MakeUntypedConst(Int, "12"),
+		"SO_PROTOCOL":	MakeUntypedConst(Int, "38"),
+		"SO_RCVBUF":	MakeUntypedConst(Int, "8"),
+		"SO_RCVBUFFORCE":	MakeUntypedConst(Int, "33"),
+		"SO_RCVLOWAT":	MakeUntypedConst(Int, "18"),
+		"SO_RCVTIMEO":	MakeUntypedConst(Int, "20"),
+		"SO_REUSEADDR":	MakeUntypedConst(Int, "2"),
+		"SO_REUSEPORT":	MakeUntypedConst(Int, "15"),
+		"SO_RXQ_OVFL":	MakeUntypedConst(Int, "40"),
+		"SO_SECURITY_AUTHENTICATION":	MakeUntypedConst(Int, "22"),
+		"SO_SECURITY_ENCRYPTION_NETWORK":	MakeUntypedConst(Int, "24"),
+		"SO_SECURITY_ENCRYPTION_TRANSPORT":	MakeUntypedConst(Int, "23"),
+		"SO_SELECT_ERR_QUEUE":	MakeUntypedConst(Int, "45"),
+		"SO_SNDBUF":	MakeUntypedConst(Int, "7"),
+		"SO_SNDBUFFORCE":	MakeUntypedConst(Int, "32"),
+		"SO_SNDLOWAT":	MakeUntypedConst(Int, "19"),
+		"SO_SNDTIMEO":	MakeUntypedConst(Int, "21"),
+		"SO_TIMESTAMP":	MakeUntypedConst(Int, "29"),
+		"SO_TIMESTAMPING":	MakeUntypedConst(Int, "37"),
+		"SO_TIMESTAMPNS":	MakeUntypedConst(Int, "35"),
+		"SO_TYPE":	MakeUntypedConst(Int, "3"),
+		"SO_WIFI_STATUS":	MakeUntypedConst(Int, "41"),
+		"SYS_ACCEPT":	MakeUntypedConst(Int, "202"),
+		"SYS_ACCEPT4":	MakeUntypedConst(Int, "242"),
+		"SYS_ACCT":	MakeUntypedConst(Int, "89"),
+		"SYS_ADD_KEY":	MakeUntypedConst(Int, "217"),
+		"SYS_ADJTIMEX":	MakeUntypedConst(Int, "171"),
+		"SYS_ARCH_SPECIFIC_SYSCALL":	MakeUntypedConst(Int, "244"),
+		"SYS_BIND":	MakeUntypedConst(Int, "200"),
+		"SYS_BPF":	MakeUntypedConst(Int, "280"),
+		"SYS_BRK":	MakeUntypedConst(Int, "214"),
+		"SYS_CAPGET":	MakeUntypedConst(Int, "90"),
+		"SYS_CAPSET":	MakeUntypedConst(Int, "91"),
+		"SYS_CHDIR":	MakeUntypedConst(Int, "49"),
+		"SYS_CHROOT":	MakeUntypedConst(Int, "51"),
+		"SYS_CLOCK_ADJTIME":	MakeUntypedConst(Int, "266"),
+		"SYS_CLOCK_GETRES":	MakeUntypedConst(Int, "114"),
+		"SYS_CLOCK_GETTIME":	MakeUntypedConst(Int, "113"),
+		"SYS_CLOCK_NANOSLEEP":	MakeUntypedConst(Int, "115"),
+		"SYS_CLOCK_SETTIME":	MakeUntypedConst(Int, "112"),
+		"SYS_CLONE":	MakeUntypedConst(Int, "220"),
+		"SYS_CLOSE":	MakeUntypedConst(Int, "57"),
+		"SYS_CONNECT":	MakeUntypedConst(Int, "203"),
+		"SYS_DELETE_MODULE":	MakeUntypedConst(Int, "106"),
+		"SYS_DUP":	MakeUntypedConst(Int, "23"),
+		"SYS_DUP3":	MakeUntypedConst(Int, "24"),
+		"SYS_EPOLL_CREATE1":	MakeUntypedConst(Int, "20"),
+		"SYS_EPOLL_CTL":	MakeUntypedConst(Int, "21"),
+		"SYS_EPOLL_PWAIT":	MakeUntypedConst(Int, "22"),
+		"SYS_EVENTFD2":	MakeUntypedConst(Int, "19"),
+		"SYS_EXECVE":	MakeUntypedConst(Int, "221"),
+		"SYS_EXECVEAT":	MakeUntypedConst(Int, "281"),
+		"SYS_EXIT":	MakeUntypedConst(Int, "93"),
+		"SYS_EXIT_GROUP":	MakeUntypedConst(Int, "94"),
+		"SYS_FACCESSAT":	MakeUntypedConst(Int, "48"),
+		"SYS_FADVISE64":	MakeUntypedConst(Int, "223"),
+		"SYS_FALLOCATE":	MakeUntypedConst(Int, "47"),
+		"SYS_FANOTIFY_INIT":	MakeUntypedConst(Int, "262"),
+		"SYS_FANOTIFY_MARK":	MakeUntypedConst(Int, "263"),
+		"SYS_FCHDIR":	MakeUntypedConst(Int, "50"),
+		"SYS_FCHMOD":	MakeUntypedConst(Int, "52"),
+		"SYS_FCHMODAT":	MakeUntypedConst(Int, "53"),
+		"SYS_FCHOWN":	MakeUntypedConst(Int, "55"),
+		"SYS_FCHOWNAT":	MakeUntypedConst(Int, "54"),
+		"SYS_FCNTL":	MakeUntypedConst(Int, "25"),
+		"SYS_FDATASYNC":	MakeUntypedConst(Int, "83"),
+		"SYS_FGETXATTR":	MakeUntypedConst(Int, "10"),
+		"SYS_FINIT_MODULE":	MakeUntypedConst(Int, "273"),
+		"SYS_FLISTXATTR":	MakeUntypedConst(Int, "13"),
+		"SYS_FLOCK":	MakeUntypedConst(Int, "32"),
+		"SYS_FREMOVEXATTR":	MakeUntypedConst(Int, "16"),
+		"SYS_FSETXATTR":	MakeUntypedConst(Int, "7"),
+		"SYS_FSTAT":	MakeUntypedConst(Int, "80"),
+		"SYS_FSTATAT":	MakeUntypedConst(Int, "79"),
+		"SYS_FSTATFS":	MakeUntypedConst(Int, "44"),
+		"SYS_FSYNC":	MakeUntypedConst(Int, "82"),
+		"SYS_FTRUNCATE":	MakeUntypedConst(Int, "46"),
+		"SYS_FUTEX":	MakeUntypedConst(Int, "98"),
+		"SYS_GETCPU":	MakeUntypedConst(Int, "168"),
+		"SYS_GETCWD":	MakeUntypedConst(Int, "17"),
+		"SYS_GETDENTS64":	MakeUntypedConst(Int, "61"),
+		"SYS_GETEGID":	MakeUntypedConst(Int, "177"),
+		"SYS_GETEUID":	MakeUntypedConst(Int, "175"),
+		"SYS_GETGID":	MakeUntypedConst(Int, "176"),
+		"SYS_GETGROUPS":	MakeUntypedConst(Int, "158"),
+		"SYS_GETITIMER":	MakeUntypedConst(Int, "102"),
+		"SYS_GETPEERNAME":	MakeUntypedConst(Int, "205"),
+		"SYS_GETPGID":	MakeUntypedConst(Int, "155"),
+		"SYS_GETPID":	MakeUntypedConst(Int, "172"),
+		"SYS_GETPPID":	MakeUntypedConst(Int, "173"),
+		"SYS_GETPRIORITY":	MakeUntypedConst(Int, "141"),
+		"SYS_GETRANDOM":	MakeUntypedConst(Int, "278"),
+		"SYS_GETRESGID":	MakeUntypedConst(Int, "150"),
+		"SYS_GETRESUID":	MakeUntypedConst(Int, "148"),
+		"SYS_GETRLIMIT":	MakeUntypedConst(Int, "163"),
+		"SYS_GETRUSAGE":	MakeUntypedConst(Int, "165"),
+		"SYS_GETSID":	MakeUntypedConst(Int, "156"),
+		"SYS_GETSOCKNAME":	MakeUntypedConst(Int, "204"),
+		"SYS_GETSOCKOPT":	MakeUntypedConst(Int, "209"),
+		"SYS_GETTID":	MakeUntypedConst(Int, "178"),
+		"SYS_GETTIMEOFDAY":	MakeUntypedConst(Int, "169"),
+		"SYS_GETUID":	MakeUntypedConst(Int, "174"),
+		"SYS_GETXATTR":	MakeUntypedConst(Int, "8"),
+		"SYS_GET_MEMPOLICY":	MakeUntypedConst(Int, "236"),
+		"SYS_GET_ROBUST_LIST":	MakeUntypedConst(Int, "100"),
+		"SYS_INIT_MODULE":	MakeUntypedConst(Int, "105"),
+		"SYS_INOTIFY_ADD_WATCH":	MakeUntypedConst(Int, "27"),
+		"SYS_INOTIFY_INIT1":	MakeUntypedConst(Int, "26"),
+		"SYS_INOTIFY_RM_WATCH":	MakeUntypedConst(Int, "28"),
+		"SYS_IOCTL":	MakeUntypedConst(Int, "29"),
+		"SYS_IOPRIO_GET":	MakeUntypedConst(Int, "31"),
+		"SYS_IOPRIO_SET":	MakeUntypedConst(Int, "30"),
+		"SYS_IO_CANCEL":	MakeUntypedConst(Int, "3"),
+		"SYS_IO_DESTROY":	MakeUntypedConst(Int, "1"),
+		"SYS_IO_GETEVENTS":	MakeUntypedConst(Int, "4"),
+		"SYS_IO_SETUP":	MakeUntypedConst(Int, "0"),
+		"SYS_IO_SUBMIT":	MakeUntypedConst(Int, "2"),
+		"SYS_KCMP":	MakeUntypedConst(Int, "272"),
+		"SYS_KEXEC_LOAD":	MakeUntypedConst(Int, "104"),
+		"SYS_KEYCTL":	MakeUntypedConst(Int, "219"),
+		"SYS_KILL":	MakeUntypedConst(Int, "129"),
+		"SYS_LGETXATTR":	MakeUntypedConst(Int, "9"),
+		"SYS_LINKAT":	MakeUntypedConst(Int, "37"),
+		"SYS_LISTEN":	MakeUntypedConst(Int, "201"),
+		"SYS_LISTXATTR":	MakeUntypedConst(Int, "11"),
+		"SYS_LLISTXATTR":	MakeUntypedConst(Int, "12"),
+		"SYS_LOOKUP_DCOOKIE":	MakeUntypedConst(Int, "18"),
+		"SYS_LREMOVEXATTR":	MakeUntypedConst(Int, "15"),
+		"SYS_LSEEK":	MakeUntypedConst(Int, "62"),
+		"SYS_LSETXATTR":	MakeUntypedConst(Int, "6"),
+		"SYS_MADVISE":	MakeUntypedConst(Int, "233"),
+		"SYS_MBIND":	MakeUntypedConst(Int, "235"),
+		"SYS_MEMFD_CREATE":	MakeUntypedConst(Int, "279"),
+		"SYS_MIGRATE_PAGES":	MakeUntypedConst(Int, "238"),
+		"SYS_MINCORE":	MakeUntypedConst(Int, "232"),
+		"SYS_MKDIRAT":	MakeUntypedConst(Int, "34"),
+		"SYS_MKNODAT":	MakeUntypedConst(Int, "33"),
+		"SYS_MLOCK":	MakeUntypedConst(Int, "228"),
+		"SYS_MLOCKALL":	MakeUntypedConst(Int, "230"),
+		"SYS_MMAP":	MakeUntypedConst(Int, "222"),
+		"SYS_MOUNT":	MakeUntypedConst(Int, "40"),
+		"SYS_MOVE_PAGES":	MakeUntypedConst(Int, "239"),
+		"SYS_MPROTECT":	MakeUntypedConst(Int, "226"),
+		"SYS_MQ_GETSETATTR":	MakeUntypedConst(Int, "185"),
+		"SYS_MQ_NOTIFY":	MakeUntypedConst(Int, "184"),
+		"SYS_MQ_OPEN":	MakeUntypedConst(Int, "180"),
+		"SYS_MQ_TIMEDRECEIVE":	MakeUntypedConst(Int, "183"),
+		"SYS_MQ_TIMEDSEND":	MakeUntypedConst(Int, "182"),
+		"SYS_MQ_UNLINK":	MakeUntypedConst(Int, "181"),
+		"SYS_MREMAP":	MakeUntypedConst(Int, "216"),
+		"SYS_MSGCTL":	MakeUntypedConst(Int, "187"),
+		"SYS_MSGGET":	MakeUntypedConst(Int, "186"),
+		"SYS_MSGRCV":	MakeUntypedConst(Int, "188"),
+		"SYS_MSGSND":	MakeUntypedConst(Int, "189"),
+		"SYS_MSYNC":	MakeUntypedConst(Int, "227"),
+		"SYS_MUNLOCK":	MakeUntypedConst(Int, "229"),
+		"SYS_MUNLOCKALL":	MakeUntypedConst(Int, "231"),
+		"SYS_MUNMAP":	MakeUntypedConst(Int, "215"),
+		"SYS_NAME_TO_HANDLE_AT":	MakeUntypedConst(Int, "264"),
+		"SYS_NANOSLEEP":	MakeUntypedConst(Int, "101"),
+		"SYS_NFSSERVCTL":	MakeUntypedConst(Int, "42"),
+		"SYS_OPENAT":	MakeUntypedConst(Int, "56"),
+		"SYS_OPEN_BY_HANDLE_AT":	MakeUntypedConst(Int, "265"),
+		"SYS_PERF_EVENT_OPEN":	MakeUntypedConst(Int, "241"),
+		"SYS_PERSONALITY":	MakeUntypedConst(Int, "92"),
+		"SYS_PIPE2":	MakeUntypedConst(Int, "59"),
+		"SYS_PIVOT_ROOT":	MakeUntypedConst(Int, "41"),
+		"SYS_PPOLL":	MakeUntypedConst(Int, "73"),
+		"SYS_PRCTL":	MakeUntypedConst(Int, "167"),
+		"SYS_PREAD64":	MakeUntypedConst(Int, "67"),
+		"SYS_PREADV":	MakeUntypedConst(Int, "69"),
+		"SYS_PRLIMIT64":	MakeUntypedConst(Int, "261"),
+		"SYS_PROCESS_VM_READV":	MakeUntypedConst(Int, "270"),
+		"SYS_PROCESS_VM_WRITEV":	MakeUntypedConst(Int, "271"),
+		"SYS_PSELECT6":	MakeUntypedConst(Int, "72"),
+		"SYS_PTRACE":	MakeUntypedConst(Int, "117"),
+		"SYS_PWRITE64":	MakeUntypedConst(Int, "68"),
+		"SYS_PWRITEV":	MakeUntypedConst(Int, "70"),
+		"SYS_QUOTACTL":	MakeUntypedConst(Int, "60"),
+		"SYS_READ":	MakeUntypedConst(Int, "63"),
+		"SYS_READAHEAD":	MakeUntypedConst(Int, "213"),
+		"SYS_READLINKAT":	MakeUntypedConst(Int, "78"),
+		"SYS_READV":	MakeUntypedConst(Int, "65"),
+		"SYS_REBOOT":	MakeUntypedConst(Int, "142"),
+		"SYS_RECVFROM":	MakeUntypedConst(Int, "207"),
+		"SYS_RECVMMSG":	MakeUntypedConst(Int, "243"),
+		"SYS_RECVMSG":	MakeUntypedConst(Int, "212"),
+		"SYS_REMAP_FILE_PAGES":	MakeUntypedConst(Int, "234"),
+		"SYS_REMOVEXATTR":	MakeUntypedConst(Int, "14"),
+		"SYS_RENAMEAT":	MakeUntypedConst(Int, "38"),
+		"SYS_RENAMEAT2":	MakeUntypedConst(Int, "276"),
+		"SYS_REQUEST_KEY":	MakeUntypedConst(Int, "218"),
+		"SYS_RESTART_SYSCALL":	MakeUntypedConst(Int, "128"),
+		"SYS_RT_SIGACTION":	MakeUntypedConst(Int, "134"),
+		"SYS_RT_SIGPENDING":	MakeUntypedConst(Int, "136"),
+		"SYS_RT_SIGPROCMASK":	MakeUntypedConst(Int, "135"),
+		"SYS_RT_SIGQUEUEINFO":	MakeUntypedConst(Int, "138"),
+		"SYS_RT_SIGRETURN":	MakeUntypedConst(Int, "139"),
+		"SYS_RT_SIGSUSPEND":	MakeUntypedConst(Int, "133"),
+		"SYS_RT_SIGTIMEDWAIT":	MakeUntypedConst(Int, "137"),
+		"SYS_RT_TGSIGQUEUEINFO":	MakeUntypedConst(Int, "240"),
+		"SYS_SCHED_GETAFFINITY":	MakeUntypedConst(Int, "123"),
+		"SYS_SCHED_GETATTR":	MakeUntypedConst(Int, "275"),
+		"SYS_SCHED_GETPARAM":	MakeUntypedConst(Int, "121"),
+		"SYS_SCHED_GETSCHEDULER":	MakeUntypedConst(Int, "120"),
+		"SYS_SCHED_GET_PRIORITY_MAX":	MakeUntypedConst(Int, "125"),
+		"SYS_SCHED_GET_PRIORITY_MIN":	MakeUntypedConst(Int, "126"),
+		"SYS_SCHED_RR_GET_INTERVAL":	MakeUntypedConst(Int, "127"),
+		"SYS_SCHED_SETAFFINITY":	MakeUntypedConst(Int, "122"),
+		"SYS_SCHED_SETATTR":	MakeUntypedConst(Int, "274"),
+		"SYS_SCHED_SETPARAM":	MakeUntypedConst(Int, "118"),
+		"SYS_SCHED_SETSCHEDULER":	MakeUntypedConst(Int, "119"),
+		"SYS_SCHED_YIELD":	MakeUntypedConst(Int, "124"),
+		"SYS_SECCOMP":	MakeUntypedConst(Int, "277"),
+		"SYS_SEMCTL":	MakeUntypedConst(Int, "191"),
+		"SYS_SEMGET":	MakeUntypedConst(Int, "190"),
+		"SYS_SEMOP":	MakeUntypedConst(Int, "193"),
+		"SYS_SEMTIMEDOP":	MakeUntypedConst(Int, "192"),
+		"SYS_SENDFILE":	MakeUntypedConst(Int, "71"),
+		"SYS_SENDMMSG":	MakeUntypedConst(Int, "269"),
+		"SYS_SENDMSG":	MakeUntypedConst(Int, "211"),
+		"SYS_SENDTO":	MakeUntypedConst(Int, "206"),
+		"SYS_SETDOMAINNAME":	MakeUntypedConst(Int, "162"),
+		"SYS_SETFSGID":	MakeUntypedConst(Int, "152"),
+		"SYS_SETFSUID":	MakeUntypedConst(Int, "151"),
+		"SYS_SETGID":	MakeUntypedConst(Int, "144"),
+		"SYS_SETGROUPS":	MakeUntypedConst(Int, "159"),
+		"SYS_SETHOSTNAME":	MakeUntypedConst(Int, "161"),
+		"SYS_SETITIMER":	MakeUntypedConst(Int, "103"),
+		"SYS_SETNS":	MakeUntypedConst(Int, "268"),
+		"SYS_SETPGID":	MakeUntypedConst(Int, "154"),
+		"SYS_SETPRIORITY":	MakeUntypedConst(Int, "140"),
+		"SYS_SETREGID":	MakeUntypedConst(Int, "143"),
+		"SYS_SETRESGID":	MakeUntypedConst(Int, "149"),
+		"SYS_SETRESUID":	MakeUntypedConst(Int, "147"),
+		"SYS_SETREUID":	MakeUntypedConst(Int, "145"),
+		"SYS_SETRLIMIT":	MakeUntypedConst(Int, "164"),
+		"SYS_SETSID":	MakeUntypedConst(Int, "157"),
+		"SYS_SETSOCKOPT":	MakeUntypedConst(Int, "208"),
+		"SYS_SETTIMEOFDAY":	MakeUntypedConst(Int, "170"),
+		"SYS_SETUID":	MakeUntypedConst(Int, "146"),
+		"SYS_SETXATTR":	MakeUntypedConst(Int, "5"),
+		"SYS_SET_MEMPOLICY":	MakeUntypedConst(Int, "237"),
+		"SYS_SET_ROBUST_LIST":	MakeUntypedConst(Int, "99"),
+		"SYS_SET_TID_ADDRESS":	MakeUntypedConst(Int, "96"),
+		"SYS_SHMAT":	MakeUntypedConst(Int, "196"),
+		"SYS_SHMCTL":	MakeUntypedConst(Int, "195"),
+		"SYS_SHMDT":	MakeUntypedConst(Int, "197"),
+		"SYS_SHMGET":	MakeUntypedConst(Int, "194"),
+		"SYS_SHUTDOWN":	MakeUntypedConst(Int, "210"),
+		"SYS_SIGALTSTACK":	MakeUntypedConst(Int, "132"),
+		"SYS_SIGNALFD4":	MakeUntypedConst(Int, "74"),
+		"SYS_SOCKET":	MakeUntypedConst(Int, "198"),
+		"SYS_SOCKETPAIR":	MakeUntypedConst(Int, "199"),
+		"SYS_SPLICE":	MakeUntypedConst(Int, "76"),
+		"SYS_STATFS":	MakeUntypedConst(Int, "43"),
+		"SYS_SWAPOFF":	MakeUntypedConst(Int, "225"),
+		"SYS_SWAPON":	MakeUntypedConst(Int, "224"),
+		"SYS_SYMLINKAT":	MakeUntypedConst(Int, "36"),
+		"SYS_SYNC":	MakeUntypedConst(Int, "81"),
+		"SYS_SYNCFS":	MakeUntypedConst(Int, "267"),
+		"SYS_SYNC_FILE_RANGE":	MakeUntypedConst(Int, "84"),
+		"SYS_SYNC_FILE_RANGE2":	MakeUntypedConst(Int, "84"),
+		"SYS_SYSINFO":	MakeUntypedConst(Int, "179"),
+		"SYS_SYSLOG":	MakeUntypedConst(Int, "116"),
+		"SYS_TEE":	MakeUntypedConst(Int, "77"),
+		"SYS_TGKILL":	MakeUntypedConst(Int, "131"),
+		"SYS_TIMERFD_CREATE":	MakeUntypedConst(Int, "85"),
+		"SYS_TIMERFD_GETTIME":	MakeUntypedConst(Int, "87"),
+		"SYS_TIMERFD_SETTIME":	MakeUntypedConst(Int, "86"),
+		"SYS_TIMER_CREATE":	MakeUntypedConst(Int, "107"),
+		"SYS_TIMER_DELETE":	MakeUntypedConst(Int, "111"),
+		"SYS_TIMER_GETOVERRUN":	MakeUntypedConst(Int, "109"),
+		"SYS_TIMER_GETTIME":	MakeUntypedConst(Int, "108"),
+		"SYS_TIMER_SETTIME":	MakeUntypedConst(Int, "110"),
+		"SYS_TIMES":	MakeUntypedConst(Int, "153"),
+		"SYS_TKILL":	MakeUntypedConst(Int, "130"),
+		"SYS_TRUNCATE":	MakeUntypedConst(Int, "45"),
+		"SYS_UMASK":	MakeUntypedConst(Int, "166"),
+		"SYS_UMOUNT2":	MakeUntypedConst(Int, "39"),
+		"SYS_UNAME":	MakeUntypedConst(Int, "160"),
+		"SYS_UNLINKAT":	MakeUntypedConst(Int, "35"),
+		"SYS_UNSHARE":	MakeUntypedConst(Int, "97"),
+		"SYS_UTIMENSAT":	MakeUntypedConst(Int, "88"),
+		"SYS_VHANGUP":	MakeUntypedConst(Int, "58"),
+		"SYS_VMSPLICE":	MakeUntypedConst(Int, "75"),
+		"SYS_WAIT4":	MakeUntypedConst(Int, "260"),
+		"SYS_WAITID":	MakeUntypedConst(Int, "95"),
+		"SYS_WRITE":	MakeUntypedConst(Int, "64"),
+		"SYS_WRITEV":	MakeUntypedConst(Int, "66"),
+		"S_BLKSIZE":	MakeUntypedConst(Int, "512"),
+		"S_IEXEC":	MakeUntypedConst(Int, "64"),
+		"S_IFBLK":	MakeUntypedConst(Int, "24576"),
+		"S_IFCHR":	MakeUntypedConst(Int, "8192"),
+		"S_IFDIR":	MakeUntypedConst(Int, "16384"),
+		"S_IFIFO":	MakeUntypedConst(Int, "4096"),
+		"S_IFLNK":	MakeUntypedConst(Int, "40960"),
+		"S_IFMT":	MakeUntypedConst(Int, "61440"),
+		"S_IFREG":	MakeUntypedConst(Int, "32768"),
+		"S_IFSOCK":	MakeUntypedConst(Int, "49152"),
+		"S_IREAD":	MakeUntypedConst(Int, "256"),
+		"S_IRGRP":	MakeUntypedConst(Int, "32"),
+		"S_IROTH":	MakeUntypedConst(Int, "4"),
+		"S_IRUSR":	MakeUntypedConst(Int, "256"),
+		"S_IRWXG":	MakeUntypedConst(Int, "56"),
+		"S_IRWXO":	MakeUntypedConst(Int, "7"),
+		"S_IRWXU":	MakeUntypedConst(Int, "448"),
+		"S_ISGID":	MakeUntypedConst(Int, "1024"),
+		"S_ISUID":	MakeUntypedConst(Int, "2048"),
+		"S_ISVTX":	MakeUntypedConst(Int, "512"),
+		"S_IWGRP":	MakeUntypedConst(Int, "16"),
+		"S_IWOTH":	MakeUntypedConst(Int, "2"),
+		"S_IWRITE":	MakeUntypedConst(Int, "128"),
+		"S_IWUSR":	MakeUntypedConst(Int, "128"),
+		"S_IXGRP":	MakeUntypedConst(Int, "8"),
+		"S_IXOTH":	MakeUntypedConst(Int, "1"),
+		"S_IXUSR":	MakeUntypedConst(Int, "64"),
+		"SizeofCmsghdr":	MakeUntypedConst(Int, "16"),
+		"SizeofICMPv6Filter":	MakeUntypedConst(Int, "32"),
+		"SizeofIPMreq":	MakeUntypedConst(Int, "8"),
+		"SizeofIPMreqn":	MakeUntypedConst(Int, "12"),
+		"SizeofIPv6MTUInfo":	MakeUntypedConst(Int, "32"),
+		"SizeofIPv6Mreq":	MakeUntypedConst(Int, "20"),
+		"SizeofIfAddrmsg":	MakeUntypedConst(Int, "8"),
+		"SizeofIfInfomsg":	MakeUntypedConst(Int, "16"),
+		"SizeofInet4Pktinfo":	MakeUntypedConst(Int, "12"),
+		"SizeofInet6Pktinfo":	MakeUntypedConst(Int, "20"),
+		"SizeofInotifyEvent":	MakeUntypedConst(Int, "16"),
+		"SizeofLinger":	MakeUntypedConst(Int, "8"),
+		"SizeofMsghdr":	MakeUntypedConst(Int, "56"),
+		"SizeofNlAttr":	MakeUntypedConst(Int, "4"),
+		"SizeofNlMsgerr":	MakeUntypedConst(Int, "20"),
+		"SizeofNlMsghdr":	MakeUntypedConst(Int, "16"),
+		"SizeofRtAttr":	MakeUntypedConst(Int, "4"),
+		"SizeofRtGenmsg":	MakeUntypedConst(Int, "1"),
+		"SizeofRtMsg":	MakeUntypedConst(Int, "12"),
+		"SizeofRtNexthop":	MakeUntypedConst(Int, "8"),
+		"SizeofSockFilter":	MakeUntypedConst(Int, "8"),
+		"SizeofSockFprog":	MakeUntypedConst(Int, "16"),
+		"SizeofSockaddrAny":	MakeUntypedConst(Int, "112"),
+		"SizeofSockaddrInet4":	MakeUntypedConst(Int, "16"),
+		"SizeofSockaddrInet6":	MakeUntypedConst(Int, "28"),
+		"SizeofSockaddrLinklayer":	MakeUntypedConst(Int, "20"),
+		"SizeofSockaddrNetlink":	MakeUntypedConst(Int, "12"),
+		"SizeofSockaddrUnix":	MakeUntypedConst(Int, "110"),
+		"SizeofTCPInfo":	MakeUntypedConst(Int, "104"),
+		"SizeofUcred":	MakeUntypedConst(Int, "12"),
+		"TCFLSH":	MakeUntypedConst(Int, "21515"),
+		"TCGETS":	MakeUntypedConst(Int, "21505"),
+		"TCIFLUSH":	MakeUntypedConst(Int, "0"),
+		"TCIOFLUSH":	MakeUntypedConst(Int, "2"),
+		"TCOFLUSH":	MakeUntypedConst(Int, "1"),
+		"TCP_CONGESTION":	MakeUntypedConst(Int, "13"),
+		"TCP_COOKIE_IN_ALWAYS":	MakeUntypedConst(Int, "1"),
+		"TCP_COOKIE_MAX":	MakeUntypedConst(Int, "16"),
+		"TCP_COOKIE_MIN":	MakeUntypedConst(Int, "8"),
+		"TCP_COOKIE_OUT_NEVER":	MakeUntypedConst(Int, "2"),
+		"TCP_COOKIE_PAIR_SIZE":	MakeUntypedConst(Int, "32"),
+		"TCP_COOKIE_TRANSACTIONS":	MakeUntypedConst(Int, "15"),
+		"TCP_CORK":	MakeUntypedConst(Int, "3"),
+		"TCP_DEFER_ACCEPT":	MakeUntypedConst(Int, "9"),
+		"TCP_FASTOPEN":	MakeUntypedConst(Int, "23"),
+		"TCP_INFO":	MakeUntypedConst(Int, "11"),
+		"TCP_KEEPCNT":	MakeUntypedConst(Int, "6"),
+		"TCP_KEEPIDLE":	MakeUntypedConst(Int, "4"),
+		"TCP_KEEPINTVL":	MakeUntypedConst(Int, "5"),
+		"TCP_LINGER2":	MakeUntypedConst(Int, "8"),
+		"TCP_MAXSEG":	MakeUntypedConst(Int, "2"),
+		"TCP_MAXWIN":	MakeUntypedConst(Int, "65535"),
+		"TCP_MAX_WINSHIFT":	MakeUntypedConst(Int, "14"),
+		"TCP_MD5SIG":	MakeUntypedConst(Int, "14"),
+		"TCP_MD5SIG_MAXKEYLEN":	MakeUntypedConst(Int, "80"),
+		"TCP_MSS":	MakeUntypedConst(Int, "512"),
+		"TCP_MSS_DEFAULT":	MakeUntypedConst(Int, "536"),
+		"TCP_MSS_DESIRED":	MakeUntypedConst(Int, "1220"),
+		"TCP_NODELAY":	MakeUntypedConst(Int, "1"),
+		"TCP_QUEUE_SEQ":	MakeUntypedConst(Int, "21"),
+		"TCP_QUICKACK":	MakeUntypedConst(Int, "12"),
+		"TCP_REPAIR":	MakeUntypedConst(Int, "19"),
+		"TCP_REPAIR_OPTIONS":	MakeUntypedConst(Int, "22"),
+		"TCP_REPAIR_QUEUE":	MakeUntypedConst(Int, "20"),
+		"TCP_SYNCNT":	MakeUntypedConst(Int, "7"),
+		"TCP_S_DATA_IN":	MakeUntypedConst(Int, "4"),
+		"TCP_S_DATA_OUT":	MakeUntypedConst(Int, "8"),
+		"TCP_THIN_DUPACK":	MakeUntypedConst(Int, "17"),
+		"TCP_THIN_LINEAR_TIMEOUTS":	MakeUntypedConst(Int, "16"),
+		"TCP_TIMESTAMP":	MakeUntypedConst(Int, "24"),
+		"TCP_USER_TIMEOUT":	MakeUntypedConst(Int, "18"),
+		"TCP_WINDOW_CLAMP":	MakeUntypedConst(Int, "10"),
+		"TCSAFLUSH":	MakeUntypedConst(Int, "2"),
+		"TCSETS":	MakeUntypedConst(Int, "21506"),
+		"TIOCCBRK":	MakeUntypedConst(Int, "21544"),
+		"TIOCCONS":	MakeUntypedConst(Int, "21533"),
+		"TIOCEXCL":	MakeUntypedConst(Int, "21516"),
+		"TIOCGDEV":	MakeUntypedConst(Int, "2147767346"),
+		"TIOCGETD":	MakeUntypedConst(Int, "21540"),
+		"TIOCGEXCL":	MakeUntypedConst(Int, "2147767360"),
+		"TIOCGICOUNT":	MakeUntypedConst(Int, "21597"),
+		"TIOCGLCKTRMIOS":	MakeUntypedConst(Int, "21590"),
+		"TIOCGPGRP":	MakeUntypedConst(Int, "21519"),
+		"TIOCGPKT":	MakeUntypedConst(Int, "2147767352"),
+		"TIOCGPTLCK":	MakeUntypedConst(Int, "2147767353"),
+		"TIOCGPTN":	MakeUntypedConst(Int, "2147767344"),
+		"TIOCGRS485":	MakeUntypedConst(Int, "21550"),
+		"TIOCGSERIAL":	MakeUntypedConst(Int, "21534"),
+		"TIOCGSID":	MakeUntypedConst(Int, "21545"),
+		"TIOCGSOFTCAR":	MakeUntypedConst(Int, "21529"),
+		"TIOCGWINSZ":	MakeUntypedConst(Int, "21523"),
+		"TIOCINQ":	MakeUntypedConst(Int, "21531"),
+		"TIOCLINUX":	MakeUntypedConst(Int, "21532"),
+		"TIOCMBIC":	MakeUntypedConst(Int, "21527"),
+		"TIOCMBIS":	MakeUntypedConst(Int, "21526"),
+		"TIOCMGET":	MakeUntypedConst(Int, "21525"),
+		"TIOCMIWAIT":	MakeUntypedConst(Int, "21596"),
+		"TIOCMSET":	MakeUntypedConst(Int, "21528"),
+		"TIOCM_CAR":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CD":	MakeUntypedConst(Int, "64"),
+		"TIOCM_CTS":	MakeUntypedConst(Int, "32"),
+		"TIOCM_DSR":	MakeUntypedConst(Int, "256"),
+		"TIOCM_DTR":	MakeUntypedConst(Int, "2"),
+		"TIOCM_LE":	MakeUntypedConst(Int, "1"),
+		"TIOCM_RI":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RNG":	MakeUntypedConst(Int, "128"),
+		"TIOCM_RTS":	MakeUntypedConst(Int, "4"),
+		"TIOCM_SR":	MakeUntypedConst(Int, "16"),
+		"TIOCM_ST":	MakeUntypedConst(Int, "8"),
+		"TIOCNOTTY":	MakeUntypedConst(Int, "21538"),
+		"TIOCNXCL":	MakeUntypedConst(Int, "21517"),
+		"TIOCOUTQ":	MakeUntypedConst(Int, "21521"),
+		"TIOCPKT":	MakeUntypedConst(Int, "21536"),
+		"TIOCPKT_DATA":	MakeUntypedConst(Int, "0"),
+		"TIOCPKT_DOSTOP":	MakeUntypedConst(Int, "32"),
+		"TIOCPKT_FLUSHREAD":	MakeUntypedConst(Int, "1"),
+		"TIOCPKT_FLUSHWRITE":	MakeUntypedConst(Int, "2"),
+		"TIOCPKT_IOCTL":	MakeUntypedConst(Int, "64"),
+		"TIOCPKT_NOSTOP":	MakeUntypedConst(Int, "16"),
+		"TIOCPKT_START":	MakeUntypedConst(Int, "8"),
+		"TIOCPKT_STOP":	MakeUntypedConst(Int, "4"),
+		"TIOCSBRK":	MakeUntypedConst(Int, "21543"),
+		"TIOCSCTTY":	MakeUntypedConst(Int, "21518"),
+		"TIOCSERCONFIG":	MakeUntypedConst(Int, "21587"),
+		"TIOCSERGETLSR":	MakeUntypedConst(Int, "21593"),
+		"TIOCSERGETMULTI":	MakeUntypedConst(Int, "21594"),
+		"TIOCSERGSTRUCT":	MakeUntypedConst(Int, "21592"),
+		"TIOCSERGWILD":	MakeUntypedConst(Int, "21588"),
+		"TIOCSERSETMULTI":	MakeUntypedConst(Int, "21595"),
+		"TIOCSERSWILD":	MakeUntypedConst(Int, "21589"),
+		"TIOCSER_TEMT":	MakeUntypedConst(Int, "1"),
+		"TIOCSETD":	MakeUntypedConst(Int, "21539"),
+		"TIOCSIG":	MakeUntypedConst(Int, "1074025526"),
+		"TIOCSLCKTRMIOS":	MakeUntypedConst(Int, "21591"),
+		"TIOCSPGRP":	MakeUntypedConst(Int, "21520"),
+		"TIOCSPTLCK":	MakeUntypedConst(Int, "1074025521"),
+		"TIOCSRS485":	MakeUntypedConst(Int, "21551"),
+		"TIOCSSERIAL":	MakeUntypedConst(Int, "21535"),
+		"TIOCSSOFTCAR":	MakeUntypedConst(Int, "21530"),
+		"TIOCSTI":	MakeUntypedConst(Int, "21522"),
+		"TIOCSWINSZ":	MakeUntypedConst(Int, "21524"),
+		"TIOCVHANGUP":	MakeUntypedConst(Int, "21559"),
+		"TOSTOP":	MakeUntypedConst(Int, "256"),
+		"TUNATTACHFILTER":	MakeUntypedConst(Int, "1074812117"),
+		"TUNDETACHFILTER":	MakeUntypedConst(Int, "1074812118"),
+		"TUNGETFEATURES":	MakeUntypedConst(Int, "2147767503"),
+		"TUNGETFILTER":	MakeUntypedConst(Int, "2148553947"),
+		"TUNGETIFF":	MakeUntypedConst(Int, "2147767506"),
+		"TUNGETSNDBUF":	MakeUntypedConst(Int, "2147767507"),
+		"TUNGETVNETHDRSZ":	MakeUntypedConst(Int, "2147767511"),
+		"TUNSETDEBUG":	MakeUntypedConst(Int, "1074025673"),
+		"TUNSETGROUP":	MakeUntypedConst(Int, "1074025678"),
+		"TUNSETIFF":	MakeUntypedConst(Int, "1074025674"),
+		"TUNSETIFINDEX":	MakeUntypedConst(Int, "1074025690"),
+		"TUNSETLINK":	MakeUntypedConst(Int, "1074025677"),
+		"TUNSETNOCSUM":	MakeUntypedConst(Int, "1074025672"),
+		"TUNSETOFFLOAD":	MakeUntypedConst(Int, "1074025680"),
+		"TUNSETOWNER":	MakeUntypedConst(Int, "1074025676"),
+		"TUNSETPERSIST":	MakeUntypedConst(Int, "1074025675"),
+		"TUNSETQUEUE":	MakeUntypedConst(Int, "1074025689"),
+		"TUNSETSNDBUF":	MakeUntypedConst(Int, "1074025684"),
+		"TUNSETTXFILTER":	MakeUntypedConst(Int, "1074025681"),
+		"TUNSETVNETHDRSZ":	MakeUntypedConst(Int, "1074025688"),
+		"VDISCARD":	MakeUntypedConst(Int, "13"),
+		"VEOF":	MakeUntypedConst(Int, "4"),
+		"VEOL":	MakeUntypedConst(Int, "11"),
+		"VEOL2":	MakeUntypedConst(Int, "16"),
+		"VERASE":	MakeUntypedConst(Int, "2"),
+		"VINTR":	MakeUntypedConst(Int, "0"),
+		"VKILL":	MakeUntypedConst(Int, "3"),
+		"VLNEXT":	MakeUntypedConst(Int, "15"),
+		"VMIN":	MakeUntypedConst(Int, "6"),
+		"VQUIT":	MakeUntypedConst(Int, "1"),
+		"VREPRINT":	MakeUntypedConst(Int, "12"),
+		"VSTART":	MakeUntypedConst(Int, "8"),
+		"VSTOP":	MakeUntypedConst(Int, "9"),
+		"VSUSP":	MakeUntypedConst(Int, "10"),
+		"VSWTC":	MakeUntypedConst(Int, "7"),
+		"VT0":	MakeUntypedConst(Int, "0"),
+		"VT1":	MakeUntypedConst(Int, "16384"),
+		"VTDLY":	MakeUntypedConst(Int, "16384"),
+		"VTIME":	MakeUntypedConst(Int, "5"),
+		"VWERASE":	MakeUntypedConst(Int, "14"),
+		"WALL":	MakeUntypedConst(Int, "1073741824"),
+		"WCLONE":	MakeUntypedConst(Int, "2147483648"),
+		"WCONTINUED":	MakeUntypedConst(Int, "8"),
+		"WEXITED":	MakeUntypedConst(Int, "4"),
+		"WNOHANG":	MakeUntypedConst(Int, "1"),
+		"WNOTHREAD":	MakeUntypedConst(Int, "536870912"),
+		"WNOWAIT":	MakeUntypedConst(Int, "16777216"),
+		"WORDSIZE":	MakeUntypedConst(Int, "64"),
+		"WSTOPPED":	MakeUntypedConst(Int, "2"),
+		"WUNTRACED":	MakeUntypedConst(Int, "2"),
+		"XCASE":	MakeUntypedConst(Int, "4"),
+	} } })
 }
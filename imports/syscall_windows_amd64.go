@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "syscall"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_syscall
+// +build !gomacro_slim gomacro_syscall
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["syscall"] = Package{
+	Register("syscall", func() Package { return Package{
 	Binds: map[string]Value{
 		"AF_INET":	ValueOf(syscall.AF_INET),
 		"AF_INET6":	ValueOf(syscall.AF_INET6),
@@ -990,5 +993,494 @@ func init() {
 		"Win32finddata":	TypeOf((*syscall.Win32finddata)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"AF_INET":	MakeUntypedConst(Int, "2"),
+		"AF_INET6":	MakeUntypedConst(Int, "23"),
+		"AF_NETBIOS":	MakeUntypedConst(Int, "17"),
+		"AF_UNIX":	MakeUntypedConst(Int, "1"),
+		"AF_UNSPEC":	MakeUntypedConst(Int, "0"),
+		"AI_CANONNAME":	MakeUntypedConst(Int, "2"),
+		"AI_NUMERICHOST":	MakeUntypedConst(Int, "4"),
+		"AI_PASSIVE":	MakeUntypedConst(Int, "1"),
+		"APPLICATION_ERROR":	MakeUntypedConst(Int, "536870912"),
+		"AUTHTYPE_CLIENT":	MakeUntypedConst(Int, "1"),
+		"AUTHTYPE_SERVER":	MakeUntypedConst(Int, "2"),
+		"BASE_PROTOCOL":	MakeUntypedConst(Int, "1"),
+		"CERT_CHAIN_POLICY_AUTHENTICODE":	MakeUntypedConst(Int, "2"),
+		"CERT_CHAIN_POLICY_AUTHENTICODE_TS":	MakeUntypedConst(Int, "3"),
+		"CERT_CHAIN_POLICY_BASE":	MakeUntypedConst(Int, "1"),
+		"CERT_CHAIN_POLICY_BASIC_CONSTRAINTS":	MakeUntypedConst(Int, "5"),
+		"CERT_CHAIN_POLICY_EV":	MakeUntypedConst(Int, "8"),
+		"CERT_CHAIN_POLICY_MICROSOFT_ROOT":	MakeUntypedConst(Int, "7"),
+		"CERT_CHAIN_POLICY_NT_AUTH":	MakeUntypedConst(Int, "6"),
+		"CERT_CHAIN_POLICY_SSL":	MakeUntypedConst(Int, "4"),
+		"CERT_E_CN_NO_MATCH":	MakeUntypedConst(Int, "2148204815"),
+		"CERT_E_EXPIRED":	MakeUntypedConst(Int, "2148204801"),
+		"CERT_E_PURPOSE":	MakeUntypedConst(Int, "2148204806"),
+		"CERT_E_ROLE":	MakeUntypedConst(Int, "2148204803"),
+		"CERT_E_UNTRUSTEDROOT":	MakeUntypedConst(Int, "2148204809"),
+		"CERT_STORE_ADD_ALWAYS":	MakeUntypedConst(Int, "4"),
+		"CERT_STORE_DEFER_CLOSE_UNTIL_LAST_FREE_FLAG":	MakeUntypedConst(Int, "4"),
+		"CERT_STORE_PROV_MEMORY":	MakeUntypedConst(Int, "2"),
+		"CERT_TRUST_HAS_EXCLUDED_NAME_CONSTRAINT":	MakeUntypedConst(Int, "32768"),
+		"CERT_TRUST_HAS_NOT_DEFINED_NAME_CONSTRAINT":	MakeUntypedConst(Int, "8192"),
+		"CERT_TRUST_HAS_NOT_PERMITTED_NAME_CONSTRAINT":	MakeUntypedConst(Int, "16384"),
+		"CERT_TRUST_HAS_NOT_SUPPORTED_CRITICAL_EXT":	MakeUntypedConst(Int, "134217728"),
+		"CERT_TRUST_HAS_NOT_SUPPORTED_NAME_CONSTRAINT":	MakeUntypedConst(Int, "4096"),
+		"CERT_TRUST_INVALID_BASIC_CONSTRAINTS":	MakeUntypedConst(Int, "1024"),
+		"CERT_TRUST_INVALID_EXTENSION":	MakeUntypedConst(Int, "256"),
+		"CERT_TRUST_INVALID_NAME_CONSTRAINTS":	MakeUntypedConst(Int, "2048"),
+		"CERT_TRUST_INVALID_POLICY_CONSTRAINTS":	MakeUntypedConst(Int, "512"),
+		"CERT_TRUST_IS_CYCLIC":	MakeUntypedConst(Int, "128"),
+		"CERT_TRUST_IS_EXPLICIT_DISTRUST":	MakeUntypedConst(Int, "67108864"),
+		"CERT_TRUST_IS_NOT_SIGNATURE_VALID":	MakeUntypedConst(Int, "8"),
+		"CERT_TRUST_IS_NOT_TIME_VALID":	MakeUntypedConst(Int, "1"),
+		"CERT_TRUST_IS_NOT_VALID_FOR_USAGE":	MakeUntypedConst(Int, "16"),
+		"CERT_TRUST_IS_OFFLINE_REVOCATION":	MakeUntypedConst(Int, "16777216"),
+		"CERT_TRUST_IS_REVOKED":	MakeUntypedConst(Int, "4"),
+		"CERT_TRUST_IS_UNTRUSTED_ROOT":	MakeUntypedConst(Int, "32"),
+		"CERT_TRUST_NO_ERROR":	MakeUntypedConst(Int, "0"),
+		"CERT_TRUST_NO_ISSUANCE_CHAIN_POLICY":	MakeUntypedConst(Int, "33554432"),
+		"CERT_TRUST_REVOCATION_STATUS_UNKNOWN":	MakeUntypedConst(Int, "64"),
+		"CREATE_ALWAYS":	MakeUntypedConst(Int, "2"),
+		"CREATE_NEW":	MakeUntypedConst(Int, "1"),
+		"CREATE_NEW_PROCESS_GROUP":	MakeUntypedConst(Int, "512"),
+		"CREATE_UNICODE_ENVIRONMENT":	MakeUntypedConst(Int, "1024"),
+		"CRYPT_DEFAULT_CONTAINER_OPTIONAL":	MakeUntypedConst(Int, "128"),
+		"CRYPT_DELETEKEYSET":	MakeUntypedConst(Int, "16"),
+		"CRYPT_MACHINE_KEYSET":	MakeUntypedConst(Int, "32"),
+		"CRYPT_NEWKEYSET":	MakeUntypedConst(Int, "8"),
+		"CRYPT_SILENT":	MakeUntypedConst(Int, "64"),
+		"CRYPT_VERIFYCONTEXT":	MakeUntypedConst(Int, "4026531840"),
+		"CTRL_BREAK_EVENT":	MakeUntypedConst(Int, "1"),
+		"CTRL_C_EVENT":	MakeUntypedConst(Int, "0"),
+		"DNS_INFO_NO_RECORDS":	MakeUntypedConst(Int, "9501"),
+		"DNS_TYPE_A":	MakeUntypedConst(Int, "1"),
+		"DNS_TYPE_A6":	MakeUntypedConst(Int, "38"),
+		"DNS_TYPE_AAAA":	MakeUntypedConst(Int, "28"),
+		"DNS_TYPE_ADDRS":	MakeUntypedConst(Int, "248"),
+		"DNS_TYPE_AFSDB":	MakeUntypedConst(Int, "18"),
+		"DNS_TYPE_ALL":	MakeUntypedConst(Int, "255"),
+		"DNS_TYPE_ANY":	MakeUntypedConst(Int, "255"),
+		"DNS_TYPE_ATMA":	MakeUntypedConst(Int, "34"),
+		"DNS_TYPE_AXFR":	MakeUntypedConst(Int, "252"),
+		"DNS_TYPE_CERT":	MakeUntypedConst(Int, "37"),
+		"DNS_TYPE_CNAME":	MakeUntypedConst(Int, "5"),
+		"DNS_TYPE_DHCID":	MakeUntypedConst(Int, "49"),
+		"DNS_TYPE_DNAME":	MakeUntypedConst(Int, "39"),
+		"DNS_TYPE_DNSKEY":	MakeUntypedConst(Int, "48"),
+		"DNS_TYPE_DS":	MakeUntypedConst(Int, "43"),
+		"DNS_TYPE_EID":	MakeUntypedConst(Int, "31"),
+		"DNS_TYPE_GID":	MakeUntypedConst(Int, "102"),
+		"DNS_TYPE_GPOS":	MakeUntypedConst(Int, "27"),
+		"DNS_TYPE_HINFO":	MakeUntypedConst(Int, "13"),
+		"DNS_TYPE_ISDN":	MakeUntypedConst(Int, "20"),
+		"DNS_TYPE_IXFR":	MakeUntypedConst(Int, "251"),
+		"DNS_TYPE_KEY":	MakeUntypedConst(Int, "25"),
+		"DNS_TYPE_KX":	MakeUntypedConst(Int, "36"),
+		"DNS_TYPE_LOC":	MakeUntypedConst(Int, "29"),
+		"DNS_TYPE_MAILA":	MakeUntypedConst(Int, "254"),
+		"DNS_TYPE_MAILB":	MakeUntypedConst(Int, "253"),
+		"DNS_TYPE_MB":	MakeUntypedConst(Int, "7"),
+		"DNS_TYPE_MD":	MakeUntypedConst(Int, "3"),
+		"DNS_TYPE_MF":	MakeUntypedConst(Int, "4"),
+		"DNS_TYPE_MG":	MakeUntypedConst(Int, "8"),
+		"DNS_TYPE_MINFO":	MakeUntypedConst(Int, "14"),
+		"DNS_TYPE_MR":	MakeUntypedConst(Int, "9"),
+		"DNS_TYPE_MX":	MakeUntypedConst(Int, "15"),
+		"DNS_TYPE_NAPTR":	MakeUntypedConst(Int, "35"),
+		"DNS_TYPE_NBSTAT":	MakeUntypedConst(Int, "65281"),
+		"DNS_TYPE_NIMLOC":	MakeUntypedConst(Int, "32"),
+		"DNS_TYPE_NS":	MakeUntypedConst(Int, "2"),
+		"DNS_TYPE_NSAP":	MakeUntypedConst(Int, "22"),
+		"DNS_TYPE_NSAPPTR":	MakeUntypedConst(Int, "23"),
+		"DNS_TYPE_NSEC":	MakeUntypedConst(Int, "47"),
+		"DNS_TYPE_NULL":	MakeUntypedConst(Int, "10"),
+		"DNS_TYPE_NXT":	MakeUntypedConst(Int, "30"),
+		"DNS_TYPE_OPT":	MakeUntypedConst(Int, "41"),
+		"DNS_TYPE_PTR":	MakeUntypedConst(Int, "12"),
+		"DNS_TYPE_PX":	MakeUntypedConst(Int, "26"),
+		"DNS_TYPE_RP":	MakeUntypedConst(Int, "17"),
+		"DNS_TYPE_RRSIG":	MakeUntypedConst(Int, "46"),
+		"DNS_TYPE_RT":	MakeUntypedConst(Int, "21"),
+		"DNS_TYPE_SIG":	MakeUntypedConst(Int, "24"),
+		"DNS_TYPE_SINK":	MakeUntypedConst(Int, "40"),
+		"DNS_TYPE_SOA":	MakeUntypedConst(Int, "6"),
+		"DNS_TYPE_SRV":	MakeUntypedConst(Int, "33"),
+		"DNS_TYPE_TEXT":	MakeUntypedConst(Int, "16"),
+		"DNS_TYPE_TKEY":	MakeUntypedConst(Int, "249"),
+		"DNS_TYPE_TSIG":	MakeUntypedConst(Int, "250"),
+		"DNS_TYPE_UID":	MakeUntypedConst(Int, "101"),
+		"DNS_TYPE_UINFO":	MakeUntypedConst(Int, "100"),
+		"DNS_TYPE_UNSPEC":	MakeUntypedConst(Int, "103"),
+		"DNS_TYPE_WINS":	MakeUntypedConst(Int, "65281"),
+		"DNS_TYPE_WINSR":	MakeUntypedConst(Int, "65282"),
+		"DNS_TYPE_WKS":	MakeUntypedConst(Int, "11"),
+		"DNS_TYPE_X25":	MakeUntypedConst(Int, "19"),
+		"DUPLICATE_CLOSE_SOURCE":	MakeUntypedConst(Int, "1"),
+		"DUPLICATE_SAME_ACCESS":	MakeUntypedConst(Int, "2"),
+		"DnsSectionAdditional":	MakeUntypedConst(Int, "3"),
+		"DnsSectionAnswer":	MakeUntypedConst(Int, "1"),
+		"DnsSectionAuthority":	MakeUntypedConst(Int, "2"),
+		"DnsSectionQuestion":	MakeUntypedConst(Int, "0"),
+		"FILE_ACTION_ADDED":	MakeUntypedConst(Int, "1"),
+		"FILE_ACTION_MODIFIED":	MakeUntypedConst(Int, "3"),
+		"FILE_ACTION_REMOVED":	MakeUntypedConst(Int, "2"),
+		"FILE_ACTION_RENAMED_NEW_NAME":	MakeUntypedConst(Int, "5"),
+		"FILE_ACTION_RENAMED_OLD_NAME":	MakeUntypedConst(Int, "4"),
+		"FILE_APPEND_DATA":	MakeUntypedConst(Int, "4"),
+		"FILE_ATTRIBUTE_ARCHIVE":	MakeUntypedConst(Int, "32"),
+		"FILE_ATTRIBUTE_DIRECTORY":	MakeUntypedConst(Int, "16"),
+		"FILE_ATTRIBUTE_HIDDEN":	MakeUntypedConst(Int, "2"),
+		"FILE_ATTRIBUTE_NORMAL":	MakeUntypedConst(Int, "128"),
+		"FILE_ATTRIBUTE_READONLY":	MakeUntypedConst(Int, "1"),
+		"FILE_ATTRIBUTE_REPARSE_POINT":	MakeUntypedConst(Int, "1024"),
+		"FILE_ATTRIBUTE_SYSTEM":	MakeUntypedConst(Int, "4"),
+		"FILE_BEGIN":	MakeUntypedConst(Int, "0"),
+		"FILE_CURRENT":	MakeUntypedConst(Int, "1"),
+		"FILE_END":	MakeUntypedConst(Int, "2"),
+		"FILE_FLAG_BACKUP_SEMANTICS":	MakeUntypedConst(Int, "33554432"),
+		"FILE_FLAG_OPEN_REPARSE_POINT":	MakeUntypedConst(Int, "2097152"),
+		"FILE_FLAG_OVERLAPPED":	MakeUntypedConst(Int, "1073741824"),
+		"FILE_LIST_DIRECTORY":	MakeUntypedConst(Int, "1"),
+		"FILE_MAP_COPY":	MakeUntypedConst(Int, "1"),
+		"FILE_MAP_EXECUTE":	MakeUntypedConst(Int, "32"),
+		"FILE_MAP_READ":	MakeUntypedConst(Int, "4"),
+		"FILE_MAP_WRITE":	MakeUntypedConst(Int, "2"),
+		"FILE_NOTIFY_CHANGE_ATTRIBUTES":	MakeUntypedConst(Int, "4"),
+		"FILE_NOTIFY_CHANGE_CREATION":	MakeUntypedConst(Int, "64"),
+		"FILE_NOTIFY_CHANGE_DIR_NAME":	MakeUntypedConst(Int, "2"),
+		"FILE_NOTIFY_CHANGE_FILE_NAME":	MakeUntypedConst(Int, "1"),
+		"FILE_NOTIFY_CHANGE_LAST_ACCESS":	MakeUntypedConst(Int, "32"),
+		"FILE_NOTIFY_CHANGE_LAST_WRITE":	MakeUntypedConst(Int, "16"),
+		"FILE_NOTIFY_CHANGE_SIZE":	MakeUntypedConst(Int, "8"),
+		"FILE_SHARE_DELETE":	MakeUntypedConst(Int, "4"),
+		"FILE_SHARE_READ":	MakeUntypedConst(Int, "1"),
+		"FILE_SHARE_WRITE":	MakeUntypedConst(Int, "2"),
+		"FILE_SKIP_COMPLETION_PORT_ON_SUCCESS":	MakeUntypedConst(Int, "1"),
+		"FILE_SKIP_SET_EVENT_ON_HANDLE":	MakeUntypedConst(Int, "2"),
+		"FILE_TYPE_CHAR":	MakeUntypedConst(Int, "2"),
+		"FILE_TYPE_DISK":	MakeUntypedConst(Int, "1"),
+		"FILE_TYPE_PIPE":	MakeUntypedConst(Int, "3"),
+		"FILE_TYPE_REMOTE":	MakeUntypedConst(Int, "32768"),
+		"FILE_TYPE_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"FILE_WRITE_ATTRIBUTES":	MakeUntypedConst(Int, "256"),
+		"FORMAT_MESSAGE_ALLOCATE_BUFFER":	MakeUntypedConst(Int, "256"),
+		"FORMAT_MESSAGE_ARGUMENT_ARRAY":	MakeUntypedConst(Int, "8192"),
+		"FORMAT_MESSAGE_FROM_HMODULE":	MakeUntypedConst(Int, "2048"),
+		"FORMAT_MESSAGE_FROM_STRING":	MakeUntypedConst(Int, "1024"),
+		"FORMAT_MESSAGE_FROM_SYSTEM":	MakeUntypedConst(Int, "4096"),
+		"FORMAT_MESSAGE_IGNORE_INSERTS":	MakeUntypedConst(Int, "512"),
+		"FORMAT_MESSAGE_MAX_WIDTH_MASK":	MakeUntypedConst(Int, "255"),
+		"FSCTL_GET_REPARSE_POINT":	MakeUntypedConst(Int, "589992"),
+		"GENERIC_ALL":	MakeUntypedConst(Int, "268435456"),
+		"GENERIC_EXECUTE":	MakeUntypedConst(Int, "536870912"),
+		"GENERIC_READ":	MakeUntypedConst(Int, "2147483648"),
+		"GENERIC_WRITE":	MakeUntypedConst(Int, "1073741824"),
+		"GetFileExInfoStandard":	MakeUntypedConst(Int, "0"),
+		"GetFileExMaxInfoLevel":	MakeUntypedConst(Int, "1"),
+		"HANDLE_FLAG_INHERIT":	MakeUntypedConst(Int, "1"),
+		"HKEY_CLASSES_ROOT":	MakeUntypedConst(Int, "2147483648"),
+		"HKEY_CURRENT_CONFIG":	MakeUntypedConst(Int, "2147483653"),
+		"HKEY_CURRENT_USER":	MakeUntypedConst(Int, "2147483649"),
+		"HKEY_DYN_DATA":	MakeUntypedConst(Int, "2147483654"),
+		"HKEY_LOCAL_MACHINE":	MakeUntypedConst(Int, "2147483650"),
+		"HKEY_PERFORMANCE_DATA":	MakeUntypedConst(Int, "2147483652"),
+		"HKEY_USERS":	MakeUntypedConst(Int, "2147483651"),
+		"IFF_BROADCAST":	MakeUntypedConst(Int, "2"),
+		"IFF_LOOPBACK":	MakeUntypedConst(Int, "4"),
+		"IFF_MULTICAST":	MakeUntypedConst(Int, "16"),
+		"IFF_POINTTOPOINT":	MakeUntypedConst(Int, "8"),
+		"IFF_UP":	MakeUntypedConst(Int, "1"),
+		"IGNORE":	MakeUntypedConst(Int, "0"),
+		"INFINITE":	MakeUntypedConst(Int, "4294967295"),
+		"INVALID_FILE_ATTRIBUTES":	MakeUntypedConst(Int, "4294967295"),
+		"IOC_IN":	MakeUntypedConst(Int, "2147483648"),
+		"IOC_INOUT":	MakeUntypedConst(Int, "3221225472"),
+		"IOC_OUT":	MakeUntypedConst(Int, "1073741824"),
+		"IOC_VENDOR":	MakeUntypedConst(Int, "402653184"),
+		"IOC_WS2":	MakeUntypedConst(Int, "134217728"),
+		"IO_REPARSE_TAG_SYMLINK":	MakeUntypedConst(Int, "2684354572"),
+		"IPPROTO_IP":	MakeUntypedConst(Int, "0"),
+		"IPPROTO_IPV6":	MakeUntypedConst(Int, "41"),
+		"IPPROTO_TCP":	MakeUntypedConst(Int, "6"),
+		"IPPROTO_UDP":	MakeUntypedConst(Int, "17"),
+		"IPV6_JOIN_GROUP":	MakeUntypedConst(Int, "12"),
+		"IPV6_LEAVE_GROUP":	MakeUntypedConst(Int, "13"),
+		"IPV6_MULTICAST_HOPS":	MakeUntypedConst(Int, "10"),
+		"IPV6_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IPV6_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IPV6_UNICAST_HOPS":	MakeUntypedConst(Int, "4"),
+		"IPV6_V6ONLY":	MakeUntypedConst(Int, "27"),
+		"IP_ADD_MEMBERSHIP":	MakeUntypedConst(Int, "12"),
+		"IP_DROP_MEMBERSHIP":	MakeUntypedConst(Int, "13"),
+		"IP_MULTICAST_IF":	MakeUntypedConst(Int, "9"),
+		"IP_MULTICAST_LOOP":	MakeUntypedConst(Int, "11"),
+		"IP_MULTICAST_TTL":	MakeUntypedConst(Int, "10"),
+		"IP_TOS":	MakeUntypedConst(Int, "3"),
+		"IP_TTL":	MakeUntypedConst(Int, "4"),
+		"ImplementsGetwd":	MakeUntypedConst(Bool, "true"),
+		"KEY_ALL_ACCESS":	MakeUntypedConst(Int, "983103"),
+		"KEY_CREATE_LINK":	MakeUntypedConst(Int, "32"),
+		"KEY_CREATE_SUB_KEY":	MakeUntypedConst(Int, "4"),
+		"KEY_ENUMERATE_SUB_KEYS":	MakeUntypedConst(Int, "8"),
+		"KEY_EXECUTE":	MakeUntypedConst(Int, "131097"),
+		"KEY_NOTIFY":	MakeUntypedConst(Int, "16"),
+		"KEY_QUERY_VALUE":	MakeUntypedConst(Int, "1"),
+		"KEY_READ":	MakeUntypedConst(Int, "131097"),
+		"KEY_SET_VALUE":	MakeUntypedConst(Int, "2"),
+		"KEY_WOW64_32KEY":	MakeUntypedConst(Int, "512"),
+		"KEY_WOW64_64KEY":	MakeUntypedConst(Int, "256"),
+		"KEY_WRITE":	MakeUntypedConst(Int, "131078"),
+		"LANG_ENGLISH":	MakeUntypedConst(Int, "9"),
+		"LAYERED_PROTOCOL":	MakeUntypedConst(Int, "0"),
+		"MAXIMUM_REPARSE_DATA_BUFFER_SIZE":	MakeUntypedConst(Int, "16384"),
+		"MAXLEN_IFDESCR":	MakeUntypedConst(Int, "256"),
+		"MAXLEN_PHYSADDR":	MakeUntypedConst(Int, "8"),
+		"MAX_ADAPTER_ADDRESS_LENGTH":	MakeUntypedConst(Int, "8"),
+		"MAX_ADAPTER_DESCRIPTION_LENGTH":	MakeUntypedConst(Int, "128"),
+		"MAX_ADAPTER_NAME_LENGTH":	MakeUntypedConst(Int, "256"),
+		"MAX_COMPUTERNAME_LENGTH":	MakeUntypedConst(Int, "15"),
+		"MAX_INTERFACE_NAME_LEN":	MakeUntypedConst(Int, "256"),
+		"MAX_LONG_PATH":	MakeUntypedConst(Int, "32768"),
+		"MAX_PATH":	MakeUntypedConst(Int, "260"),
+		"MAX_PROTOCOL_CHAIN":	MakeUntypedConst(Int, "7"),
+		"MaxTokenInfoClass":	MakeUntypedConst(Int, "29"),
+		"NameCanonical":	MakeUntypedConst(Int, "7"),
+		"NameCanonicalEx":	MakeUntypedConst(Int, "9"),
+		"NameDisplay":	MakeUntypedConst(Int, "3"),
+		"NameDnsDomain":	MakeUntypedConst(Int, "12"),
+		"NameFullyQualifiedDN":	MakeUntypedConst(Int, "1"),
+		"NameSamCompatible":	MakeUntypedConst(Int, "2"),
+		"NameServicePrincipal":	MakeUntypedConst(Int, "10"),
+		"NameUniqueId":	MakeUntypedConst(Int, "6"),
+		"NameUnknown":	MakeUntypedConst(Int, "0"),
+		"NameUserPrincipal":	MakeUntypedConst(Int, "8"),
+		"NetSetupDomainName":	MakeUntypedConst(Int, "3"),
+		"NetSetupUnjoined":	MakeUntypedConst(Int, "1"),
+		"NetSetupUnknownStatus":	MakeUntypedConst(Int, "0"),
+		"NetSetupWorkgroupName":	MakeUntypedConst(Int, "2"),
+		"OPEN_ALWAYS":	MakeUntypedConst(Int, "4"),
+		"OPEN_EXISTING":	MakeUntypedConst(Int, "3"),
+		"O_APPEND":	MakeUntypedConst(Int, "1024"),
+		"O_ASYNC":	MakeUntypedConst(Int, "8192"),
+		"O_CLOEXEC":	MakeUntypedConst(Int, "524288"),
+		"O_CREAT":	MakeUntypedConst(Int, "64"),
+		"O_EXCL":	MakeUntypedConst(Int, "128"),
+		"O_NOCTTY":	MakeUntypedConst(Int, "256"),
+		"O_NONBLOCK":	MakeUntypedConst(Int, "2048"),
+		"O_RDONLY":	MakeUntypedConst(Int, "0"),
+		"O_RDWR":	MakeUntypedConst(Int, "2"),
+		"O_SYNC":	MakeUntypedConst(Int, "4096"),
+		"O_TRUNC":	MakeUntypedConst(Int, "512"),
+		"O_WRONLY":	MakeUntypedConst(Int, "1"),
+		"PAGE_EXECUTE_READ":	MakeUntypedConst(Int, "32"),
+		"PAGE_EXECUTE_READWRITE":	MakeUntypedConst(Int, "64"),
+		"PAGE_EXECUTE_WRITECOPY":	MakeUntypedConst(Int, "128"),
+		"PAGE_READONLY":	MakeUntypedConst(Int, "2"),
+		"PAGE_READWRITE":	MakeUntypedConst(Int, "4"),
+		"PAGE_WRITECOPY":	MakeUntypedConst(Int, "8"),
+		"PFL_HIDDEN":	MakeUntypedConst(Int, "4"),
+		"PFL_MATCHES_PROTOCOL_ZERO":	MakeUntypedConst(Int, "8"),
+		"PFL_MULTIPLE_PROTO_ENTRIES":	MakeUntypedConst(Int, "1"),
+		"PFL_NETWORKDIRECT_PROVIDER":	MakeUntypedConst(Int, "16"),
+		"PFL_RECOMMENDED_PROTO_ENTRY":	MakeUntypedConst(Int, "2"),
+		"PKCS_7_ASN_ENCODING":	MakeUntypedConst(Int, "65536"),
+		"PROCESS_QUERY_INFORMATION":	MakeUntypedConst(Int, "1024"),
+		"PROCESS_TERMINATE":	MakeUntypedConst(Int, "1"),
+		"PROV_DH_SCHANNEL":	MakeUntypedConst(Int, "18"),
+		"PROV_DSS":	MakeUntypedConst(Int, "3"),
+		"PROV_DSS_DH":	MakeUntypedConst(Int, "13"),
+		"PROV_EC_ECDSA_FULL":	MakeUntypedConst(Int, "16"),
+		"PROV_EC_ECDSA_SIG":	MakeUntypedConst(Int, "14"),
+		"PROV_EC_ECNRA_FULL":	MakeUntypedConst(Int, "17"),
+		"PROV_EC_ECNRA_SIG":	MakeUntypedConst(Int, "15"),
+		"PROV_FORTEZZA":	MakeUntypedConst(Int, "4"),
+		"PROV_INTEL_SEC":	MakeUntypedConst(Int, "22"),
+		"PROV_MS_EXCHANGE":	MakeUntypedConst(Int, "5"),
+		"PROV_REPLACE_OWF":	MakeUntypedConst(Int, "23"),
+		"PROV_RNG":	MakeUntypedConst(Int, "21"),
+		"PROV_RSA_AES":	MakeUntypedConst(Int, "24"),
+		"PROV_RSA_FULL":	MakeUntypedConst(Int, "1"),
+		"PROV_RSA_SCHANNEL":	MakeUntypedConst(Int, "12"),
+		"PROV_RSA_SIG":	MakeUntypedConst(Int, "2"),
+		"PROV_SPYRUS_LYNKS":	MakeUntypedConst(Int, "20"),
+		"PROV_SSL":	MakeUntypedConst(Int, "6"),
+		"REG_BINARY":	MakeUntypedConst(Int, "3"),
+		"REG_DWORD":	MakeUntypedConst(Int, "4"),
+		"REG_DWORD_BIG_ENDIAN":	MakeUntypedConst(Int, "5"),
+		"REG_DWORD_LITTLE_ENDIAN":	MakeUntypedConst(Int, "4"),
+		"REG_EXPAND_SZ":	MakeUntypedConst(Int, "2"),
+		"REG_FULL_RESOURCE_DESCRIPTOR":	MakeUntypedConst(Int, "9"),
+		"REG_LINK":	MakeUntypedConst(Int, "6"),
+		"REG_MULTI_SZ":	MakeUntypedConst(Int, "7"),
+		"REG_NONE":	MakeUntypedConst(Int, "0"),
+		"REG_QWORD":	MakeUntypedConst(Int, "11"),
+		"REG_QWORD_LITTLE_ENDIAN":	MakeUntypedConst(Int, "11"),
+		"REG_RESOURCE_LIST":	MakeUntypedConst(Int, "8"),
+		"REG_RESOURCE_REQUIREMENTS_LIST":	MakeUntypedConst(Int, "10"),
+		"REG_SZ":	MakeUntypedConst(Int, "1"),
+		"SHUT_RD":	MakeUntypedConst(Int, "0"),
+		"SHUT_RDWR":	MakeUntypedConst(Int, "2"),
+		"SHUT_WR":	MakeUntypedConst(Int, "1"),
+		"SIO_GET_EXTENSION_FUNCTION_POINTER":	MakeUntypedConst(Int, "3355443206"),
+		"SIO_GET_INTERFACE_LIST":	MakeUntypedConst(Int, "1074033791"),
+		"SIO_KEEPALIVE_VALS":	MakeUntypedConst(Int, "2550136836"),
+		"SIO_UDP_CONNRESET":	MakeUntypedConst(Int, "2550136844"),
+		"SOCK_DGRAM":	MakeUntypedConst(Int, "2"),
+		"SOCK_RAW":	MakeUntypedConst(Int, "3"),
+		"SOCK_SEQPACKET":	MakeUntypedConst(Int, "5"),
+		"SOCK_STREAM":	MakeUntypedConst(Int, "1"),
+		"SOL_SOCKET":	MakeUntypedConst(Int, "65535"),
+		"SOMAXCONN":	MakeUntypedConst(Int, "2147483647"),
+		"SO_BROADCAST":	MakeUntypedConst(Int, "32"),
+		"SO_DONTROUTE":	MakeUntypedConst(Int, "16"),
+		"SO_KEEPALIVE":	MakeUntypedConst(Int, "8"),
+		"SO_LINGER":	MakeUntypedConst(Int, "128"),
+		"SO_RCVBUF":	MakeUntypedConst(Int, "4098"),
+		"SO_REUSEADDR":	MakeUntypedConst(Int, "4"),
+		"SO_SNDBUF":	MakeUntypedConst(Int, "4097"),
+		"SO_UPDATE_ACCEPT_CONTEXT":	MakeUntypedConst(Int, "28683"),
+		"SO_UPDATE_CONNECT_CONTEXT":	MakeUntypedConst(Int, "28688"),
+		"STANDARD_RIGHTS_ALL":	MakeUntypedConst(Int, "2031616"),
+		"STANDARD_RIGHTS_EXECUTE":	MakeUntypedConst(Int, "131072"),
+		"STANDARD_RIGHTS_READ":	MakeUntypedConst(Int, "131072"),
+		"STANDARD_RIGHTS_REQUIRED":	MakeUntypedConst(Int, "983040"),
+		"STANDARD_RIGHTS_WRITE":	MakeUntypedConst(Int, "131072"),
+		"STARTF_USESHOWWINDOW":	MakeUntypedConst(Int, "1"),
+		"STARTF_USESTDHANDLES":	MakeUntypedConst(Int, "256"),
+		"STD_ERROR_HANDLE":	MakeUntypedConst(Int, "-12"),
+		"STD_INPUT_HANDLE":	MakeUntypedConst(Int, "-10"),
+		"STD_OUTPUT_HANDLE":	MakeUntypedConst(Int, "-11"),
+		"SUBLANG_ENGLISH_US":	MakeUntypedConst(Int, "1"),
+		"SW_FORCEMINIMIZE":	MakeUntypedConst(Int, "11"),
+		"SW_HIDE":	MakeUntypedConst(Int, "0"),
+		"SW_MAXIMIZE":	MakeUntypedConst(Int, "3"),
+		"SW_MINIMIZE":	MakeUntypedConst(Int, "6"),
+		"SW_NORMAL":	MakeUntypedConst(Int, "1"),
+		"SW_RESTORE":	MakeUntypedConst(Int, "9"),
+		"SW_SHOW":	MakeUntypedConst(Int, "5"),
+		"SW_SHOWDEFAULT":	MakeUntypedConst(Int, "10"),
+		"SW_SHOWMAXIMIZED":	MakeUntypedConst(Int, "3"),
+		"SW_SHOWMINIMIZED":	MakeUntypedConst(Int, "2"),
+		"SW_SHOWMINNOACTIVE":	MakeUntypedConst(Int, "7"),
+		"SW_SHOWNA":	MakeUntypedConst(Int, "8"),
+		"SW_SHOWNOACTIVATE":	MakeUntypedConst(Int, "4"),
+		"SW_SHOWNORMAL":	MakeUntypedConst(Int, "1"),
+		"SYMBOLIC_LINK_FLAG_DIRECTORY":	MakeUntypedConst(Int, "1"),
+		"SYNCHRONIZE":	MakeUntypedConst(Int, "1048576"),
+		"S_IFBLK":	MakeUntypedConst(Int, "24576"),
+		"S_IFCHR":	MakeUntypedConst(Int, "8192"),
+		"S_IFDIR":	MakeUntypedConst(Int, "16384"),
+		"S_IFIFO":	MakeUntypedConst(Int, "4096"),
+		"S_IFLNK":	MakeUntypedConst(Int, "40960"),
+		"S_IFMT":	MakeUntypedConst(Int, "126976"),
+		"S_IFREG":	MakeUntypedConst(Int, "32768"),
+		"S_IFSOCK":	MakeUntypedConst(Int, "49152"),
+		"S_IRUSR":	MakeUntypedConst(Int, "256"),
+		"S_ISGID":	MakeUntypedConst(Int, "1024"),
+		"S_ISUID":	MakeUntypedConst(Int, "2048"),
+		"S_ISVTX":	MakeUntypedConst(Int, "512"),
+		"S_IWRITE":	MakeUntypedConst(Int, "128"),
+		"S_IWUSR":	MakeUntypedConst(Int, "128"),
+		"S_IXUSR":	MakeUntypedConst(Int, "64"),
+		"SidTypeAlias":	MakeUntypedConst(Int, "4"),
+		"SidTypeComputer":	MakeUntypedConst(Int, "9"),
+		"SidTypeDeletedAccount":	MakeUntypedConst(Int, "6"),
+		"SidTypeDomain":	MakeUntypedConst(Int, "3"),
+		"SidTypeGroup":	MakeUntypedConst(Int, "2"),
+		"SidTypeInvalid":	MakeUntypedConst(Int, "7"),
+		"SidTypeLabel":	MakeUntypedConst(Int, "10"),
+		"SidTypeUnknown":	MakeUntypedConst(Int, "8"),
+		"SidTypeUser":	MakeUntypedConst(Int, "1"),
+		"SidTypeWellKnownGroup":	MakeUntypedConst(Int, "5"),
+		"TCP_NODELAY":	MakeUntypedConst(Int, "1"),
+		"TF_DISCONNECT":	MakeUntypedConst(Int, "1"),
+		"TF_REUSE_SOCKET":	MakeUntypedConst(Int, "2"),
+		"TF_USE_DEFAULT_WORKER":	MakeUntypedConst(Int, "0"),
+		"TF_USE_KERNEL_APC":	MakeUntypedConst(Int, "32"),
+		"TF_USE_SYSTEM_THREAD":	MakeUntypedConst(Int, "16"),
+		"TF_WRITE_BEHIND":	MakeUntypedConst(Int, "4"),
+		"TH32CS_INHERIT":	MakeUntypedConst(Int, "2147483648"),
+		"TH32CS_SNAPALL":	MakeUntypedConst(Int, "15"),
+		"TH32CS_SNAPHEAPLIST":	MakeUntypedConst(Int, "1"),
+		"TH32CS_SNAPMODULE":	MakeUntypedConst(Int, "8"),
+		"TH32CS_SNAPMODULE32":	MakeUntypedConst(Int, "16"),
+		"TH32CS_SNAPPROCESS":	MakeUntypedConst(Int, "2"),
+		"TH32CS_SNAPTHREAD":	MakeUntypedConst(Int, "4"),
+		"TIME_ZONE_ID_DAYLIGHT":	MakeUntypedConst(Int, "2"),
+		"TIME_ZONE_ID_STANDARD":	MakeUntypedConst(Int, "1"),
+		"TIME_ZONE_ID_UNKNOWN":	MakeUntypedConst(Int, "0"),
+		"TOKEN_ADJUST_DEFAULT":	MakeUntypedConst(Int, "128"),
+		"TOKEN_ADJUST_GROUPS":	MakeUntypedConst(Int, "64"),
+		"TOKEN_ADJUST_PRIVILEGES":	MakeUntypedConst(Int, "32"),
+		"TOKEN_ALL_ACCESS":	MakeUntypedConst(Int, "983551"),
+		"TOKEN_ASSIGN_PRIMARY":	MakeUntypedConst(Int, "1"),
+		"TOKEN_DUPLICATE":	MakeUntypedConst(Int, "2"),
+		"TOKEN_EXECUTE":	MakeUntypedConst(Int, "131072"),
+		"TOKEN_IMPERSONATE":	MakeUntypedConst(Int, "4"),
+		"TOKEN_QUERY":	MakeUntypedConst(Int, "8"),
+		"TOKEN_QUERY_SOURCE":	MakeUntypedConst(Int, "16"),
+		"TOKEN_READ":	MakeUntypedConst(Int, "131080"),
+		"TOKEN_WRITE":	MakeUntypedConst(Int, "131296"),
+		"TRUNCATE_EXISTING":	MakeUntypedConst(Int, "5"),
+		"TokenAccessInformation":	MakeUntypedConst(Int, "22"),
+		"TokenAuditPolicy":	MakeUntypedConst(Int, "16"),
+		"TokenDefaultDacl":	MakeUntypedConst(Int, "6"),
+		"TokenElevation":	MakeUntypedConst(Int, "20"),
+		"TokenElevationType":	MakeUntypedConst(Int, "18"),
+		"TokenGroups":	MakeUntypedConst(Int, "2"),
+		"TokenGroupsAndPrivileges":	MakeUntypedConst(Int, "13"),
+		"TokenHasRestrictions":	MakeUntypedConst(Int, "21"),
+		"TokenImpersonationLevel":	MakeUntypedConst(Int, "9"),
+		"TokenIntegrityLevel":	MakeUntypedConst(Int, "25"),
+		"TokenLinkedToken":	MakeUntypedConst(Int, "19"),
+		"TokenLogonSid":	MakeUntypedConst(Int, "28"),
+		"TokenMandatoryPolicy":	MakeUntypedConst(Int, "27"),
+		"TokenOrigin":	MakeUntypedConst(Int, "17"),
+		"TokenOwner":	MakeUntypedConst(Int, "4"),
+		"TokenPrimaryGroup":	MakeUntypedConst(Int, "5"),
+		"TokenPrivileges":	MakeUntypedConst(Int, "3"),
+		"TokenRestrictedSids":	MakeUntypedConst(Int, "11"),
+		"TokenSandBoxInert":	MakeUntypedConst(Int, "15"),
+		"TokenSessionId":	MakeUntypedConst(Int, "12"),
+		"TokenSessionReference":	MakeUntypedConst(Int, "14"),
+		"TokenSource":	MakeUntypedConst(Int, "7"),
+		"TokenStatistics":	MakeUntypedConst(Int, "10"),
+		"TokenType":	MakeUntypedConst(Int, "8"),
+		"TokenUIAccess":	MakeUntypedConst(Int, "26"),
+		"TokenUser":	MakeUntypedConst(Int, "1"),
+		"TokenVirtualizationAllowed":	MakeUntypedConst(Int, "23"),
+		"TokenVirtualizationEnabled":	MakeUntypedConst(Int, "24"),
+		"USAGE_MATCH_TYPE_AND":	MakeUntypedConst(Int, "0"),
+		"USAGE_MATCH_TYPE_OR":	MakeUntypedConst(Int, "1"),
+		"WAIT_ABANDONED":	MakeUntypedConst(Int, "128"),
+		"WAIT_FAILED":	MakeUntypedConst(Int, "4294967295"),
+		"WAIT_OBJECT_0":	MakeUntypedConst(Int, "0"),
+		"WAIT_TIMEOUT":	MakeUntypedConst(Int, "258"),
+		"WSADESCRIPTION_LEN":	MakeUntypedConst(Int, "256"),
+		"WSAPROTOCOL_LEN":	MakeUntypedConst(Int, "255"),
+		"WSASYS_STATUS_LEN":	MakeUntypedConst(Int, "128"),
+		"X509_ASN_ENCODING":	MakeUntypedConst(Int, "1"),
+		"XP1_CONNECTIONLESS":	MakeUntypedConst(Int, "1"),
+		"XP1_CONNECT_DATA":	MakeUntypedConst(Int, "128"),
+		"XP1_DISCONNECT_DATA":	MakeUntypedConst(Int, "256"),
+		"XP1_EXPEDITED_DATA":	MakeUntypedConst(Int, "64"),
+		"XP1_GRACEFUL_CLOSE":	MakeUntypedConst(Int, "32"),
+		"XP1_GUARANTEED_DELIVERY":	MakeUntypedConst(Int, "2"),
+		"XP1_GUARANTEED_ORDER":	MakeUntypedConst(Int, "4"),
+		"XP1_IFS_HANDLES":	MakeUntypedConst(Int, "131072"),
+		"XP1_MESSAGE_ORIENTED":	MakeUntypedConst(Int, "8"),
+		"XP1_MULTIPOINT_CONTROL_PLANE":	MakeUntypedConst(Int, "2048"),
+		"XP1_MULTIPOINT_DATA_PLANE":	MakeUntypedConst(Int, "4096"),
+		"XP1_PARTIAL_MESSAGE":	MakeUntypedConst(Int, "262144"),
+		"XP1_PSEUDO_STREAM":	MakeUntypedConst(Int, "16"),
+		"XP1_QOS_SUPPORTED":	MakeUntypedConst(Int, "8192"),
+		"XP1_SAN_SUPPORT_SDP":	MakeUntypedConst(Int, "524288"),
+		"XP1_SUPPORT_BROADCAST":	MakeUntypedConst(Int, "512"),
+		"XP1_SUPPORT_MULTIPOINT":	MakeUntypedConst(Int, "1024"),
+		"XP1_UNI_RECV":	MakeUntypedConst(Int, "65536"),
+		"XP1_UNI_SEND":	MakeUntypedConst(Int, "32768"),
+	} } })
 }
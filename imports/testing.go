@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "testing"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_testing
+// +build !gomacro_slim gomacro_testing
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["testing"] = Package{
+	Register("testing", func() Package { return Package{
 	Binds: map[string]Value{
 		"AllocsPerRun":	ValueOf(testing.AllocsPerRun),
 		"Benchmark":	ValueOf(testing.Benchmark),
@@ -38,5 +41,5 @@ func init() {
 		"TB":	TypeOf((*testing.TB)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "testing/iotest"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_testing_iotest
+// +build !gomacro_slim gomacro_testing_iotest
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["testing/iotest"] = Package{
+	Register("testing/iotest", func() Package { return Package{
 	Binds: map[string]Value{
 		"DataErrReader":	ValueOf(iotest.DataErrReader),
 		"ErrTimeout":	ValueOf(&iotest.ErrTimeout).Elem(),
@@ -23,5 +26,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "testing/quick"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_testing_quick
+// +build !gomacro_slim gomacro_testing_quick
+
 package imports
 
 import (
@@ -11,7 +14,7 @@ import (
 )
 
 func init() {
-	Packages["testing/quick"] = Package{
+	Register("testing/quick", func() Package { return Package{
 	Binds: map[string]Value{
 		"Check":	ValueOf(quick.Check),
 		"CheckEqual":	ValueOf(quick.CheckEqual),
@@ -26,7 +29,7 @@ func init() {
 	},
 	Proxies: map[string]Type{
 		"Generator":	TypeOf((*Generator_testing_quick)(nil)).Elem(),
-	} }
+	} } })
 }
 
 // --------------- proxy for testing/quick.Generator ---------------
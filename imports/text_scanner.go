@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "text/scanner"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_text_scanner
+// +build !gomacro_slim gomacro_text_scanner
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["text/scanner"] = Package{
+	Register("text/scanner", func() Package { return Package{
 	Binds: map[string]Value{
 		"Char":	ValueOf(scanner.Char),
 		"Comment":	ValueOf(scanner.Comment),
@@ -36,5 +39,25 @@ func init() {
 		"Scanner":	TypeOf((*scanner.Scanner)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Char":	MakeUntypedConst(Int, "-5"),
+		"Comment":	MakeUntypedConst(Int, "-8"),
+		"EOF":	MakeUntypedConst(Int, "-1"),
+		"Float":	MakeUntypedConst(Int, "-4"),
+		"GoTokens":	MakeUntypedConst(Int, "1012"),
+		"GoWhitespace":	MakeUntypedConst(Int, "4294977024"),
+		"Ident":	MakeUntypedConst(Int, "-2"),
+		"Int":	MakeUntypedConst(Int, "-3"),
+		"RawString":	MakeUntypedConst(Int, "-7"),
+		"ScanChars":	MakeUntypedConst(Int, "32"),
+		"ScanComments":	MakeUntypedConst(Int, "256"),
+		"ScanFloats":	MakeUntypedConst(Int, "16"),
+		"ScanIdents":	MakeUntypedConst(Int, "4"),
+		"ScanInts":	MakeUntypedConst(Int, "8"),
+		"ScanRawStrings":	MakeUntypedConst(Int, "128"),
+		"ScanStrings":	MakeUntypedConst(Int, "64"),
+		"SkipComments":	MakeUntypedConst(Int, "512"),
+		"String":	MakeUntypedConst(Int, "-6"),
+	} } })
 }
@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "text/tabwriter"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_text_tabwriter
+// +build !gomacro_slim gomacro_text_tabwriter
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["text/tabwriter"] = Package{
+	Register("text/tabwriter", func() Package { return Package{
 	Binds: map[string]Value{
 		"AlignRight":	ValueOf(tabwriter.AlignRight),
 		"Debug":	ValueOf(tabwriter.Debug),
@@ -24,5 +27,8 @@ func init() {
 		"Writer":	TypeOf((*tabwriter.Writer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"Escape":	MakeUntypedConst(Int32, "255"),
+	} } })
 }
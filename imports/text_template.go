@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "text/template"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_text_template
+// +build !gomacro_slim gomacro_text_template
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["text/template"] = Package{
+	Register("text/template", func() Package { return Package{
 	Binds: map[string]Value{
 		"HTMLEscape":	ValueOf(template.HTMLEscape),
 		"HTMLEscapeString":	ValueOf(template.HTMLEscapeString),
@@ -30,5 +33,5 @@ func init() {
 		"Template":	TypeOf((*template.Template)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
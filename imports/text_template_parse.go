@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "text/template/parse"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_text_template_parse
+// +build !gomacro_slim gomacro_text_template_parse
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["text/template/parse"] = Package{
+	Register("text/template/parse", func() Package { return Package{
 	Binds: map[string]Value{
 		"IsEmptyTree":	ValueOf(parse.IsEmptyTree),
 		"New":	ValueOf(parse.New),
@@ -60,5 +63,5 @@ func init() {
 		"WithNode":	TypeOf((*parse.WithNode)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
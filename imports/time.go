@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "time"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_time
+// +build !gomacro_slim gomacro_time
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["time"] = Package{
+	Register("time", func() Package { return Package{
 	Binds: map[string]Value{
 		"ANSIC":	ValueOf(time.ANSIC),
 		"After":	ValueOf(time.After),
@@ -81,5 +84,22 @@ func init() {
 		"Weekday":	TypeOf((*time.Weekday)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"ANSIC":	MakeUntypedConst(String, "\"Mon Jan _2 15:04:05 2006\""),
+		"Kitchen":	MakeUntypedConst(String, "\"3:04PM\""),
+		"RFC1123":	MakeUntypedConst(String, "\"Mon, 02 Jan 2006 15:04:05 MST\""),
+		"RFC1123Z":	MakeUntypedConst(String, "\"Mon, 02 Jan 2006 15:04:05 -0700\""),
+		"RFC3339":	MakeUntypedConst(String, "\"2006-01-02T15:04:05Z07:00\""),
+		"RFC3339Nano":	MakeUntypedConst(String, "\"2006-01-02T15:04:05.999999999Z07:00\""),
+		"RFC822":	MakeUntypedConst(String, "\"02 Jan 06 15:04 MST\""),
+		"RFC822Z":	MakeUntypedConst(String, "\"02 Jan 06 15:04 -0700\""),
+		"RFC850":	MakeUntypedConst(String, "\"Monday, 02-Jan-06 15:04:05 MST\""),
+		"RubyDate":	MakeUntypedConst(String, "\"Mon Jan 02 15:04:05 -0700 2006\""),
+		"Stamp":	MakeUntypedConst(String, "\"Jan _2 15:04:05\""),
+		"StampMicro":	MakeUntypedConst(String, "\"Jan _2 15:04:05.000000\""),
+		"StampMilli":	MakeUntypedConst(String, "\"Jan _2 15:04:05.000\""),
+		"StampNano":	MakeUntypedConst(String, "\"Jan _2 15:04:05.000000000\""),
+		"UnixDate":	MakeUntypedConst(String, "\"Mon Jan _2 15:04:05 MST 2006\""),
+	} } })
 }
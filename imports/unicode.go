@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "unicode"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_unicode
+// +build !gomacro_slim gomacro_unicode
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["unicode"] = Package{
+	Register("unicode", func() Package { return Package{
 	Binds: map[string]Value{
 		"ASCII_Hex_Digit":	ValueOf(&unicode.ASCII_Hex_Digit).Elem(),
 		"Adlam":	ValueOf(&unicode.Adlam).Elem(),
@@ -279,5 +282,17 @@ func init() {
 		"SpecialCase":	TypeOf((*unicode.SpecialCase)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"LowerCase":	MakeUntypedConst(Int, "1"),
+		"MaxASCII":	MakeUntypedConst(Int32, "127"),
+		"MaxCase":	MakeUntypedConst(Int, "3"),
+		"MaxLatin1":	MakeUntypedConst(Int32, "255"),
+		"MaxRune":	MakeUntypedConst(Int32, "1114111"),
+		"ReplacementChar":	MakeUntypedConst(Int32, "65533"),
+		"TitleCase":	MakeUntypedConst(Int, "2"),
+		"UpperCase":	MakeUntypedConst(Int, "0"),
+		"UpperLower":	MakeUntypedConst(Int32, "1114112"),
+		"Version":	MakeUntypedConst(String, "\"17.0.0\""),
+	} } })
 }
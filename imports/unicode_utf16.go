@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "unicode/utf16"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_unicode_utf16
+// +build !gomacro_slim gomacro_unicode_utf16
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["unicode/utf16"] = Package{
+	Register("unicode/utf16", func() Package { return Package{
 	Binds: map[string]Value{
 		"Decode":	ValueOf(utf16.Decode),
 		"DecodeRune":	ValueOf(utf16.DecodeRune),
@@ -20,5 +23,5 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
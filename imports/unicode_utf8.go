@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "unicode/utf8"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_unicode_utf8
+// +build !gomacro_slim gomacro_unicode_utf8
+
 package imports
 
 import (
@@ -9,7 +12,7 @@ import (
 )
 
 func init() {
-	Packages["unicode/utf8"] = Package{
+	Register("unicode/utf8", func() Package { return Package{
 	Binds: map[string]Value{
 		"DecodeLastRune":	ValueOf(utf8.DecodeLastRune),
 		"DecodeLastRuneInString":	ValueOf(utf8.DecodeLastRuneInString),
@@ -33,5 +36,11 @@ func init() {
 	Types: map[string]Type{
 	},
 	Proxies: map[string]Type{
-	} }
+	},
+	Untypeds: map[string]UntypedConst{
+		"MaxRune":	MakeUntypedConst(Int32, "1114111"),
+		"RuneError":	MakeUntypedConst(Int32, "65533"),
+		"RuneSelf":	MakeUntypedConst(Int, "128"),
+		"UTFMax":	MakeUntypedConst(Int, "4"),
+	} } })
 }
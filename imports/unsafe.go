@@ -1,6 +1,9 @@
 // this file was generated by gomacro command: import "unsafe"
 // DO NOT EDIT! Any change will be lost when the file is re-generated
 
+//go:build !gomacro_slim || gomacro_unsafe
+// +build !gomacro_slim gomacro_unsafe
+
 package imports
 
 import (
@@ -9,12 +12,12 @@ import (
 )
 
 func init() {
-	Packages["unsafe"] = Package{
+	Register("unsafe", func() Package { return Package{
 	Binds: map[string]Value{
 	},
 	Types: map[string]Type{
 		"Pointer":	TypeOf((*unsafe.Pointer)(nil)).Elem(),
 	},
 	Proxies: map[string]Type{
-	} }
+	} } })
 }
@@ -0,0 +1,89 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software: you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * untyped.go
+ *
+ *  Created on: Apr 08, 2017
+ *      Author: Massimiliano Ghilardi
+ */
+
+package imports
+
+import (
+	"go/constant"
+	"go/token"
+	. "reflect"
+	"strconv"
+	"strings"
+)
+
+// UntypedConst is an untyped constant, as math.Pi or math.MaxUint64:
+// its exact value and its default kind, i.e. the kind it gets when used without a type.
+// Kind is one of Bool, Int, Int32 (for runes), Float64, Complex128 or String
+type UntypedConst struct {
+	Kind  Kind
+	Value constant.Value
+}
+
+// MakeUntypedConst creates an UntypedConst from its default kind and its exact value,
+// formatted as constant.Value.ExactString() does
+func MakeUntypedConst(kind Kind, exact string) UntypedConst {
+	var val constant.Value
+	switch kind {
+	case Bool:
+		val = constant.MakeBool(exact == "true")
+	case String:
+		str, err := strconv.Unquote(exact)
+		if err != nil {
+			panic(err)
+		}
+		val = constant.MakeString(str)
+	case Complex128:
+		// "(re + imi)"
+		exact = strings.TrimSuffix(strings.TrimPrefix(exact, "("), ")")
+		i := strings.Index(exact, " + ")
+		if i < 0 {
+			panic("invalid complex constant: " + exact)
+		}
+		re := makeExactNumber(exact[:i])
+		im := makeExactNumber(strings.TrimSuffix(exact[i+3:], "i"))
+		val = constant.BinaryOp(re, token.ADD, constant.MakeImag(im))
+	default:
+		val = makeExactNumber(exact)
+	}
+	return UntypedConst{Kind: kind, Value: val}
+}
+
+// makeExactNumber parses an integer, a decimal or a fraction "num/denom"
+func makeExactNumber(exact string) constant.Value {
+	if strings.HasPrefix(exact, "-") {
+		return constant.UnaryOp(token.SUB, makeExactNumber(exact[1:]), 0)
+	}
+	if i := strings.IndexByte(exact, '/'); i >= 0 {
+		return constant.BinaryOp(makeExactNumber(exact[:i]), token.QUO, makeExactNumber(exact[i+1:]))
+	}
+	tok := token.INT
+	if strings.ContainsAny(exact, ".eE") {
+		tok = token.FLOAT
+	}
+	val := constant.MakeFromLiteral(exact, tok, 0)
+	if val.Kind() == constant.Unknown {
+		panic("invalid numeric constant: " + exact)
+	}
+	return val
+}
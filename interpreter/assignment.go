@@ -64,11 +64,34 @@ func (env *Env) evalAssignments(node *ast.AssignStmt) (r.Value, []r.Value) {
 
 	} else {
 		places := env.evalPlaces(left)
-		values := env.evalExprsMultipleValues(right, nleft)
+		var values []r.Value
+		if nleft == nright {
+			values = make([]r.Value, nleft)
+			for i, place := range places {
+				// untyped constants are converted exactly to the type of the place
+				values[i] = env.evalExprTo(right[i], place.Type())
+			}
+		} else {
+			values = env.evalExprsMultipleValues(right, nleft)
+		}
+		if nleft > 1 {
+			// a, b = b, a must not see the updated a while assigning b
+			for i := range values {
+				values[i] = copyValue(values[i])
+			}
+		}
 		return env.assignPlaces(places, op, values)
 	}
 }
 
+// Type returns the type of values that can be stored in the place
+func (place placeType) Type() r.Type {
+	if place.mapkey == Nil {
+		return typeOf(place.obj)
+	}
+	return place.obj.Type().Elem()
+}
+
 func (env *Env) evalPlaces(node []ast.Expr) []placeType {
 	n := len(node)
 	places := make([]placeType, n)
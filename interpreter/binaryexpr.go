@@ -83,9 +83,39 @@ func (env *Env) evalBinaryExpr(xv r.Value, op token.Token, yv r.Value) r.Value {
 	case r.String:
 		return env.evalBinaryExprString(xv, op, yv)
 	}
+	if op == token.EQL || op == token.NEQ {
+		return env.evalBinaryExprEqual(xv, op, yv)
+	}
 	return env.unsupportedBinaryExpr(xv, op, yv)
 }
 
+// evalBinaryExprEqual compares pointers, interfaces, channels, structs and arrays,
+// and compares maps, slices and functions with nil
+func (env *Env) evalBinaryExprEqual(xv r.Value, op token.Token, yv r.Value) r.Value {
+	var eq bool
+	xnil, ynil := isNilValue(xv), isNilValue(yv)
+	if xnil || ynil {
+		eq = xnil && ynil
+	} else if xv.Type().Comparable() && yv.Type().Comparable() && xv.CanInterface() && yv.CanInterface() {
+		eq = xv.Interface() == yv.Interface()
+	} else {
+		return env.unsupportedBinaryExpr(xv, op, yv)
+	}
+	return r.ValueOf(eq == (op == token.EQL))
+}
+
+// isNilValue returns true if v is nil or a nil pointer, interface, channel, map, slice or function
+func isNilValue(v r.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case r.Chan, r.Func, r.Interface, r.Map, r.Ptr, r.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
 func (env *Env) evalBinaryExprBoolBool(xv r.Value, op token.Token, yv r.Value) r.Value {
 	x := xv.Bool()
 	y := yv.Bool()
@@ -298,8 +328,29 @@ func (env *Env) evalBinaryExprComplex(xv r.Value, op token.Token, yv r.Value) r.
 }
 
 func (env *Env) evalBinaryExprString(xv r.Value, op token.Token, yv r.Value) r.Value {
-	if xv.Kind() == r.String && yv.Kind() == r.String && op == token.ADD {
-		return r.ValueOf(xv.String() + yv.String())
+	if xv.Kind() != r.String || yv.Kind() != r.String {
+		return env.unsupportedBinaryExpr(xv, op, yv)
 	}
-	return env.unsupportedBinaryExpr(xv, op, yv)
+	x := xv.String()
+	y := yv.String()
+	var b bool
+	switch op {
+	case token.ADD, token.ADD_ASSIGN:
+		return r.ValueOf(x + y)
+	case token.EQL:
+		b = x == y
+	case token.LSS:
+		b = x < y
+	case token.GTR:
+		b = x > y
+	case token.NEQ:
+		b = x != y
+	case token.LEQ:
+		b = x <= y
+	case token.GEQ:
+		b = x >= y
+	default:
+		return env.unsupportedBinaryExpr(xv, op, yv)
+	}
+	return r.ValueOf(b)
 }
@@ -27,6 +27,7 @@ package interpreter
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"io/ioutil"
 	r "reflect"
 
@@ -45,6 +46,23 @@ func callCap(arg interface{}) int {
 	return r.ValueOf(arg).Cap()
 }
 
+func callClear(arg interface{}) {
+	v := r.ValueOf(arg)
+	switch v.Kind() {
+	case r.Map:
+		for _, key := range v.MapKeys() {
+			v.SetMapIndex(key, Nil)
+		}
+	case r.Slice:
+		zero := r.Zero(v.Type().Elem())
+		for i, n := 0, v.Len(); i < n; i++ {
+			v.Index(i).Set(zero)
+		}
+	default:
+		errorf("builtin clear(): expecting map or slice, found: %v <%v>", arg, r.TypeOf(arg))
+	}
+}
+
 func callClose(channel interface{}) {
 	r.ValueOf(channel).Close()
 }
@@ -189,6 +207,48 @@ func builtinNew(env *Env, args []ast.Expr) (r.Value, []r.Value) {
 	return r.New(t), nil
 }
 
+func funcMax(env *Env, args []r.Value) (r.Value, []r.Value) {
+	return env.minOrMax("max", token.GTR, args)
+}
+
+func funcMin(env *Env, args []r.Value) (r.Value, []r.Value) {
+	return env.minOrMax("min", token.LSS, args)
+}
+
+// minOrMax implements the builtins min() and max():
+// it returns the argument that wins the comparison op against all the others
+func (env *Env) minOrMax(name string, op token.Token, args []r.Value) (r.Value, []r.Value) {
+	n := len(args)
+	if n < 1 {
+		return env.errorf("builtin %s() expects at least one argument, found %d", name, n)
+	}
+	var tfloat r.Type
+	ret := args[0]
+	for i, arg := range args {
+		switch arg.Kind() {
+		case r.Int, r.Int8, r.Int16, r.Int32, r.Int64,
+			r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr, r.String:
+		case r.Float32, r.Float64:
+			if tfloat == nil {
+				tfloat = arg.Type()
+			}
+			if f := arg.Float(); f != f {
+				// Go specs: "if any argument is a NaN, the result is a NaN"
+				return arg, nil
+			}
+		default:
+			return env.errorf("builtin %s(): invalid argument, expecting an ordered type: %v <%v>", name, arg, typeOf(arg))
+		}
+		if i != 0 && env.evalBinaryExpr(arg, op, ret).Bool() {
+			ret = arg
+		}
+	}
+	if tfloat != nil && ret.Type() != tfloat {
+		ret = ret.Convert(tfloat)
+	}
+	return ret, nil
+}
+
 func funcParse(env *Env, args []r.Value) (r.Value, []r.Value) {
 	var in interface{}
 	if arg := args[0]; arg != Nil && arg != None {
@@ -281,6 +341,7 @@ func funcRecover(env *Env, args []r.Value) (r.Value, []r.Value) {
 			ret = r.ValueOf(caller.panick)
 			caller.panick = nil
 			caller.panicking = false
+			env.forgetPanic()
 		} else if trace {
 			env.debugf("           no panic to consume: caller.runningDefers = %q, caller.panicking = %q",
 				caller.runningDefers, caller.panicking)
@@ -311,6 +372,8 @@ func (env *Env) addBuiltins() {
 
 	binds["Env"] = r.ValueOf(Function{funcEnv, 0})
 	binds["Eval"] = r.ValueOf(Function{funcEval, 1})
+	binds["Gensym"] = r.ValueOf(Function{funcGensym, 1})
+	binds["Match"] = r.ValueOf(Builtin{builtinMatch, 2})
 	binds["MacroExpand"] = r.ValueOf(Function{funcMacroExpand, -1})
 	binds["MacroExpand1"] = r.ValueOf(Function{funcMacroExpand1, -1})
 	binds["MacroExpandCodewalk"] = r.ValueOf(Function{funcMacroExpandCodewalk, -1})
@@ -328,6 +391,7 @@ func (env *Env) addBuiltins() {
 
 	binds["append"] = r.ValueOf(Function{funcAppend, -1})
 	binds["cap"] = r.ValueOf(callCap)
+	binds["clear"] = r.ValueOf(callClear)
 	binds["close"] = r.ValueOf(callClose)
 	binds["complex"] = r.ValueOf(Function{funcComplex, 2})
 	binds["copy"] = r.ValueOf(callCopy)
@@ -336,6 +400,8 @@ func (env *Env) addBuiltins() {
 	binds["imag"] = r.ValueOf(Function{funcImag, 1})
 	binds["len"] = r.ValueOf(callLen)
 	binds["make"] = r.ValueOf(Builtin{builtinMake, -1})
+	binds["max"] = r.ValueOf(Function{funcMax, -1})
+	binds["min"] = r.ValueOf(Function{funcMin, -1})
 	binds["new"] = r.ValueOf(Builtin{builtinNew, 1})
 	binds["nil"] = Nil
 	binds["panic"] = r.ValueOf(callPanic)
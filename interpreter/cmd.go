@@ -29,9 +29,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/build"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	mp "github.com/cosmos72/gomacro/parser"
@@ -40,14 +43,17 @@ import (
 type Cmd struct {
 	*Env
 	WriteDeclsAndStmtsToFile, OverwriteFiles bool
+	// ExpandOnly macroexpands files without executing them, see ExpandFile()
+	ExpandOnly bool
 }
 
 func (cmd *Cmd) Init() {
 	cmd.Env = New()
-	cmd.ParserMode = mp.Trace & 0
+	cmd.ParserMode = mp.ParseComments                        // | mp.Trace
 	cmd.Options = OptTrapPanic | OptShowPrompt | OptShowEval // | OptShowAfterMacroExpansion // | OptDebugMacroExpand // |  OptDebugQuasiquote  // | OptShowEvalDuration // | OptShowAfterParse
 	cmd.WriteDeclsAndStmtsToFile = false
 	cmd.OverwriteFiles = false
+	cmd.ExpandOnly = false
 }
 
 func (cmd *Cmd) Main(args []string) (err error) {
@@ -55,11 +61,15 @@ func (cmd *Cmd) Main(args []string) (err error) {
 		cmd.Init()
 	}
 	env := cmd.Env
+	if len(args) != 0 && args[0] == "gen-imports" {
+		return cmd.GenImports(args[1:])
+	}
 
 	var set, clear Options
 	repl := len(args) == 0
 	cmd.WriteDeclsAndStmtsToFile = false
 	cmd.OverwriteFiles = false
+	cmd.ExpandOnly = false
 
 	for len(args) > 0 {
 		switch args[0] {
@@ -75,6 +85,8 @@ func (cmd *Cmd) Main(args []string) (err error) {
 				}
 				args = args[1:]
 			}
+		case "-expand":
+			cmd.ExpandOnly = true
 		case "-f":
 			cmd.OverwriteFiles = true
 		case "-h":
@@ -116,14 +128,34 @@ func (cmd *Cmd) Main(args []string) (err error) {
 		case "-w":
 			cmd.WriteDeclsAndStmtsToFile = true
 		default:
-			if cmd.WriteDeclsAndStmtsToFile {
-				env.Options |= OptCollectDeclarations | OptCollectStatements
-			}
 			env.Options &^= OptShowPrompt | OptShowEval
 			env.Options = (env.Options | set) &^ clear
-			cmd.EvalFileOrDir(args[0])
+			if cmd.ExpandOnly {
+				err := cmd.ExpandFileOrDir(args[0])
+				if err != nil {
+					return err
+				}
+				env.Imports, env.Declarations, env.Statements, env.Macros = nil, nil, nil, nil
+				args = args[1:]
+				continue
+			}
+			if !cmd.WriteDeclsAndStmtsToFile {
+				// program mode: the first file or directory is the program,
+				// all the following arguments are passed to it
+				err := cmd.EvalProgram(args[0], args[1:]...)
+				if err != nil {
+					return err
+				}
+				args = nil
+				continue
+			}
+			env.Options |= OptCollectDeclarations | OptCollectStatements
+			err := cmd.EvalFileOrDir(args[0])
+			if err != nil {
+				return err
+			}
 
-			env.Imports, env.Declarations, env.Statements = nil, nil, nil
+			env.Imports, env.Declarations, env.Statements, env.Macros = nil, nil, nil, nil
 		}
 		args = args[1:]
 	}
@@ -135,18 +167,81 @@ func (cmd *Cmd) Main(args []string) (err error) {
 	return nil
 }
 
+// EvalProgram evaluates the file or directory fileOrDir as a program:
+// os.Args is set to fileOrDir followed by args, so the program can use the "flag" package.
+// Go main packages have their main() invoked automatically, see EvalPackageFiles().
+// Panics are not trapped: they stop the program and are returned as error
+func (cmd *Cmd) EvalProgram(fileOrDir string, args ...string) error {
+	env := cmd.Env
+	os.Args = append([]string{fileOrDir}, args...)
+
+	trap := env.Options & OptTrapPanic
+	env.Options &^= OptTrapPanic
+	defer func() {
+		env.Options |= trap
+	}()
+	return cmd.EvalFileOrDir(fileOrDir)
+}
+
+// GenImports implements "gomacro gen-imports [-o DIR] [-pkg NAME] PKG...":
+// for each PKG, it generates in DIR a Go source file that registers PKG bindings,
+// see GenImportFile(). DIR defaults to the current directory,
+// NAME defaults to the name of DIR
+func (cmd *Cmd) GenImports(args []string) error {
+	dir, pkgName := ".", ""
+	for len(args) > 1 && (args[0] == "-o" || args[0] == "-pkg") {
+		if args[0] == "-o" {
+			dir = args[1]
+		} else {
+			pkgName = args[1]
+		}
+		args = args[2:]
+	}
+	if len(args) == 0 || startsWith(args[0], "-") {
+		return cmd.Usage()
+	}
+	if len(pkgName) == 0 {
+		absdir, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		pkgName = sanitizeIdentifier(filepath.Base(absdir))
+	}
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	for _, path := range args {
+		filename, err := cmd.GenImportFile(dir, pkgName, path)
+		if err != nil {
+			return err
+		}
+		if len(filename) != 0 {
+			fmt.Fprintf(cmd.Stdout, "// generated %q\n", filename)
+		}
+	}
+	return nil
+}
+
 func (cmd *Cmd) Usage() error {
-	fmt.Print(`usage: gomacro [OPTIONS] [files-and-dirs]
+	fmt.Print(`usage: gomacro [OPTIONS] [PROGRAM [ARGS...]]
+       gomacro -w [OPTIONS] [files-and-dirs]
+       gomacro -expand [-w] [OPTIONS] [files-and-dirs]
+       gomacro gen-imports [-o DIR] [-pkg NAME] PKG...
 
        Recognized options:
        -e EXPR evaluate expression
+       -expand macroexpand files and dirs without executing them, and print the resulting
+               Go source. Only imports, macros, functions, types, constants and interpret_only
+               blocks are evaluated.
+               With -w, write the result to *.go files instead
        -f      force option -w to overwrite existing files
        -h      show this help and exit
        -i      interactive. start a REPL after evaluating expression, files and dirs.
        -o LIST set/unset options, see below.
        -s      silent. do NOT show startup message, prompt, and expressions result
        -v      verbose. show startup message, prompt, and expressions result
-       -w      write collected declarations and statements to *.go files
+       -w      write collected declarations and statements to *.go files,
+               except for interpret_only blocks
 
         LIST is a comma-separated list of one or more:
          decl      collect declarations
@@ -157,6 +252,16 @@ func (cmd *Cmd) Usage() error {
          ^verbose  same as -s
        collected declarations and statements can be written to standard output
        or to a file with the REPL command :write
+
+       PROGRAM is a file or directory to execute. The arguments following it
+       are passed to the program in os.Args[1:]. If PROGRAM is a Go main package,
+       its main() is invoked automatically.
+       An uncaught panic terminates gomacro with a non-zero exit status.
+
+       gen-imports generates in DIR (default: current directory) one file per PKG,
+       belonging to package NAME (default: the name of DIR), that registers
+       the bindings of PKG for the current GOOS and GOARCH. Compile them into
+       a custom gomacro to import PKG without plugins.
 `)
 	return nil
 }
@@ -183,68 +288,196 @@ func (cmd *Cmd) EvalFileOrDir(fileOrDir string) error {
 	}
 }
 
+// EvalDir evaluates the *.gomacro files in a directory, one at a time in alphabetical order.
+// If the directory contains no *.gomacro files, its *.go files are evaluated
+// together as a single Go package, see EvalPackageFiles()
 func (cmd *Cmd) EvalDir(dirname string) error {
-	files, err := ioutil.ReadDir(dirname)
+	gomacrofiles, gofiles, err := listDirSources(dirname)
 	if err != nil {
 		return err
 	}
+	for _, filename := range gomacrofiles {
+		err := cmd.EvalFile(filename)
+		if err != nil {
+			return err
+		}
+	}
+	if len(gomacrofiles) == 0 && len(gofiles) != 0 {
+		return cmd.EvalPackageFiles(gofiles...)
+	}
+	return nil
+}
+
+// listDirSources returns the *.gomacro files in a directory, in alphabetical order,
+// and its *.go files that match build constraints, excluding tests.
+// Macro libraries *_macros.gomacro are excluded: they are only loaded by import
+func listDirSources(dirname string) (gomacrofiles []string, gofiles []string, err error) {
+	files, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		return nil, nil, err
+	}
 	for _, file := range files {
 		filename := file.Name()
-		if endsWith(filename, ".gomacro") {
-			err := cmd.EvalFile(filename)
-			if err != nil {
-				return err
+		if isMacroLibraryFile(filename) {
+			continue
+		} else if endsWith(filename, ".gomacro") {
+			gomacrofiles = append(gomacrofiles, filepath.Join(dirname, filename))
+		} else if endsWith(filename, ".go") && !endsWith(filename, "_test.go") {
+			// honor build constraints, as the Go compiler does
+			if match, _ := build.Default.MatchFile(dirname, filename); match {
+				gofiles = append(gofiles, filepath.Join(dirname, filename))
 			}
 		}
 	}
-	return nil
+	return gomacrofiles, gofiles, nil
 }
 
+// EvalFile evaluates a file. Go source files starting with a package clause
+// are evaluated as a whole, see EvalPackageFiles(). Other files are evaluated
+// top to bottom, as if typed at the REPL
 func (cmd *Cmd) EvalFile(filename string) (err error) {
 	env := cmd.Env
 	env.Declarations = nil
 	env.Statements = nil
+	env.Macros = nil
 
-	f, err := os.Open(filename)
+	src, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		f.Close()
-	}()
-	err = cmd.EvalReader(f)
+	if endsWith(filename, ".go") && hasPackageClause(src) {
+		err = cmd.EvalPackageFiles(filename)
+	} else {
+		// record the file name in source positions,
+		// needed to resolve relative imports
+		saveFilename, saveSource := env.Filename, env.source
+		env.Filename, env.source = filename, sourcePos{}
+		err = cmd.EvalReader(bytes.NewReader(src))
+		env.Filename, env.source = saveFilename, saveSource
+	}
 	if err != nil {
 		return err
 	}
 
 	if cmd.WriteDeclsAndStmtsToFile {
-		outname := filename
-		if dot := strings.LastIndexByte(outname, '.'); dot >= 0 {
-			outname = outname[0:dot]
+		cmd.writeCollected(filename)
+	}
+	return nil
+}
+
+// ExpandFileOrDir macroexpands a file or the source files in a directory, see ExpandFile()
+func (cmd *Cmd) ExpandFileOrDir(fileOrDir string) error {
+	info, err := os.Stat(fileOrDir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return cmd.ExpandFile(fileOrDir)
+	}
+	gomacrofiles, gofiles, err := listDirSources(fileOrDir)
+	if err != nil {
+		return err
+	}
+	if len(gomacrofiles) == 0 {
+		gomacrofiles = gofiles
+	}
+	for _, filename := range gomacrofiles {
+		err := cmd.ExpandFile(filename)
+		if err != nil {
+			return err
 		}
-		outname += ".go"
-		if cmd.OverwriteFiles {
-			os.Remove(outname)
-		} else {
-			_, err := os.Stat(outname)
-			if err == nil {
-				env.warnf("file exists already, use -f to force overwriting: %v", outname)
-				return nil
+	}
+	return nil
+}
+
+// ExpandFile macroexpands a file without executing its statements, so it is safe
+// to use on files with side effects, for example from go generate.
+// The expanded source is written to standard output or, with -w, to the corresponding *.go file
+func (cmd *Cmd) ExpandFile(filename string) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			switch rec := rec.(type) {
+			case error:
+				err = rec
+			default:
+				err = errors.New(fmt.Sprint(rec))
 			}
 		}
-		env.writeDeclsToFile(outname)
+	}()
+	env := cmd.Env
+	env.Imports, env.Declarations, env.Statements, env.Macros = nil, nil, nil, nil
+	env.expandFile(filename)
+	if cmd.WriteDeclsAndStmtsToFile {
+		cmd.writeCollected(filename)
+	} else {
+		env.writeDeclsToStream(env.Stdout)
+	}
+	return nil
+}
 
+// writeCollected writes the declarations and statements collected from filename
+// to the corresponding *.go file, and its macros to the corresponding macro library
+func (cmd *Cmd) writeCollected(filename string) {
+	env := cmd.Env
+	outname := filename
+	if dot := strings.LastIndexByte(outname, '.'); dot >= 0 {
+		outname = outname[0:dot]
+	}
+	outname += ".go"
+	if cmd.OverwriteFiles {
+		os.Remove(outname)
+	} else {
+		_, err := os.Stat(outname)
+		if err == nil {
+			env.warnf("file exists already, use -f to force overwriting: %v", outname)
+			return
+		}
+	}
+	env.writeDeclsToFile(outname)
+	if len(env.Macros) != 0 {
+		macroname := strings.TrimSuffix(outname, ".go") + macroLibrarySuffix
+		env.writeMacrosToFile(macroname)
 		if env.Options&OptShowEval != 0 {
-			fmt.Fprintf(env.Stdout, "// processed file: %v\t-> %v\n", filename, outname)
+			fmt.Fprintf(env.Stdout, "// processed macros: %v\t-> %v\n", filename, macroname)
 		}
 	}
+
+	if env.Options&OptShowEval != 0 {
+		fmt.Fprintf(env.Stdout, "// processed file: %v\t-> %v\n", filename, outname)
+	}
+}
+
+// EvalPackageFiles evaluates Go source files belonging to the same package.
+// Package-level declarations are initialized in dependency order, then all init()
+// functions are executed in source order and, for package main, main() is invoked
+func (cmd *Cmd) EvalPackageFiles(filenames ...string) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			switch rec := cmd.Env.describePanic(rec).(type) {
+			case error:
+				err = rec
+			default:
+				err = errors.New(fmt.Sprint(rec))
+			}
+		}
+	}()
+	env := cmd.Env
+	files := make([]*ast.File, len(filenames))
+	for i, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		files[i] = env.ParseFile(filename, src)
+	}
+	env.evalPackage(files)
 	return nil
 }
 
 func (cmd *Cmd) EvalReader(src io.Reader) (err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
-			switch rec := rec.(type) {
+			switch rec := cmd.Env.describePanic(rec).(type) {
 			case error:
 				err = rec
 			default:
@@ -28,6 +28,8 @@ import (
 	"go/ast"
 	"go/token"
 	r "reflect"
+
+	"github.com/cosmos72/gomacro/imports"
 )
 
 func (env *Env) evalDecl(node ast.Decl) (r.Value, []r.Value) {
@@ -43,6 +45,9 @@ func (env *Env) evalDecl(node ast.Decl) (r.Value, []r.Value) {
 
 func (env *Env) evalDeclGen(node *ast.GenDecl) (r.Value, []r.Value) {
 	tok := node.Tok
+	if tok != token.IMPORT {
+		env.recordGenDecl(node)
+	}
 	var ret r.Value
 	var rets []r.Value
 	for _, decl := range node.Specs {
@@ -74,6 +79,8 @@ func (env *Env) evalDeclConsts(node ast.Spec) (r.Value, []r.Value) {
 func (env *Env) evalDeclType(node ast.Spec) (r.Value, []r.Value) {
 	switch node := node.(type) {
 	case *ast.TypeSpec:
+		// reflect cannot create named types, so type declarations
+		// and type aliases "type A = B" are both treated as aliases
 		name := node.Name.Name
 		t := env.evalType(node.Type)
 		if _, ok := env.Types[name]; ok {
@@ -107,7 +114,23 @@ func (env *Env) evalDeclConstsOrVars(idents []*ast.Ident, typ ast.Expr, exprs []
 	t := env.evalType(typ)
 
 	var values []r.Value
-	if exprs != nil {
+	if len(exprs) == n && t == nil && constant {
+		values = make([]r.Value, n)
+		for i, expr := range exprs {
+			if c, ok := env.evalUntyped(expr); ok {
+				values[i] = env.defineUntypedConst(names[i], c)
+			} else {
+				values[i] = env.defineConst(names[i], nil, env.evalExpr1(expr))
+			}
+		}
+		return unpackValues(values)
+	} else if len(exprs) == n && t != nil {
+		// untyped constants are converted exactly to t
+		values = make([]r.Value, n)
+		for i, expr := range exprs {
+			values[i] = env.evalExprTo(expr, t)
+		}
+	} else if exprs != nil {
 		values = env.evalExprsMultipleValues(exprs, n)
 	}
 	return env.defineConstsVarsOrFuncs(names, t, values, constant)
@@ -161,6 +184,7 @@ func (env *Env) defineConstVarOrFunc(name string, t r.Type, value r.Value, const
 	if env.Binds == nil {
 		env.Binds = make(map[string]r.Value)
 	}
+	delete(env.Untypeds, name)
 	if constant {
 		value = value.Convert(t)
 		env.Binds[name] = value
@@ -172,3 +196,29 @@ func (env *Env) defineConstVarOrFunc(name string, t r.Type, value r.Value, const
 	// Debugf("defineConstVarOrFunc() added %#v to %#v", name, env.Binds)
 	return value
 }
+
+// defineUntypedConst defines an untyped constant, keeping its exact value.
+// It is also bound with its default type, unless it overflows it
+func (env *Env) defineUntypedConst(name string, c imports.UntypedConst) r.Value {
+	value, err := untypedToDefault(c)
+	if name == "_" {
+		if err != nil {
+			env.errorf("%v", err)
+		}
+		return value
+	}
+	if err == nil {
+		env.defineConst(name, nil, value)
+	} else {
+		if _, exists := env.Binds[name]; exists {
+			env.warnf("redefined identifier: %v", name)
+			delete(env.Binds, name)
+		}
+		value = r.ValueOf(c.Value)
+	}
+	if env.Untypeds == nil {
+		env.Untypeds = make(map[string]imports.UntypedConst)
+	}
+	env.Untypeds[name] = c
+	return value
+}
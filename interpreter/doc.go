@@ -0,0 +1,287 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * doc.go
+ *
+ *  Created on Apr 09, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// recordDecl retains the declaration of 'name' for the :doc command.
+// decl is a *ast.FuncDecl or a *ast.GenDecl containing only the spec that declares 'name'
+func (env *Env) recordDecl(name string, decl ast.Decl) {
+	if name == "_" {
+		return
+	}
+	if env.Decls == nil {
+		env.Decls = make(map[string]ast.Decl)
+	}
+	env.Decls[name] = decl
+}
+
+// recordGenDecl retains the constants, types and variables declared by node for the :doc command
+func (env *Env) recordGenDecl(node *ast.GenDecl) {
+	for _, spec := range node.Specs {
+		var names []*ast.Ident
+		switch spec := spec.(type) {
+		case *ast.ValueSpec:
+			names = spec.Names
+		case *ast.TypeSpec:
+			names = []*ast.Ident{spec.Name}
+		default:
+			continue
+		}
+		decl := &ast.GenDecl{Tok: node.Tok, Specs: []ast.Spec{spec}}
+		if len(node.Specs) == 1 {
+			decl.Doc = node.Doc
+		}
+		for _, ident := range names {
+			env.recordDecl(ident.Name, decl)
+		}
+	}
+}
+
+// showDoc implements the REPL command ":doc NAME", where NAME can be
+// an interpreted declaration, an imported package, or PKG.NAME or PKG.TYPE.METHOD
+// for imported packages. PKG can also be an import path, as "net/http"
+func (env *Env) showDoc(name string) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		env.warnf("doc: missing argument")
+		return
+	}
+	if !strings.Contains(name, ".") {
+		for e := env; e != nil; e = e.Outer {
+			if decl, ok := e.Decls[name]; ok {
+				writeDecl(env.Stdout, env.Fileset, decl, "")
+				return
+			}
+		}
+	}
+	path, sel, imported := env.splitDocName(name)
+	if !imported && !strings.Contains(path, "/") {
+		if env.isDefined(path) {
+			env.warnf("doc: no documentation for %s", name)
+			return
+		}
+		srcdir, _ := os.Getwd()
+		if _, err := build.Import(path, srcdir, build.FindOnly); err != nil {
+			// neither defined nor an import path
+			env.warnf("doc: undefined identifier: %s", path)
+			return
+		}
+	}
+	env.showPackageDoc(path, sel)
+}
+
+// splitDocName splits "PKG.NAME" into the import path of PKG and "NAME".
+// PKG can be the name of an imported package or an import path:
+// imported reports which one
+func (env *Env) splitDocName(name string) (path string, sel string, imported bool) {
+	slash := strings.LastIndexByte(name, '/')
+	pkgname := name
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		pkgname, sel = name[:slash+1+dot], name[slash+1+dot+1:]
+	}
+	if slash < 0 {
+		bind, found := env.resolveIdentifier(&ast.Ident{Name: pkgname})
+		if found && bind.IsValid() && bind.CanInterface() {
+			if pkg, ok := bind.Interface().(*PackageRef); ok {
+				return pkg.Path, sel, true
+			}
+		}
+	}
+	// not imported: use it as an import path
+	return pkgname, sel, false
+}
+
+// isDefined returns true if name is a binding or a type visible from env
+func (env *Env) isDefined(name string) bool {
+	for e := env; e != nil; e = e.Outer {
+		if _, ok := e.Binds[name]; ok {
+			return true
+		}
+		if _, ok := e.Types[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// showPackageDoc shows the documentation of 'sel' in package 'path',
+// extracted with go/doc from the sources found by go/build.
+// If sel is empty, shows the package documentation
+func (env *Env) showPackageDoc(path string, sel string) {
+	fset := token.NewFileSet()
+	dpkg, err := loadPackageDoc(fset, path)
+	if err != nil {
+		env.warnf("doc: %v", err)
+		return
+	}
+	out := env.Stdout
+	if len(sel) == 0 {
+		fmt.Fprintf(out, "package %s // import %q\n\n", dpkg.Name, path)
+		writeDocText(out, dpkg.Doc)
+		return
+	}
+	typename, method := sel, ""
+	if dot := strings.IndexByte(sel, '.'); dot >= 0 {
+		typename, method = sel[:dot], sel[dot+1:]
+	}
+	for _, t := range dpkg.Types {
+		if t.Name != typename {
+			if len(method) == 0 {
+				// constructors, constants and variables are grouped with their type
+				if decl, text := findDocDecl(t.Funcs, t.Consts, t.Vars, sel); decl != nil {
+					writeDecl(out, fset, decl, text)
+					return
+				}
+			}
+			continue
+		}
+		if len(method) == 0 {
+			writeDecl(out, fset, t.Decl, t.Doc)
+			return
+		}
+		for _, m := range t.Methods {
+			if m.Name == method {
+				writeDecl(out, fset, m.Decl, m.Doc)
+				return
+			}
+		}
+		break
+	}
+	if len(method) == 0 {
+		if decl, text := findDocDecl(dpkg.Funcs, dpkg.Consts, dpkg.Vars, sel); decl != nil {
+			writeDecl(out, fset, decl, text)
+			return
+		}
+	}
+	env.warnf("doc: no symbol %s in package %q", sel, path)
+}
+
+// findDocDecl returns the declaration of 'name' and its doc comment
+func findDocDecl(funcs []*doc.Func, consts []*doc.Value, vars []*doc.Value, name string) (ast.Decl, string) {
+	for _, f := range funcs {
+		if f.Name == name {
+			return f.Decl, f.Doc
+		}
+	}
+	for _, list := range [][]*doc.Value{consts, vars} {
+		for _, v := range list {
+			for _, n := range v.Names {
+				if n == name {
+					return v.Decl, v.Doc
+				}
+			}
+		}
+	}
+	return nil, ""
+}
+
+// loadPackageDoc locates the sources of package 'path' with go/build, and extracts their documentation
+func loadPackageDoc(fset *token.FileSet, path string) (*doc.Package, error) {
+	srcdir, _ := os.Getwd()
+	bpkg, err := build.Import(path, srcdir, 0)
+	if err != nil {
+		return nil, err
+	}
+	var files []*ast.File
+	for _, name := range bpkg.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(bpkg.Dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return doc.NewFromFiles(fset, files, bpkg.ImportPath)
+}
+
+// writeDecl writes the signature of decl followed by its doc comment, as "go doc" does.
+// If text is empty, the doc comment is taken from decl
+func writeDecl(out io.Writer, fset *token.FileSet, decl ast.Decl, text string) {
+	switch decl := decl.(type) {
+	case *ast.FuncDecl:
+		sig := *decl
+		sig.Doc, sig.Body = nil, nil
+		if len(text) == 0 {
+			text = decl.Doc.Text()
+		}
+		fmt.Fprintf(out, "%v\n", fileSet{fset}.nodeToPrintable(&sig))
+	case *ast.GenDecl:
+		sig := *decl
+		sig.Doc = nil
+		if len(text) == 0 {
+			text = decl.Doc.Text()
+		}
+		if len(decl.Specs) == 1 {
+			// also show the doc comment of the spec, without duplicating it
+			switch spec := decl.Specs[0].(type) {
+			case *ast.ValueSpec:
+				if spec.Doc != nil {
+					if len(text) == 0 {
+						text = spec.Doc.Text()
+					}
+					specCopy := *spec
+					specCopy.Doc = nil
+					sig.Specs = []ast.Spec{&specCopy}
+				}
+			case *ast.TypeSpec:
+				if spec.Doc != nil {
+					if len(text) == 0 {
+						text = spec.Doc.Text()
+					}
+					specCopy := *spec
+					specCopy.Doc = nil
+					sig.Specs = []ast.Spec{&specCopy}
+				}
+			}
+		}
+		fmt.Fprintf(out, "%v\n", fileSet{fset}.nodeToPrintable(&sig))
+	}
+	if len(text) != 0 {
+		fmt.Fprintln(out)
+		writeDocText(out, text)
+	}
+}
+
+// writeDocText writes a doc comment, indented by four spaces
+func writeDocText(out io.Writer, text string) {
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		if len(line) == 0 {
+			fmt.Fprintln(out)
+		} else {
+			fmt.Fprintf(out, "    %s\n", line)
+		}
+	}
+}
@@ -125,6 +125,24 @@ func (env *Env) ReadParseEvalPrint(in *bufio.Reader) (callAgain bool) {
 		}
 		return false
 	}
+	if env.Options&OptShowPrompt != 0 {
+		// at the prompt, ReadMultiline() returns comment-only lines immediately:
+		// keep them, so that they document the declaration that follows
+		if isCommentOnly(str) {
+			env.docComments += str
+			return true
+		}
+		if len(env.docComments) != 0 {
+			if strings.HasPrefix(strings.TrimSpace(str), ":") {
+				// commands cannot follow comments
+				env.source.next(env.docComments)
+			} else {
+				str = env.docComments + str
+			}
+			env.docComments = ""
+		}
+	}
+	env.source.next(str)
 
 	trap := env.Options&OptTrapPanic != 0
 	duration := env.Options&OptShowTime != 0
@@ -136,7 +154,7 @@ func (env *Env) ReadParseEvalPrint(in *bufio.Reader) (callAgain bool) {
 		defer func() {
 			if trap {
 				if rec := recover(); rec != nil {
-					fmt.Fprintln(env.Stderr, rec)
+					fmt.Fprintln(env.Stderr, env.describePanic(rec))
 					callAgain = true
 				}
 			}
@@ -159,7 +177,23 @@ func (env *Env) ParseEvalPrint(src string, in *bufio.Reader) (callAgain bool) {
 	if n > 0 && src[0] == ':' {
 		args := strings.SplitN(src, " ", 2)
 		cmd := args[0]
+		// commands that parse their argument need its position
+		env.source.skip(cmd)
 		switch {
+		case startsWith(":cache", cmd):
+			if len(args) <= 1 {
+				env.pluginCacheCommand("")
+			} else {
+				env.pluginCacheCommand(args[1])
+			}
+			return true
+		case startsWith(":doc", cmd):
+			if len(args) <= 1 {
+				env.showDoc("")
+			} else {
+				env.showDoc(args[1])
+			}
+			return true
 		case startsWith(":env", cmd):
 			if len(args) <= 1 {
 				env.showPackage("")
@@ -167,6 +201,15 @@ func (env *Env) ParseEvalPrint(src string, in *bufio.Reader) (callAgain bool) {
 				env.showPackage(args[1])
 			}
 			return true
+		case cmd == ":expand1":
+			env.macroStepCommand(src[len(cmd):], macroStepOnce)
+			return true
+		case startsWith(":expandall", cmd) && len(cmd) > len(":expand"):
+			env.macroStepCommand(src[len(cmd):], macroStepAll)
+			return true
+		case startsWith(":expand", cmd):
+			env.macroStepCommand(src[len(cmd):], macroStepTop)
+			return true
 		case startsWith(":help", cmd):
 			env.showHelp(env.Stdout)
 			return true
@@ -188,6 +231,13 @@ func (env *Env) ParseEvalPrint(src string, in *bufio.Reader) (callAgain bool) {
 			return true
 		case startsWith(":quit", cmd):
 			return false
+		case startsWith(":reimport", cmd):
+			if len(args) <= 1 {
+				env.reimportCommand("")
+			} else {
+				env.reimportCommand(args[1])
+			}
+			return true
 		case startsWith(":write", cmd):
 			if len(args) <= 1 {
 				env.writeDeclsToStream(env.Stdout)
@@ -212,7 +262,10 @@ func (env *Env) ParseEvalPrint(src string, in *bufio.Reader) (callAgain bool) {
 	}
 
 	// parse + macroexpansion phase
+	mark := env.expansionMark()
 	ast := env.ParseAst(src)
+	defer env.pruneExpansions(mark, ast)
+	env.forgetPanic()
 
 	// eval phase
 	value, values := env.EvalAst(ast)
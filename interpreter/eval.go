@@ -66,6 +66,13 @@ func (env *Env) EvalAst(in Ast) (r.Value, []r.Value) {
 }
 
 func (env *Env) Eval(node ast.Node) (r.Value, []r.Value) {
+	if exp := env.expansionOf(node); exp != nil {
+		defer func() {
+			if rec := recover(); rec != nil {
+				panic(env.annotateExpansion(rec, exp))
+			}
+		}()
+	}
 	switch node := node.(type) {
 	case ast.Decl:
 		return env.evalDecl(node)
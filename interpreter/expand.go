@@ -0,0 +1,88 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * expand.go
+ *
+ *  Created on Apr 12, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	. "github.com/cosmos72/gomacro/ast2"
+)
+
+// expandFile macroexpands a file without executing it, and collects the expanded
+// declarations and statements as -w does.
+// Only imports, macros, functions, types, constants and interpret_only blocks are evaluated,
+// because macros may need them. Variables and statements are collected but not evaluated
+func (env *Env) expandFile(filename string) {
+	saveOptions := env.Options
+	env.Options |= OptCollectDeclarations | OptCollectStatements
+	defer func() {
+		env.Options = saveOptions
+	}()
+	env.forEachScriptChunk(filename, func(str string) {
+		if str == "package" || startsWith(str, "package ") {
+			env.Packagename = strings.TrimSpace(str[len("package"):])
+			return
+		}
+		// ParseAst() macroexpands and collects
+		mark := env.expansionMark()
+		form := env.ParseAst(str)
+		defer env.pruneExpansions(mark, form)
+		env.evalExpandTimeDecls(form)
+	})
+}
+
+// evalExpandTimeDecls evaluates the declarations in form that macros may need
+func (env *Env) evalExpandTimeDecls(form Ast) {
+	switch form := form.(type) {
+	case AstWithNode:
+		if isExpandTimeDecl(form.Node()) {
+			env.EvalAst(form)
+		}
+	case AstWithSlice:
+		for i, n := 0, form.Size(); i < n; i++ {
+			env.evalExpandTimeDecls(form.Get(i))
+		}
+	}
+}
+
+// isExpandTimeDecl returns true if node is an import, macro, function, type or constant declaration,
+// or an interpret_only block
+func isExpandTimeDecl(node ast.Node) bool {
+	if isInterpretOnly(node) {
+		return true
+	}
+	switch node := node.(type) {
+	case *ast.DeclStmt:
+		return isExpandTimeDecl(node.Decl)
+	case *ast.GenDecl:
+		return node.Tok == token.IMPORT || node.Tok == token.TYPE || node.Tok == token.CONST
+	case *ast.FuncDecl:
+		// methods are not supported by the interpreter yet
+		return node.Recv == nil || len(node.Recv.List) == 0
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * expansion.go
+ *
+ *  Created on Apr 11, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	r "reflect"
+
+	. "github.com/cosmos72/gomacro/ast2"
+)
+
+// macroExpansion is the provenance of the nodes generated by a macro call
+type macroExpansion struct {
+	Name  string          // macro name, as written at the call site
+	Def   token.Position  // position of the macro declaration
+	Call  token.Position  // position of the macro call
+	Outer *macroExpansion // if the macro call was itself generated by a macro
+}
+
+func (exp *macroExpansion) String() string {
+	var buf bytes.Buffer
+	for ; exp != nil; exp = exp.Outer {
+		if buf.Len() != 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "in expansion of macro %s at %v", exp.Name, exp.Call)
+		if exp.Def.IsValid() {
+			fmt.Fprintf(&buf, " (defined at %v)", exp.Def)
+		}
+	}
+	return buf.String()
+}
+
+// recordExpansion remembers the provenance of the nodes in out, the result of invoking macro on args.
+// Nodes passed unchanged from the arguments keep their own provenance, since they come from the call site
+func (ir *InterpreterCommon) recordExpansion(call Ast, macro Macro, args []r.Value, out Ast) *macroExpansion {
+	callNode := ToNode(unwrapTrivialAst(call))
+	exp := &macroExpansion{
+		Name:  fmt.Sprint(ir.toPrintable(callNode)),
+		Def:   ir.Fileset.Position(macro.Pos),
+		Call:  ir.Fileset.Position(callNode.Pos()),
+		Outer: ir.expansions[callNode],
+	}
+	if exp.Outer != nil {
+		// the macro call was generated by another macro, and its position is inside
+		// the body of that macro: report where the outer macro was called instead
+		exp.Call = exp.Outer.Call
+	}
+	fromArgs := make(map[ast.Node]bool)
+	addArg := func(node ast.Node) {
+		ast.Inspect(node, func(node ast.Node) bool {
+			if node != nil {
+				fromArgs[node] = true
+			}
+			return true
+		})
+	}
+	for _, arg := range args {
+		if !arg.IsValid() || !arg.CanInterface() {
+			continue
+		}
+		switch arg := arg.Interface().(type) {
+		case ast.Node:
+			addArg(arg)
+		case []ast.Node:
+			for _, node := range arg {
+				addArg(node)
+			}
+		case []ast.Stmt:
+			for _, node := range arg {
+				addArg(node)
+			}
+		case []ast.Expr:
+			for _, node := range arg {
+				addArg(node)
+			}
+		}
+	}
+	if ir.expansions == nil {
+		ir.expansions = make(map[ast.Node]*macroExpansion)
+	}
+	var visit func(form Ast)
+	visit = func(form Ast) {
+		switch form := form.(type) {
+		case AstWithNode:
+			ast.Inspect(form.Node(), func(node ast.Node) bool {
+				if node == nil || fromArgs[node] {
+					return false
+				}
+				ir.expansions[node] = exp
+				ir.expansionLog = append(ir.expansionLog, node)
+				return true
+			})
+		case AstWithSlice:
+			for i, n := 0, form.Size(); i < n; i++ {
+				visit(form.Get(i))
+			}
+		}
+	}
+	visit(out)
+	return exp
+}
+
+// copyExpansion gives to the node 'to' the provenance of the node 'from', since macroexpansion
+// copies the nodes it walks through
+func (ir *InterpreterCommon) copyExpansion(from Ast, to Ast) {
+	if len(ir.expansions) == 0 {
+		return
+	}
+	fromNode, ok1 := unwrapTrivialAst(from).(AstWithNode)
+	toNode, ok2 := unwrapTrivialAst(to).(AstWithNode)
+	if !ok1 || !ok2 {
+		return
+	}
+	if exp := ir.expansions[fromNode.Node()]; exp != nil {
+		if _, ok := ir.expansions[toNode.Node()]; ok {
+			// 'to' was generated by a macro call nested in 'from': keep the innermost provenance
+			return
+		}
+		ir.expansions[toNode.Node()] = exp
+		ir.expansionLog = append(ir.expansionLog, toNode.Node())
+	}
+}
+
+// expansionOf returns the provenance of a node generated by macroexpansion,
+// or nil if node was not generated by a macro
+func (ir *InterpreterCommon) expansionOf(node ast.Node) *macroExpansion {
+	if len(ir.expansions) == 0 || node == nil {
+		return nil
+	}
+	if exp := ir.expansions[node]; exp != nil {
+		return exp
+	}
+	switch node := node.(type) {
+	case *ast.ExprStmt:
+		return ir.expansions[node.X]
+	case *ast.DeclStmt:
+		return ir.expansions[node.Decl]
+	}
+	return nil
+}
+
+// expansionMark returns the number of nodes whose provenance was recorded so far, see pruneExpansions()
+func (ir *InterpreterCommon) expansionMark() int {
+	return len(ir.expansionLog)
+}
+
+// pruneExpansions forgets the provenance of the nodes recorded after mark, once the top-level form
+// containing them has been evaluated. Only the nodes inside function bodies can be evaluated again,
+// so they keep their provenance. Without pruning, ir.expansions would keep alive
+// every node ever generated by a macro
+func (ir *InterpreterCommon) pruneExpansions(mark int, form Ast) {
+	if len(ir.expansionLog) <= mark {
+		return
+	}
+	keep := make(map[ast.Node]bool)
+	var visit func(form Ast)
+	visit = func(form Ast) {
+		switch form := form.(type) {
+		case AstWithNode:
+			ast.Inspect(form.Node(), func(node ast.Node) bool {
+				switch node.(type) {
+				case *ast.FuncDecl, *ast.FuncLit:
+					ast.Inspect(node, func(node ast.Node) bool {
+						if node != nil {
+							keep[node] = true
+						}
+						return true
+					})
+					return false
+				}
+				return true
+			})
+		case AstWithSlice:
+			for i, n := 0, form.Size(); i < n; i++ {
+				visit(form.Get(i))
+			}
+		}
+	}
+	visit(form)
+	for _, node := range ir.expansionLog[mark:] {
+		if !keep[node] {
+			delete(ir.expansions, node)
+		}
+	}
+	ir.expansionLog = ir.expansionLog[:mark]
+}
+
+// annotateExpansion adds the macro expansion exp to rec, if it is an interpreter error without one.
+// Other panics are returned unchanged, since interpreted code may recover them:
+// the innermost macro expansion that raised them is remembered instead, see describePanic()
+func (ir *InterpreterCommon) annotateExpansion(rec interface{}, exp *macroExpansion) interface{} {
+	if err, ok := rec.(runtimeError); ok {
+		if err.expansion == nil {
+			err.expansion = exp
+			return err
+		}
+		return rec
+	}
+	if ir.panicExpansion == nil || !r.DeepEqual(ir.panicValue, rec) {
+		ir.panicValue, ir.panicExpansion = rec, exp
+	}
+	return rec
+}
+
+// forgetPanic is called when a panic is recovered or reported
+func (ir *InterpreterCommon) forgetPanic() {
+	ir.panicValue, ir.panicExpansion = nil, nil
+}
+
+// describePanic returns rec followed by the macro expansion that raised it,
+// if rec is not an interpreter error and it was raised by code generated by a macro.
+// Used where the top level reports panics
+func (ir *InterpreterCommon) describePanic(rec interface{}) interface{} {
+	exp := ir.panicExpansion
+	if _, ok := rec.(runtimeError); ok || exp == nil || !r.DeepEqual(ir.panicValue, rec) {
+		return rec
+	}
+	ir.forgetPanic()
+	if err, ok := rec.(error); ok {
+		return fmt.Errorf("%w, %v", err, exp)
+	}
+	return fmt.Sprintf("%v, %v", rec, exp)
+}
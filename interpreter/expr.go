@@ -103,9 +103,12 @@ func (env *Env) evalExpr(in ast.Expr) (r.Value, []r.Value) {
 			return r.ValueOf(ret), nil
 
 		case *ast.BinaryExpr:
-			xv := env.evalExpr1(node.X)
+			if c, ok := env.evalUntyped(node); ok {
+				return env.untypedDefault(c), nil
+			}
 			switch op := node.Op; op {
 			case token.LAND, token.LOR:
+				xv := env.evalExpr1(node.X)
 				if xv.Kind() != r.Bool {
 					return env.unsupportedLogicalOperand(op, xv)
 				}
@@ -121,8 +124,8 @@ func (env *Env) evalExpr(in ast.Expr) (r.Value, []r.Value) {
 				}
 				return yv, nil
 			default:
-				yv := env.evalExpr1(node.Y)
-				return env.evalBinaryExpr(xv, node.Op, yv), nil
+				xv, yv := env.evalBinaryOperands(node)
+				return env.evalBinaryExpr(xv, op, yv), nil
 			}
 
 		case *ast.CallExpr:
@@ -145,6 +148,9 @@ func (env *Env) evalExpr(in ast.Expr) (r.Value, []r.Value) {
 			continue
 
 		case *ast.UnaryExpr:
+			if c, ok := env.evalUntyped(node); ok {
+				return env.untypedDefault(c), nil
+			}
 			return env.evalUnaryExpr(node)
 
 		case *ast.SelectorExpr:
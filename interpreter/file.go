@@ -26,18 +26,295 @@ package interpreter
 
 import (
 	"go/ast"
+	"go/token"
 	r "reflect"
 )
 
+// initItem is a package-level type, constant or variable declaration
+// waiting to be evaluated during package initialization
+type initItem struct {
+	tok   token.Token
+	spec  ast.Spec
+	names []string
+	deps  map[string]bool // package-level names referenced by spec
+	done  bool
+}
+
+// pkgInit collects the declarations of one or more files belonging to the same package,
+// and evaluates them following the Go package initialization order
+type pkgInit struct {
+	env      *Env
+	topObjs  map[*ast.Object]bool // objects of top-level declarations, as resolved by the parser
+	imports  map[string]bool      // imports already evaluated, as "PATH" or "NAME PATH"
+	items    map[string]*initItem // types, constants and variables by name
+	funcs    map[string]*ast.FuncDecl
+	funcDeps map[string]map[string]bool // package-level names referenced by each function
+	funcList []*ast.FuncDecl            // functions, in source order
+	consts   []*initItem                // types and constants, in source order
+	vars     []*initItem                // variables, in source order
+	inits    []*ast.FuncDecl
+}
+
 func (env *Env) evalFile(node *ast.File) (r.Value, []r.Value) {
-	env.Packagename = node.Name.Name
+	return env.evalPackage([]*ast.File{node})
+}
+
+// evalPackage evaluates the declarations of one or more files belonging to the same package
+// with the semantics of Go package initialization: imports and macros are evaluated first,
+// in source order. Then types and constants, function declarations and variables are evaluated,
+// each in dependency order. Finally all init() functions are executed in source order
+// and, for package main, main() is invoked if present.
+func (env *Env) evalPackage(files []*ast.File) (r.Value, []r.Value) {
+	if len(files) == 0 {
+		return None, nil
+	}
+	env.Packagename = files[0].Name.Name
+
+	p := pkgInit{
+		env:     env,
+		topObjs: make(map[*ast.Object]bool),
+		imports: make(map[string]bool),
+		items:   make(map[string]*initItem),
+		funcs:   make(map[string]*ast.FuncDecl),
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			p.addTopDecl(decl)
+		}
+	}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			p.collectDecl(decl)
+		}
+	}
+	p.computeDeps()
+
+	p.evalItems(p.consts)
+	for _, decl := range p.funcList {
+		env.evalDeclNamedFunction(decl)
+	}
+	p.evalItems(p.vars)
+
+	for _, decl := range p.inits {
+		fun, _ := env.evalDeclFunction(decl, decl.Type, decl.Body)
+		fun.Call(nil)
+	}
+	if env.Packagename == "main" {
+		if main, ok := env.Binds["main"]; ok && main.Kind() == r.Func && main.Type().NumIn() == 0 {
+			main.Call(nil)
+		}
+	}
+	return None, nil
+}
+
+// addTopDecl remembers the objects of top-level declarations:
+// the parser resolves package-level identifiers to them
+func (p *pkgInit) addTopDecl(decl ast.Decl) {
+	var idents []*ast.Ident
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		for _, spec := range decl.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				idents = append(idents, spec.Name)
+			case *ast.ValueSpec:
+				idents = append(idents, spec.Names...)
+			}
+		}
+	case *ast.FuncDecl:
+		idents = append(idents, decl.Name)
+	}
+	for _, ident := range idents {
+		if ident.Obj != nil {
+			p.topObjs[ident.Obj] = true
+		}
+	}
+}
+
+// collectDecl macroexpands a top-level declaration, evaluates it immediately if it's an import
+// or a macro, otherwise classifies it for later evaluation
+func (p *pkgInit) collectDecl(decl ast.Decl) {
+	env := p.env
+	node, _ := env.MacroExpandCodewalk(decl)
+	decl, ok := node.(ast.Decl)
+	if !ok {
+		env.errorf("macroexpansion of top-level declaration produced a non-declaration: %v <%v>", node, r.TypeOf(node))
+		return
+	}
+	if env.Options&OptCollectDeclarations != 0 {
+		env.collectNode(decl)
+	}
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		if decl.Tok == token.IMPORT {
+			p.evalImports(decl)
+			return
+		}
+		for _, spec := range decl.Specs {
+			p.addSpec(decl.Tok, spec)
+		}
+	case *ast.FuncDecl:
+		if decl.Recv != nil && len(decl.Recv.List) == 0 {
+			// macros must be available to the declarations that follow
+			env.evalDeclNamedFunction(decl)
+		} else if decl.Recv == nil && decl.Name.Name == "init" {
+			p.inits = append(p.inits, decl)
+		} else if decl.Recv == nil {
+			p.funcs[decl.Name.Name] = decl
+			p.funcList = append(p.funcList, decl)
+		} else {
+			env.evalDecl(decl) // method declaration
+		}
+	default:
+		env.evalDecl(decl)
+	}
+}
 
-	// TODO eval node.Imports
-	var ret r.Value
-	var rets []r.Value
+// evalImports evaluates an import declaration. The imports of all files share the package scope,
+// thus an import already evaluated for another file, with the same name and path, is skipped
+func (p *pkgInit) evalImports(decl *ast.GenDecl) {
+	var specs []ast.Spec
+	for _, spec := range decl.Specs {
+		if spec, ok := spec.(*ast.ImportSpec); ok {
+			key := spec.Path.Value
+			if spec.Name != nil {
+				key = spec.Name.Name + " " + key
+			}
+			if p.imports[key] {
+				continue
+			}
+			p.imports[key] = true
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) != 0 {
+		p.env.evalDeclGen(&ast.GenDecl{Doc: decl.Doc, TokPos: decl.TokPos, Tok: decl.Tok,
+			Lparen: decl.Lparen, Specs: specs, Rparen: decl.Rparen})
+	}
+}
+
+func (p *pkgInit) addSpec(tok token.Token, spec ast.Spec) {
+	item := &initItem{tok: tok, spec: spec}
+	switch spec := spec.(type) {
+	case *ast.TypeSpec:
+		item.names = []string{spec.Name.Name}
+	case *ast.ValueSpec:
+		for _, ident := range spec.Names {
+			item.names = append(item.names, ident.Name)
+		}
+	}
+	for _, name := range item.names {
+		if name != "_" {
+			p.items[name] = item
+		}
+	}
+	if tok == token.VAR {
+		p.vars = append(p.vars, item)
+	} else {
+		p.consts = append(p.consts, item)
+	}
+}
+
+// computeDeps computes the package-level types, constants and variables
+// each declaration depends on, following references through function bodies as Go does
+func (p *pkgInit) computeDeps() {
+	p.funcDeps = make(map[string]map[string]bool)
+	for _, list := range [][]*initItem{p.consts, p.vars} {
+		for _, item := range list {
+			item.deps = make(map[string]bool)
+			p.addDeps(item.deps, item.spec, make(map[string]bool))
+			for _, name := range item.names {
+				delete(item.deps, name)
+			}
+		}
+	}
+}
+
+// addDeps adds to deps the package-level types, constants and variables referenced by node
+func (p *pkgInit) addDeps(deps map[string]bool, node ast.Node, visiting map[string]bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			// n.Sel is a field, method or imported name, not a package-level identifier
+			p.addDeps(deps, n.X, visiting)
+			return false
+		case *ast.Ident:
+			p.addIdentDeps(deps, n, visiting)
+		}
+		return true
+	})
+}
+
+func (p *pkgInit) addIdentDeps(deps map[string]bool, ident *ast.Ident, visiting map[string]bool) {
+	// identifiers resolved by the parser to local declarations are not package-level
+	if obj := ident.Obj; obj != nil && !p.topObjs[obj] {
+		return
+	}
+	name := ident.Name
+	if _, ok := p.items[name]; ok {
+		deps[name] = true
+		return
+	}
+	decl, ok := p.funcs[name]
+	if !ok || visiting[name] {
+		return
+	}
+	fdeps, ok := p.funcDeps[name]
+	if !ok {
+		visiting[name] = true
+		fdeps = make(map[string]bool)
+		p.addDeps(fdeps, decl, visiting)
+		delete(visiting, name)
+		p.funcDeps[name] = fdeps
+	}
+	for dep := range fdeps {
+		deps[dep] = true
+	}
+}
+
+// evalItems evaluates declarations in dependency order: Go specs
+// "the next package-level variable that is earliest in declaration order
+// and ready for initialization is selected"
+func (p *pkgInit) evalItems(list []*initItem) {
+	for remaining := len(list); remaining > 0; remaining-- {
+		var next *initItem
+		for _, item := range list {
+			if !item.done && p.isReady(item) {
+				next = item
+				break
+			}
+		}
+		if next == nil {
+			for _, item := range list {
+				if !item.done {
+					next = item
+					break
+				}
+			}
+			p.env.warnf("initialization cycle or unresolved dependency for %v, initializing in source order", next.names)
+		}
+		p.evalItem(next)
+	}
+}
+
+func (p *pkgInit) isReady(item *initItem) bool {
+	for dep := range item.deps {
+		if other := p.items[dep]; other != nil && !other.done {
+			return false
+		}
+	}
+	return true
+}
 
-	for _, decl := range node.Decls {
-		ret, rets = env.evalDecl(decl)
+func (p *pkgInit) evalItem(item *initItem) {
+	item.done = true
+	env := p.env
+	switch item.tok {
+	case token.TYPE:
+		env.evalDeclType(item.spec)
+	case token.CONST:
+		env.evalDeclConsts(item.spec)
+	case token.VAR:
+		env.evalDeclVars(item.spec)
 	}
-	return ret, rets
 }
@@ -71,7 +71,13 @@ func (env *Env) evalForRange(node *ast.RangeStmt) (r.Value, []r.Value) {
 		return env.evalForRangeChannel(container, node)
 	case r.Map:
 		return env.evalForRangeMap(container, node)
-	case r.Slice, r.Array:
+	case r.Array:
+		// Golang specs https://golang.org/ref/spec#For_statements
+		// "The range expression x is evaluated once before beginning the loop"
+		// i.e. an array is copied, and assigning to its elements inside the loop
+		// does not change the iteration values
+		return env.evalForRangeSlice(copyValue(container), node)
+	case r.Slice:
 		return env.evalForRangeSlice(container, node)
 	case r.String:
 		// Golang specs https://golang.org/ref/spec#RangeClause
@@ -81,8 +87,15 @@ func (env *Env) evalForRange(node *ast.RangeStmt) (r.Value, []r.Value) {
 		if container.Elem().Kind() == r.Array {
 			return env.evalForRangeSlice(container.Elem(), node)
 		}
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64,
+		r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr:
+		// Golang specs https://golang.org/ref/spec#For_range
+		// "For an integer value n, the iteration values 0 through n-1 are produced in increasing order"
+		return env.evalForRangeInt(container, node)
+	case r.Func:
+		return env.evalForRangeFunc(container, node)
 	}
-	return env.errorf("invalid for range: expecting array, channel, map, slice, string, or pointer to array, found: %v <%v>",
+	return env.errorf("invalid for range: expecting array, channel, function, integer, map, slice, string, or pointer to array, found: %v <%v>",
 		container, typeOf(container))
 }
 
@@ -264,6 +277,154 @@ func (env *Env) evalForRangeSlice(obj r.Value, node *ast.RangeStmt) (r.Value, []
 	return None, nil
 }
 
+func (env *Env) evalForRangeInt(obj r.Value, node *ast.RangeStmt) (r.Value, []r.Value) {
+	knode := nilIfIdentUnderscore(node.Key)
+	if node.Value != nil {
+		return env.errorf("range expression is an integer: expecting at most one iteration variable, found two: %v %v", node.Key, node.Value)
+	}
+	t := obj.Type()
+	var n uint64
+	switch obj.Kind() {
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+		if i := obj.Int(); i > 0 {
+			n = uint64(i)
+		}
+	default:
+		n = obj.Uint()
+	}
+	tok := node.Tok
+	switch tok {
+	case token.DEFINE:
+		env = NewEnv(env, "range int {}")
+		k := env.defineForIterVar(knode, t)
+
+		for i := uint64(0); i < n; i++ {
+			if k != Nil {
+				k.Set(r.ValueOf(i).Convert(t))
+			}
+			if !env.evalForBodyOnce(node.Body) {
+				break
+			}
+		}
+	case token.ASSIGN:
+		for i := uint64(0); i < n; i++ {
+			// Golang specs https://golang.org/ref/spec#RangeClause
+			// "Function calls on the left are evaluated once per iteration"
+			//
+			// we actually evaluate once per iteration the full expressions on the left
+			if knode != nil {
+				kplace := env.evalPlace(knode)
+				env.assignPlace(kplace, tok, r.ValueOf(i).Convert(t))
+			}
+			if !env.evalForBodyOnce(node.Body) {
+				break
+			}
+		}
+	default:
+		for i := uint64(0); i < n; i++ {
+			if !env.evalForBodyOnce(node.Body) {
+				break
+			}
+		}
+	}
+	return None, nil
+}
+
+// evalForRangeFunc implements range-over-function iterators, i.e.
+// for k, v := range seq where seq is a func(yield func(K, V) bool)
+func (env *Env) evalForRangeFunc(seq r.Value, node *ast.RangeStmt) (r.Value, []r.Value) {
+	t := seq.Type()
+	if t.NumIn() != 1 || t.NumOut() != 0 || t.In(0).Kind() != r.Func {
+		return env.errorf("invalid for range: expecting function func(yield func(...) bool), found: %v <%v>", node.X, t)
+	}
+	tyield := t.In(0)
+	nargs := tyield.NumIn()
+	if nargs > 2 || tyield.NumOut() != 1 || tyield.Out(0).Kind() != r.Bool {
+		return env.errorf("invalid for range: expecting function func(yield func(...) bool), found: %v <%v>", node.X, t)
+	}
+	if (node.Key != nil && nargs < 1) || (node.Value != nil && nargs < 2) {
+		return env.errorf("range over %v <%v> permits only %d iteration variables", node.X, t, nargs)
+	}
+	knode := nilIfIdentUnderscore(node.Key)
+	vnode := nilIfIdentUnderscore(node.Value)
+	tok := node.Tok
+	var k, v r.Value
+	if tok == token.DEFINE {
+		env = NewEnv(env, "range func {}")
+		if nargs > 0 {
+			k = env.defineForIterVar(knode, tyield.In(0))
+		}
+		if nargs > 1 {
+			v = env.defineForIterVar(vnode, tyield.In(1))
+		}
+	}
+	stack := env.CallStack
+	depth := len(stack.Frames)
+	done := false
+	var ret *eReturn
+
+	yield := r.MakeFunc(tyield, func(args []r.Value) []r.Value {
+		if done {
+			env.errorf("range function continued iteration after loop body exit: %v", node.X)
+		}
+		// run the loop body in the call frame of the function containing the loop,
+		// not in the frames of the iterator: defer, recover and return must see the former
+		inner := append([]CallFrame(nil), stack.Frames[depth:]...)
+		stack.Frames = stack.Frames[:depth]
+		defer func() {
+			stack.Frames = append(stack.Frames[:depth], inner...)
+		}()
+		switch tok {
+		case token.DEFINE:
+			if k != Nil {
+				k.Set(args[0])
+			}
+			if v != Nil {
+				v.Set(args[1])
+			}
+		case token.ASSIGN:
+			// Golang specs https://golang.org/ref/spec#RangeClause
+			// "Function calls on the left are evaluated once per iteration"
+			if knode != nil {
+				kplace := env.evalPlace(knode)
+				env.assignPlace(kplace, tok, args[0])
+			}
+			if vnode != nil {
+				vplace := env.evalPlace(vnode)
+				env.assignPlace(vplace, tok, args[1])
+			}
+		}
+		cont := env.evalForRangeFuncBodyOnce(node.Body, &ret)
+		done = !cont
+		return []r.Value{r.ValueOf(cont)}
+	})
+	seq.Call([]r.Value{yield})
+	done = true
+
+	if ret != nil {
+		// the loop body executed a return: propagate it now that the iterator has returned
+		panic(*ret)
+	}
+	return None, nil
+}
+
+// evalForRangeFuncBodyOnce is like evalForBodyOnce, but also intercepts return statements:
+// they must not unwind through the iterator, which would treat them as its own return.
+// Instead, yield returns false and the return is stored in *ret
+func (env *Env) evalForRangeFuncBodyOnce(node *ast.BlockStmt, ret **eReturn) (cont bool) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			if p, ok := rec.(eReturn); ok {
+				*ret = &p
+				cont = false
+			} else {
+				panic(rec)
+			}
+		}
+	}()
+	return env.evalForBodyOnce(node)
+}
+
 func (env *Env) evalForBodyOnce(node *ast.BlockStmt) (cont bool) {
 	defer func() {
 		if rec := recover(); rec != nil {
@@ -56,6 +56,7 @@ func (env *Env) evalDeclNamedFunction(node *ast.FuncDecl) (r.Value, []r.Value) {
 
 	fun, t := env.evalDeclFunction(node, node.Type, node.Body)
 	ret := env.defineFunc(name, t, fun)
+	env.recordDecl(name, node)
 	return ret, nil
 }
 
@@ -86,7 +87,11 @@ func (env *Env) evalDeclFunction(decl *ast.FuncDecl, funcType *ast.FuncType, bod
 	}
 	if isMacro {
 		// env.Debugf("defined macro %v, type %v, args (%v), returns (%v)", decl.Name.Name, t, strings.Join(argNames, ", "), strings.Join(resultNames, ", "))
-		ret = r.ValueOf(Macro{Closure: closure, ArgNum: len(argNames)})
+		hygienic := env.Options&OptMacroHygiene != 0
+		if hygienic {
+			closure = env.hygienicMacroClosure(closure)
+		}
+		ret = r.ValueOf(Macro{Closure: closure, ArgNum: len(argNames), Hygienic: hygienic, Type: t, Pos: decl.Pos()})
 		tret = typeOf(ret) // do NOT change t, is needed by the closure above
 	} else {
 		ret = r.MakeFunc(t, closure)
@@ -143,6 +148,8 @@ func (env *Env) evalFuncCall(envName string, body *ast.BlockStmt, t r.Type, argN
 		}
 		if len(frame.defers) != 0 {
 			frame.runDefers(env)
+			// deferred calls may have grown env.CallStack.Frames, moving our frame
+			frame = env.CurrentFrame()
 		}
 		stack := env.CallStack
 		stack.Frames = stack.Frames[0 : len(stack.Frames)-1]
@@ -191,7 +198,12 @@ func (env *Env) convertFuncCallResults(t r.Type, rets []r.Value, warn bool) []r.
 		rets = rets[:expectedN]
 	}
 	for i := range rets {
-		rets[i] = rets[i].Convert(t.Out(i))
+		if rets[i].IsValid() {
+			rets[i] = rets[i].Convert(t.Out(i))
+		} else {
+			// nil or missing return value
+			rets[i] = r.Zero(t.Out(i))
+		}
 	}
 	return rets
 }
@@ -209,11 +221,11 @@ func (frame *CallFrame) runDefers(env *Env) {
 	}
 	defers := frame.defers
 	for i := len(defers) - 1; i >= 0; i-- {
-		frame.runDefer(defers[i])
+		env.runDefer(defers[i])
 	}
 }
 
-func (frame *CallFrame) runDefer(deferred func()) {
+func (env *Env) runDefer(deferred func()) {
 	// invoking panic() inside a deferred function exits it with a panic,
 	// but the previously-installed deferred functions are still executed
 	// and can recover() such panic
@@ -221,6 +233,8 @@ func (frame *CallFrame) runDefer(deferred func()) {
 	panicking := true // use a flag to distinguish non-panic from panic(nil)
 	defer func() {
 		if panicking {
+			// look up the frame only now: deferred() may have grown env.CallStack.Frames
+			frame := env.CurrentFrame()
 			frame.panick = recover()
 			frame.panicking = true
 		}
@@ -240,8 +254,11 @@ func (env *Env) evalCall(node *ast.CallExpr) (r.Value, []r.Value) {
 	}
 
 	if t != nil {
-		val := env.evalExpr1(node.Args[0])
-		return env.valueToType(val, t), nil
+		if c, ok := env.evalUntyped(node.Args[0]); ok && t.Kind() == r.String && isUntypedInteger(c) {
+			// string(rune)
+			return env.valueToType(env.untypedDefault(c), t), nil
+		}
+		return env.evalExprTo(node.Args[0], t), nil
 	}
 
 	{
@@ -285,8 +302,15 @@ func (env *Env) evalCall(node *ast.CallExpr) (r.Value, []r.Value) {
 }
 
 func (env *Env) evalFuncArgs(fun r.Value, node *ast.CallExpr) []r.Value {
-	args := env.evalExprs(node.Args)
 	funt := fun.Type()
+	if !funt.IsVariadic() && len(node.Args) == funt.NumIn() {
+		args := make([]r.Value, len(node.Args))
+		for i, arg := range node.Args {
+			args[i] = env.evalExprTo(arg, funt.In(i))
+		}
+		return args
+	}
+	args := env.evalExprs(node.Args)
 	// TODO does Go have a special case fooAcceptsMultipleArgs( barReturnsMultipleValues() ) ???
 	if !funt.IsVariadic() {
 		if len(args) != funt.NumIn() {
@@ -296,6 +320,12 @@ func (env *Env) evalFuncArgs(fun r.Value, node *ast.CallExpr) []r.Value {
 		for i, arg := range args {
 			args[i] = env.valueToType(arg, funt.In(i))
 		}
+	} else {
+		// valueToType() above already copies arrays and structs,
+		// do the same for variadic calls: defer f(args...) must not see later changes
+		for i, arg := range args {
+			args[i] = copyValue(arg)
+		}
 	}
 	return args
 }
@@ -26,6 +26,7 @@ package interpreter
 
 import (
 	"go/ast"
+	"go/token"
 	r "reflect"
 	"sort"
 	"strings"
@@ -40,6 +41,7 @@ type Env struct {
 	CallStack  *CallStack
 	iotaOffset int
 	Name, Path string
+	Decls      map[string]ast.Decl // declarations retained for the :doc command
 }
 
 type CallStack struct {
@@ -67,8 +69,14 @@ type Function struct {
 }
 
 type Macro struct {
-	Closure func(args []r.Value) (results []r.Value)
-	ArgNum  int
+	Closure  func(args []r.Value) (results []r.Value)
+	ArgNum   int
+	Hygienic bool
+	// Type is the function type of the macro, used to check the syntactic category of its arguments.
+	// If nil, arguments are not checked. If variadic, the last parameter collects all remaining arguments
+	Type r.Type
+	// Pos is the position of the macro declaration
+	Pos token.Pos
 }
 
 type PackageRef struct {
@@ -92,6 +100,7 @@ const (
 	OptDebugPanicRecover
 	OptCollectDeclarations
 	OptCollectStatements
+	OptMacroHygiene // macros declared with this option set are hygienic
 
 	cMacroExpand1 whichMacroExpand = iota
 	cMacroExpand
@@ -111,6 +120,7 @@ var optNames = map[Options]string{
 	OptDebugPanicRecover:   "?PanicRecover",
 	OptCollectDeclarations: "Declarations",
 	OptCollectStatements:   "Statements",
+	OptMacroHygiene:        "Hygiene",
 }
 
 var optValues = map[string]Options{}
@@ -166,7 +176,12 @@ var None = r.ValueOf(none) // used to indicate "no value"
 
 var one = r.ValueOf(1)
 
+var typeOfBool = r.TypeOf(false)
 var typeOfInt = r.TypeOf(int(0))
+var typeOfInt64 = r.TypeOf(int64(0))
+var typeOfUint64 = r.TypeOf(uint64(0))
+var typeOfFloat64 = r.TypeOf(float64(0))
+var typeOfComplex128 = r.TypeOf(complex128(0))
 var typeOfRune = r.TypeOf(rune(0))
 var typeOfInterface = r.TypeOf((*interface{})(nil)).Elem()
 var typeOfString = r.TypeOf("")
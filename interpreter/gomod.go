@@ -0,0 +1,371 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * gomod.go
+ *
+ *  Created on Apr 02, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/importer"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// goModule is the subset of "go list -json" module information used by the importer
+type goModule struct {
+	Path    string
+	Version string
+	Dir     string
+	GoMod   string
+	Main    bool
+	Replace *goModule
+}
+
+// goPackage is the subset of "go list -json" package information used by the importer
+type goPackage struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	Export     string
+	GoFiles    []string
+	CgoFiles   []string
+	Standard   bool
+	Module     *goModule
+}
+
+// goCommand executes "go args..." in directory dir.
+// If offline is true, modules are resolved only from the local module cache
+func goCommand(dir string, offline bool, stdout io.Writer, stderr io.Writer, args ...string) error {
+	if moddir := importVendorModule(dir); len(moddir) != 0 {
+		// resolve and build with the vendor directory of the current module
+		dir = moddir
+		args = append([]string{args[0], "-mod=vendor"}, args[1:]...)
+	}
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stdin = nil
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if offline {
+		// the module cache was already verified when it was filled,
+		// and the checksum database cannot be reached without a proxy
+		cmd.Env = append(os.Environ(), "GOPROXY=off", "GOSUMDB=off")
+	}
+	if isImportWorkspace(dir) {
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		cmd.Env = append(cmd.Env, "GOFLAGS="+workspaceGoFlags())
+	}
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("error executing \"go %s\" in directory %q: %v", strings.Join(args, " "), dir, err)
+	}
+	return nil
+}
+
+// isImportWorkspace returns true if dir contains the go.work file generated by prepareImportWorkspace()
+func isImportWorkspace(dir string) bool {
+	if len(dir) == 0 {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(dir, "go.work"))
+	return err == nil
+}
+
+// importVendorModule returns the root of the current module if dir was generated
+// by prepareImportVendor(), otherwise ""
+func importVendorModule(dir string) string {
+	if len(dir) == 0 {
+		return ""
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, vendorModuleFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+var (
+	workspaceGoFlagsCache string
+	workspaceGoFlagsKnown bool
+)
+
+// workspaceGoFlags returns $GOFLAGS without -mod=..., which is not allowed in workspace mode
+func workspaceGoFlags() string {
+	if !workspaceGoFlagsKnown {
+		var flags []string
+		for _, flag := range strings.Fields(goEnv("GOFLAGS")) {
+			if !strings.HasPrefix(flag, "-mod=") && !strings.HasPrefix(flag, "--mod=") {
+				flags = append(flags, flag)
+			}
+		}
+		workspaceGoFlagsCache, workspaceGoFlagsKnown = strings.Join(flags, " "), true
+	}
+	return workspaceGoFlagsCache
+}
+
+// goCommandPreferOffline executes "go args..." in directory dir,
+// first using only the local module cache and then, if that fails, the network
+func goCommandPreferOffline(dir string, stdout io.Writer, stderr io.Writer, args ...string) error {
+	var buf bytes.Buffer
+	if goCommand(dir, true, stdout, &buf, args...) == nil {
+		return nil
+	}
+	return goCommand(dir, false, stdout, stderr, args...)
+}
+
+// goEnv returns the value of the go environment variable 'name'
+func goEnv(name string) string {
+	var out, errbuf bytes.Buffer
+	err := goCommand("", false, &out, &errbuf, "env", name)
+	if err != nil {
+		errorf("%v\n%s", err, errbuf.Bytes())
+	}
+	return strings.TrimSpace(out.String())
+}
+
+// goList executes "go list -json args..." in directory dir and decodes its output
+func goList(dir string, args ...string) ([]goPackage, error) {
+	var out, errbuf bytes.Buffer
+	args = append([]string{"list", "-json"}, args...)
+	if err := goCommandPreferOffline(dir, &out, &errbuf, args...); err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, errbuf.Bytes())
+	}
+	var list []goPackage
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var pkg goPackage
+		if err := dec.Decode(&pkg); err != nil {
+			return nil, fmt.Errorf("error decoding the output of \"go list\": %v", err)
+		}
+		list = append(list, pkg)
+	}
+	return list, nil
+}
+
+// goModFile returns the go.mod file of the current module,
+// os.DevNull if module mode is enabled but there is no current module,
+// or "" if module mode is disabled.
+// It is computed once per session, since executing "go env" is slow
+func (ir *InterpreterCommon) goModFile() string {
+	if !ir.goModKnown {
+		ir.goMod, ir.goModKnown = goEnv("GOMOD"), true
+	}
+	return ir.goMod
+}
+
+func getGomacroCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		errorf("cannot determine gomacro cache directory: %v", err)
+	}
+	return filepath.Join(dir, "gomacro")
+}
+
+// prepareImportModule returns the directory where the plugin that imports package 'path'
+// should be generated and compiled. Such directory is in the gomacro cache directory:
+// the current module, if any, is never modified.
+//
+// Inside a module, the generated module is used together with the current one through a go.work file,
+// thus it uses the same requirements, replacements and module cache.
+// Go ignores vendor directories in workspace mode, thus a vendored module is used directly instead.
+//
+// Outside any module, if the package is checked out in $GOPATH/src, it is used through a local replace
+func (ir *InterpreterCommon) prepareImportModule(path string, gomod string) string {
+	if gomod != os.DevNull {
+		moddir := filepath.Dir(gomod)
+		if _, err := os.Stat(filepath.Join(moddir, "vendor", "modules.txt")); err == nil {
+			return ir.prepareImportVendor(path, moddir)
+		}
+		return ir.prepareImportWorkspace(path, gomod)
+	}
+	dir := filepath.Join(getGomacroCacheDir(), "imports", filepath.FromSlash(path))
+	mkdirAll(dir)
+
+	buf := bytes.Buffer{}
+	writeImportModuleHeader(&buf, path)
+	if modpath, moddir := findLocalModule(path); len(moddir) != 0 {
+		ir.debugf("using local module %q in directory %q", modpath, moddir)
+		fmt.Fprintf(&buf, "\nrequire %s v0.0.0-00010101000000-000000000000\n\nreplace %s => %s\n",
+			modpath, modpath, moddir)
+	}
+	writeFile(filepath.Join(dir, "go.mod"), buf.Bytes())
+	var errbuf bytes.Buffer
+	if err := goCommandPreferOffline(dir, ir.Stdout, &errbuf, "get", path); err != nil {
+		errorf("error resolving the module of package %q: %v\n%s", path, err, errbuf.Bytes())
+	}
+	return dir
+}
+
+// prepareImportWorkspace generates the module for package 'path' and a go.work file
+// that uses it together with the current module, whose go.mod file is gomod
+func (ir *InterpreterCommon) prepareImportWorkspace(path string, gomod string) string {
+	moddir := filepath.Dir(gomod)
+	// separate the generated modules of different current modules
+	sum := sha256.Sum256([]byte(moddir))
+	dir := filepath.Join(getGomacroCacheDir(), "imports", "module-"+hex.EncodeToString(sum[:8]), filepath.FromSlash(path))
+	mkdirAll(dir)
+
+	buf := bytes.Buffer{}
+	writeImportModuleHeader(&buf, path)
+	writeFile(filepath.Join(dir, "go.mod"), buf.Bytes())
+
+	buf.Reset()
+	writeGeneratedHeader(&buf, path)
+	// go.work must require at least the go version of the modules it uses
+	if version := goModVersion(gomod); len(version) != 0 {
+		fmt.Fprintf(&buf, "go %s\n\n", version)
+	}
+	fmt.Fprintf(&buf, "use .\nuse %s\n", moddir)
+	writeFile(filepath.Join(dir, "go.work"), buf.Bytes())
+	ir.debugf("using module %q through %q", moddir, filepath.Join(dir, "go.work"))
+	return dir
+}
+
+// vendorModuleFile is the file, generated by prepareImportVendor(), that contains the root of the current module
+const vendorModuleFile = "gomacro_vendor.txt"
+
+// prepareImportVendor returns the directory for package 'path' when the current module,
+// whose root is moddir, has a vendor directory. The directory is not a module:
+// goCommand() executes "go list" and "go build" from moddir with -mod=vendor,
+// and compilePlugin() builds the generated file by name
+func (ir *InterpreterCommon) prepareImportVendor(path string, moddir string) string {
+	sum := sha256.Sum256([]byte(moddir))
+	dir := filepath.Join(getGomacroCacheDir(), "imports", "vendor-"+hex.EncodeToString(sum[:8]), filepath.FromSlash(path))
+	mkdirAll(dir)
+	writeFile(filepath.Join(dir, vendorModuleFile), []byte(moddir+"\n"))
+	ir.debugf("using the vendor directory of module %q", moddir)
+	return dir
+}
+
+func writeGeneratedHeader(buf *bytes.Buffer, path string) {
+	fmt.Fprintf(buf, "// this file was generated by gomacro command: import %q\n", path)
+	fmt.Fprintf(buf, "// DO NOT EDIT! Any change will be lost when the file is re-generated\n\n")
+}
+
+func writeImportModuleHeader(buf *bytes.Buffer, path string) {
+	writeGeneratedHeader(buf, path)
+	fmt.Fprintf(buf, "module gomacro_imports/%s\n", path)
+}
+
+func writeFile(filename string, data []byte) {
+	if err := ioutil.WriteFile(filename, data, os.FileMode(0666)); err != nil {
+		errorf("error writing file %q: %v", filename, err)
+	}
+}
+
+// goModVersion returns the go version required by the go.mod file gomod, or "" if not specified
+func goModVersion(gomod string) string {
+	data, err := ioutil.ReadFile(gomod)
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Fields(line); len(fields) >= 2 && fields[0] == "go" {
+			return fields[1]
+		}
+	}
+	return ""
+}
+
+// findLocalModule searches a local checkout of package 'path' in $GOPATH/src.
+// If found, returns the path and directory of the module containing it
+func findLocalModule(path string) (modpath string, moddir string) {
+	srcdir := getGoSrcPath()
+	dir := filepath.Join(srcdir, filepath.FromSlash(path))
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return "", ""
+	}
+	for ; len(dir) > len(srcdir); dir = filepath.Dir(dir) {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+			continue
+		}
+		var out, errbuf bytes.Buffer
+		if goCommand(dir, true, &out, &errbuf, "list", "-m") != nil {
+			return "", ""
+		}
+		return strings.TrimSpace(out.String()), dir
+	}
+	return "", ""
+}
+
+// findGoModule searches the go.mod file in dir and its parent directories.
+// If found, returns the module path and its root directory
+func findGoModule(dir string) (modpath string, moddir string) {
+	for {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				fields := strings.Fields(line)
+				if len(fields) >= 2 && fields[0] == "module" {
+					return strings.Trim(fields[1], "\"`"), dir
+				}
+			}
+			return "", ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+}
+
+// loadPackageTypes loads the names and types of package 'path' and its dependencies,
+// as resolved by "go list" from directory dir
+func (ir *InterpreterCommon) loadPackageTypes(dir string, path string) (*types.Package, error) {
+	list, err := goList(dir, "-export", "-deps", path)
+	if err != nil {
+		return nil, err
+	}
+	exports := make(map[string]string)
+	for _, pkg := range list {
+		exports[pkg.ImportPath] = pkg.Export
+		if pkg.ImportPath == path && pkg.Module != nil {
+			ir.debugf("package %q found in module %s %s", path, pkg.Module.Path, pkg.Module.Version)
+		}
+	}
+	lookup := func(path string) (io.ReadCloser, error) {
+		if filename := exports[path]; len(filename) != 0 {
+			return os.Open(filename)
+		}
+		return nil, fmt.Errorf("no export data for package %q", path)
+	}
+	return importer.ForCompiler(ir.Fileset, "gc", lookup).Import(path)
+}
+
+func mkdirAll(dirname string) {
+	if err := os.MkdirAll(dirname, 0700); err != nil {
+		errorf("error creating directory %q: %v", dirname, err)
+	}
+}
@@ -0,0 +1,183 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * hygiene.go
+ *
+ *  Created on Apr 10, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	r "reflect"
+
+	mt "github.com/cosmos72/gomacro/token"
+)
+
+// Gensym returns a fresh identifier starting with prefix,
+// different from all identifiers returned by previous calls.
+// It contains mt.GensymMark, thus it cannot clash with identifiers written in source code
+func (ir *InterpreterCommon) Gensym(prefix string) *ast.Ident {
+	if len(prefix) == 0 {
+		prefix = "g"
+	}
+	ir.gensymCounter++
+	return &ast.Ident{Name: fmt.Sprintf("%s%c%d", prefix, mt.GensymMark, ir.gensymCounter)}
+}
+
+func funcGensym(env *Env, args []r.Value) (r.Value, []r.Value) {
+	prefix, ok := args[0].Interface().(string)
+	if !ok {
+		return env.errorf("Gensym: expecting a string prefix, found: %v <%v>", args[0], typeOf(args[0]))
+	}
+	return r.ValueOf(env.Gensym(prefix)), nil
+}
+
+// hygienicMacroClosure wraps the closure of a macro declared with OptMacroHygiene:
+// while the macro runs, quasiquote renames the identifiers bound by its templates
+func (ir *InterpreterCommon) hygienicMacroClosure(closure func([]r.Value) []r.Value) func([]r.Value) []r.Value {
+	return func(args []r.Value) []r.Value {
+		saved := ir.macroHygiene
+		ir.macroHygiene = true
+		defer func() {
+			ir.macroHygiene = saved
+		}()
+		return closure(args)
+	}
+}
+
+// hygienicRenames returns a fresh name for each identifier bound by the quasiquote template 'node':
+// variables and constants, types, functions, parameters, results and labels.
+// The result is indexed by the identifiers to rename: those binding such names, and those referencing them.
+// Field and method names, as in x.Name, struct{ Name T } and T{Name: x}, are neither, thus they are kept.
+// Code inside unquote and unquote_splice is not part of the template, so its identifiers are kept too
+func (ir *InterpreterCommon) hygienicRenames(node ast.Node) map[*ast.Ident]string {
+	names := make(map[string]string)
+	bind := func(ident *ast.Ident) {
+		if ident != nil && ident.Name != "_" {
+			if _, ok := names[ident.Name]; !ok {
+				names[ident.Name] = ir.Gensym(ident.Name).Name
+			}
+		}
+	}
+	bindFields := func(list *ast.FieldList) {
+		if list != nil {
+			for _, field := range list.List {
+				for _, ident := range field.Names {
+					bind(ident)
+				}
+			}
+		}
+	}
+	// identifiers naming fields and methods
+	members := make(map[*ast.Ident]bool)
+	addMembers := func(list *ast.FieldList) {
+		if list != nil {
+			for _, field := range list.List {
+				for _, ident := range field.Names {
+					members[ident] = true
+				}
+			}
+		}
+	}
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.UnaryExpr:
+			if node.Op == mt.UNQUOTE || node.Op == mt.UNQUOTE_SPLICE {
+				return false
+			}
+		case *ast.AssignStmt:
+			if node.Tok == token.DEFINE {
+				for _, expr := range node.Lhs {
+					if ident, ok := expr.(*ast.Ident); ok {
+						bind(ident)
+					}
+				}
+			}
+		case *ast.RangeStmt:
+			if node.Tok == token.DEFINE {
+				for _, expr := range []ast.Expr{node.Key, node.Value} {
+					if ident, ok := expr.(*ast.Ident); ok {
+						bind(ident)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for _, ident := range node.Names {
+				bind(ident)
+			}
+		case *ast.TypeSpec:
+			bind(node.Name)
+		case *ast.FuncDecl:
+			// methods do not bind their name in the enclosing scope
+			if node.Recv == nil || len(node.Recv.List) == 0 {
+				bind(node.Name)
+			} else {
+				members[node.Name] = true
+			}
+			bindFields(node.Recv)
+		case *ast.FuncType:
+			bindFields(node.Params)
+			bindFields(node.Results)
+		case *ast.LabeledStmt:
+			bind(node.Label)
+		case *ast.SelectorExpr:
+			members[node.Sel] = true
+		case *ast.StructType:
+			addMembers(node.Fields)
+		case *ast.InterfaceType:
+			addMembers(node.Methods)
+		case *ast.CompositeLit:
+			// the keys of map, slice and array literals are expressions, the others are field names
+			switch node.Type.(type) {
+			case *ast.MapType, *ast.ArrayType:
+			default:
+				for _, elt := range node.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						if ident, ok := kv.Key.(*ast.Ident); ok {
+							members[ident] = true
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+	renames := make(map[*ast.Ident]string)
+	if len(names) == 0 {
+		return renames
+	}
+	ast.Inspect(node, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.UnaryExpr:
+			if node.Op == mt.UNQUOTE || node.Op == mt.UNQUOTE_SPLICE {
+				return false
+			}
+		case *ast.Ident:
+			if name, ok := names[node.Name]; ok && !members[node] {
+				renames[node] = name
+			}
+		}
+		return true
+	})
+	return renames
+}
@@ -32,6 +32,10 @@ import (
 func (env *Env) evalIdentifier(ident *ast.Ident) r.Value {
 	value, found := env.resolveIdentifier(ident)
 	if !found {
+		if c, ok := env.resolveUntyped(ident); ok {
+			// an untyped constant that overflows its default type
+			env.untypedDefault(c)
+		}
 		env.errorf("undefined identifier: %s", ident.Name)
 	}
 	return value
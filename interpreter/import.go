@@ -26,6 +26,7 @@ package interpreter
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/constant"
@@ -34,6 +35,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"path/filepath"
 	r "reflect"
 	"strconv"
 	"strings"
@@ -84,13 +86,30 @@ func (env *Env) evalImport(node ast.Spec) (r.Value, []r.Value) {
 		var name string
 		if node.Name != nil {
 			name = node.Name.Name
+		}
+		var pkg *PackageRef
+		if dir, canonical := env.resolveLocalImport(path, node.Pos()); len(dir) != 0 {
+			if len(name) == 0 {
+				name = canonical[1+strings.LastIndexByte(canonical, '/'):]
+			}
+			pkg = env.importLocalDir(name, canonical, dir)
 		} else {
-			name = path[1+strings.LastIndexByte(path, '/'):]
+			if len(name) == 0 {
+				name = path[1+strings.LastIndexByte(path, '/'):]
+			}
+			pkg = env.ImportPackage(name, path)
 		}
-		pkg := env.ImportPackage(name, path)
 		if pkg != nil {
 			fileEnv := env.FileEnv()
-			fileEnv.defineConst(name, r.TypeOf(pkg), r.ValueOf(pkg))
+			switch name {
+			case "_":
+				// blank import: only for the side effects of importing the package,
+				// i.e. running its init() functions
+			case ".":
+				fileEnv.dotImport(pkg)
+			default:
+				fileEnv.defineConst(name, r.TypeOf(pkg), r.ValueOf(pkg))
+			}
 		}
 		return r.ValueOf(path), nil
 	default:
@@ -98,8 +117,57 @@ func (env *Env) evalImport(node ast.Spec) (r.Value, []r.Value) {
 	}
 }
 
+// dotImport adds the declarations of pkg to env, as done by import . "path"
+func (env *Env) dotImport(pkg *PackageRef) {
+	if env.Binds == nil {
+		env.Binds = make(map[string]r.Value)
+	}
+	if env.Types == nil {
+		env.Types = make(map[string]r.Type)
+	}
+	if env.Untypeds == nil {
+		env.Untypeds = make(map[string]imports.UntypedConst)
+	}
+	for name, bind := range pkg.Binds {
+		if _, exists := env.Binds[name]; exists {
+			env.warnf("redefined identifier: %v", name)
+		}
+		env.Binds[name] = bind
+	}
+	for name, t := range pkg.Types {
+		if _, exists := env.Types[name]; exists {
+			env.warnf("redefined type: %v", name)
+		}
+		env.Types[name] = t
+	}
+	if origin := importOrigins[pkg.Path]; origin != nil {
+		origin.dotImports = append(origin.dotImports, env)
+	}
+	for name, c := range pkg.Untypeds {
+		env.Untypeds[name] = c
+	}
+}
+
+// isStdlibImport returns true if path belongs to the standard library,
+// i.e. its first element does not contain a dot
+func isStdlibImport(path string) bool {
+	first := path
+	if slash := strings.IndexByte(path, '/'); slash >= 0 {
+		first = path[:slash]
+	}
+	return !strings.Contains(first, ".")
+}
+
+func isRelativeImport(path string) bool {
+	return path == "." || path == ".." || startsWith(path, "./") || startsWith(path, "../")
+}
+
 func (env *Env) sanitizeImportPath(path string) string {
 	path = strings.Replace(path, "\\", "/", -1)
+	if isRelativeImport(path) {
+		// resolved relative to the importing file, see resolveLocalImport()
+		return path
+	}
 	l := len(path)
 	if path == ".." || l >= 3 && (path[:3] == "../" || path[l-3:] == "/..") || strings.Contains(path, "/../") {
 		env.errorf("invalid import %q: contains \"..\"", path)
@@ -111,42 +179,115 @@ func (env *Env) sanitizeImportPath(path string) string {
 }
 
 func (ir *InterpreterCommon) ImportPackage(name, path string) *PackageRef {
-	if pkg, ok := imports.Packages[path]; ok {
-		return &PackageRef{Package: pkg, Name: name, Path: path}
-	}
-	pkg, err := ir.Importer.Import(path) // loads names and types, not the values!
-	if err != nil {
-		ir.errorf("error loading package %q metadata, maybe you need to download (go get), compile (go build) and install (go install) it? %v", path, err)
-		return nil
+	if pkg, ok := imports.Lookup(path); ok {
+		ref := &PackageRef{Package: pkg, Name: name, Path: path}
+		if !isStdlibImport(path) {
+			// packages with precompiled bindings may also have macro libraries
+			ir.importMacroLibrary(ref, "")
+		}
+		return ref
 	}
 	internal := name == "__"
-	filename := ir.createImportFile(path, pkg, internal)
+	gomod := ir.goModFile()
+	var dir string
+	if len(gomod) != 0 && !internal {
+		dir = ir.prepareImportModule(path, gomod)
+	}
+	if !internal && !pluginSupported {
+		return ir.importSourcePackage(name, path, dir)
+	}
+	var cached *pluginCacheEntry
+	if !internal {
+		cached = ir.newPluginCacheEntry(dir, path)
+		if soname := cached.lookup(); len(soname) != 0 {
+			ir.debugf("loading cached plugin %q ...", soname)
+			ref := ir.loadImportPlugin(name, path, soname)
+			ir.importMacroLibrary(ref, packageDir(path, dir))
+			recordImport(path, importPlugin, dir, cached.Key, ref.Package)
+			return ref
+		}
+	}
+	pkg := ir.loadImportTypes(gomod, dir, path)
+	filename := ir.createImportFile(path, pkg, dir, internal)
 	if internal {
 		return nil
 	}
-	if len(filename) == 0 {
-		// empty package
-		return &PackageRef{Name: name, Path: path}
+	ref, rec := ir.compileImportPlugin(name, path, filename, "", cached)
+	if rec != nil {
+		ir.warnf("cannot import package %q as a plugin, interpreting its source code instead: %v", path, rec)
+		return ir.importSourcePackage(name, path, dir)
 	}
+	ir.importMacroLibrary(ref, packageDir(path, dir))
+	recordImport(path, importPlugin, dir, cached.Key, ref.Package)
+	return ref
+}
 
-	soname := ir.compilePlugin(filename, ir.Stdout, ir.Stderr)
+// compileImportPlugin compiles and loads the plugin for package 'path', and stores it in cached if not nil.
+// Failures are returned instead of panicking, so the caller can fall back on importSourcePackage()
+func (ir *InterpreterCommon) compileImportPlugin(name, path, filename, pluginpath string, cached *pluginCacheEntry) (ref *PackageRef, rec interface{}) {
+	defer func() {
+		if rec == nil {
+			rec = recover()
+		}
+	}()
+	soname := ir.compilePlugin(filename, pluginpath, ir.Stdout, ir.Stderr)
+	if cached != nil {
+		if cachedname, err := cached.store(soname); err != nil {
+			ir.warnf("error storing plugin %q in cache: %v", soname, err)
+		} else {
+			soname = cachedname
+		}
+	}
+	return ir.loadImportPlugin(name, path, soname), nil
+}
+
+func (ir *InterpreterCommon) loadImportPlugin(name, path, soname string) *PackageRef {
 	ifun := loadPlugin(soname, "Exports")
-	fun := ifun.(func() (map[string]r.Value, map[string]r.Type, map[string]r.Type))
-	binds, types, proxies := fun()
+	fun := ifun.(func() (map[string]r.Value, map[string]r.Type, map[string]r.Type, map[string][2]string))
+	binds, types, proxies, exacts := fun()
+	untypeds := make(map[string]imports.UntypedConst, len(exacts))
+	for name, exact := range exacts {
+		untypeds[name] = imports.MakeUntypedConst(kindByName[exact[0]], exact[1])
+	}
 	return &PackageRef{
-		Package: imports.Package{Binds: binds, Types: types, Proxies: proxies},
+		Package: imports.Package{Binds: binds, Types: types, Proxies: proxies, Untypeds: untypeds},
 		Name:    name, Path: path}
 }
 
-func (ir *InterpreterCommon) createImportFile(path string, pkg *types.Package, internal bool) string {
+// loadImportTypes loads the names and types of package 'path', not the values
+func (ir *InterpreterCommon) loadImportTypes(gomod, dir, path string) *types.Package {
+	var pkg *types.Package
+	var err error
+	if len(gomod) == 0 {
+		// GOPATH mode
+		pkg, err = ir.Importer.Import(path)
+	} else {
+		pkg, err = ir.loadPackageTypes(dir, path)
+	}
+	if err != nil {
+		ir.errorf("error loading package %q metadata, maybe you need to download (go get), compile (go build) and install (go install) it? %v", path, err)
+	}
+	return pkg
+}
+
+func (ir *InterpreterCommon) createImportFile(path string, pkg *types.Package, dir string, internal bool) string {
 	buf := bytes.Buffer{}
-	isEmpty := ir.writeImportFile(&buf, path, pkg, internal)
+	var dst *importFile
+	if internal {
+		dst = &importFile{pkgName: "imports"}
+	}
+	isEmpty := ir.writeImportFile(&buf, path, pkg, dst)
 	if isEmpty {
 		ir.warnf("package %q exports zero constants, functions, types and variables", path)
-		return ""
+		if internal {
+			return ""
+		}
+		// still load the package, for the side effects of its init() functions
+		buf.Reset()
+		writeEmptyImportPlugin(&buf, path)
 	}
 
-	filename := computeImportFilename(path, internal)
+	filename := computeImportFilename(path, dir, internal)
 	err := ioutil.WriteFile(filename, buf.Bytes(), os.FileMode(0666))
 	if err != nil {
 		errorf("error writing file %q: %v", filename, err)
@@ -175,10 +316,16 @@ func sanitizeIdentifier2(str string, replacement rune) string {
 	return string(runes)
 }
 
-func computeImportFilename(path string, internal bool) string {
+// computeImportFilename returns the name of the file to generate for importing package 'path'.
+// If dir is not empty, the file is created inside it. Otherwise it is created
+// inside $GOPATH/src/gomacro_imports, as needed by GOPATH mode
+func computeImportFilename(path string, dir string, internal bool) string {
 	if internal {
 		return fmt.Sprintf("imports/%s.go", sanitizeIdentifier(path))
 	}
+	if len(dir) != 0 {
+		return filepath.Join(dir, path[1+strings.LastIndexByte(path, '/'):]+".go")
+	}
 
 	srcdirname := getGoSrcPath()
 
@@ -192,13 +339,58 @@ func computeImportFilename(path string, internal bool) string {
 	return filename
 }
 
-func (ir *InterpreterCommon) writeImportFile(out *bytes.Buffer, path string, pkg *types.Package, internal bool) (isEmpty bool) {
+// GenImportFile writes into directory dir a Go source file, belonging to package pkgName,
+// that registers the declarations of package 'path' with imports.Register().
+// Compiling such file into gomacro makes 'path' importable without plugins.
+// Exported declarations can differ across platforms, thus the file is restricted
+// to the current GOOS and GOARCH both by its name and by a build constraint
+func (ir *InterpreterCommon) GenImportFile(dir, pkgName, path string) (filename string, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			switch rec := rec.(type) {
+			case error:
+				err = rec
+			default:
+				err = errors.New(fmt.Sprint(rec))
+			}
+		}
+	}()
+	goos, goarch := goEnv("GOOS"), goEnv("GOARCH")
+	pkg := ir.loadImportTypes(ir.goModFile(), "", path)
+
+	buf := bytes.Buffer{}
+	dst := &importFile{
+		pkgName:  pkgName,
+		buildTag: fmt.Sprintf("%s && %s", goos, goarch),
+	}
+	if ir.writeImportFile(&buf, path, pkg, dst) {
+		ir.warnf("package %q exports zero constants, functions, types and variables", path)
+		return "", nil
+	}
+	filename = filepath.Join(dir, fmt.Sprintf("%s_%s_%s.go", sanitizeIdentifier(path), goos, goarch))
+	err = ioutil.WriteFile(filename, buf.Bytes(), os.FileMode(0666))
+	return filename, err
+}
+
+// importFile describes a generated import file that is compiled into gomacro
+// or into a custom interpreter, as opposed to a plugin
+type importFile struct {
+	pkgName  string // package of the generated file
+	buildTag string // optional build constraint
+}
+
+// writeImportFile writes the bindings of package 'path'.
+// If dst is nil, they are written as a plugin exporting them from func Exports().
+// Otherwise they are written as an init() function that registers them with imports.Register()
+func (ir *InterpreterCommon) writeImportFile(out *bytes.Buffer, path string, pkg *types.Package, dst *importFile) (isEmpty bool) {
+	internal := dst != nil
+
 	scope := pkg.Scope()
 	names := scope.Names()
 
 	isEmpty = true
 	for _, name := range names {
-		if obj := scope.Lookup(name); obj.Exported() {
+		if obj := scope.Lookup(name); isExportable(obj) {
 			switch obj.(type) {
 			case *types.Const, *types.Var, *types.Func, *types.TypeName:
 				isEmpty = false
@@ -219,29 +411,40 @@ func (ir *InterpreterCommon) writeImportFile(out *bytes.Buffer, path string, pkg
 	}
 
 	var thisPkgName = "main"
+	var qualifier string // prefix for imports.Package and imports.Register
 	if internal {
-		thisPkgName = "imports"
+		thisPkgName = dst.pkgName
+		if thisPkgName != "imports" {
+			qualifier = "imports."
+		}
 	}
 
 	fmt.Fprintf(out, `// this file was generated by gomacro command: import %q
 // DO NOT EDIT! Any change will be lost when the file is re-generated
-
+`, path)
+	if internal {
+		writeBuildConstraint(out, path, dst)
+	}
+	fmt.Fprintf(out, `
 package %s
 
 import (
-	. "reflect"`, path, thisPkgName)
+	. "reflect"`, thisPkgName)
 
 	for _, str := range ir.collectPackageImports(pkg, true) {
 		fmt.Fprintf(out, "\n\t%q", str)
 	}
+	if len(qualifier) != 0 {
+		fmt.Fprint(out, "\n\n\t\"github.com/cosmos72/gomacro/imports\"")
+	}
 
 	if internal {
 		fmt.Fprintf(out, `
 )
 
 func init() {
-	Packages[%q] = Package{
-	Binds: map[string]Value{`, path)
+	%sRegister(%q, func() %sPackage { return %sPackage{
+	Binds: map[string]Value{`, qualifier, path, qualifier, qualifier)
 	} else {
 		fmt.Fprint(out, `
 )
@@ -249,12 +452,12 @@ func init() {
 func main() {
 }
 
-func Exports() (map[string]Value, map[string]Type, map[string]Type) {
+func Exports() (map[string]Value, map[string]Type, map[string]Type, map[string][2]string) {
 	return map[string]Value{`)
 	}
 
 	for _, name := range names {
-		if obj := scope.Lookup(name); obj.Exported() {
+		if obj := scope.Lookup(name); isExportable(obj) {
 			switch obj := obj.(type) {
 			case *types.Const:
 				val := obj.Val()
@@ -279,7 +482,7 @@ func Exports() (map[string]Value, map[string]Type, map[string]Type) {
 	}
 
 	for _, name := range names {
-		if obj := scope.Lookup(name); obj.Exported() {
+		if obj := scope.Lookup(name); isExportable(obj) {
 			switch obj.(type) {
 			case *types.TypeName:
 				fmt.Fprintf(out, "\n\t\t%q:\tTypeOf((*%s.%s)(nil)).Elem(),", name, pkgName, name)
@@ -301,8 +504,14 @@ func Exports() (map[string]Value, map[string]Type, map[string]Type) {
 	}
 
 	if internal {
-		fmt.Fprint(out, "\n\t} }\n}\n")
+		if hasUntypedConsts(scope) {
+			fmt.Fprintf(out, "\n\t},\n\tUntypeds: map[string]%sUntypedConst{", qualifier)
+			writeUntypedConsts(out, scope, qualifier+"MakeUntypedConst(%s, %q)")
+		}
+		fmt.Fprint(out, "\n\t} } })\n}\n")
 	} else {
+		fmt.Fprint(out, "\n\t}, map[string][2]string{")
+		writeUntypedConsts(out, scope, "{%q, %q}")
 		fmt.Fprint(out, "\n\t}\n}\n")
 	}
 
@@ -315,6 +524,111 @@ func Exports() (map[string]Value, map[string]Type, map[string]Type) {
 	return isEmpty
 }
 
+// kindByName maps the capitalized kind names written by writeUntypedConsts() to their kinds
+var kindByName = map[string]r.Kind{
+	"Bool": r.Bool, "Int": r.Int, "Int32": r.Int32,
+	"Float64": r.Float64, "Complex128": r.Complex128, "String": r.String,
+}
+
+// slimBuildTag returns the build tag that selects package 'path'
+// in slim gomacro builds, i.e. builds with the tag gomacro_slim.
+// For example, "go build -tags 'gomacro_slim gomacro_fmt gomacro_math_rand'"
+// builds a gomacro that can only import "fmt" and "math/rand" without plugins
+func slimBuildTag(path string) string {
+	return "gomacro_" + sanitizeIdentifier(path)
+}
+
+// writeBuildConstraint writes the build constraint of a generated import file.
+// Files of package imports can be excluded with the build tag gomacro_slim, see slimBuildTag()
+func writeBuildConstraint(out *bytes.Buffer, path string, dst *importFile) {
+	var exprs, lines []string
+	if dst.pkgName == "imports" {
+		tag := slimBuildTag(path)
+		exprs = append(exprs, "!gomacro_slim || "+tag)
+		lines = append(lines, "!gomacro_slim "+tag)
+	}
+	if len(dst.buildTag) != 0 {
+		exprs = append(exprs, dst.buildTag)
+		lines = append(lines, strings.Replace(dst.buildTag, " && ", ",", -1))
+	}
+	if len(exprs) == 0 {
+		return
+	} else if len(exprs) > 1 {
+		exprs[0] = "(" + exprs[0] + ")"
+	}
+	fmt.Fprintf(out, "\n//go:build %s\n", strings.Join(exprs, " && "))
+	for _, line := range lines {
+		fmt.Fprintf(out, "// +build %s\n", line)
+	}
+}
+
+// untypedKind returns the default kind of an untyped constant type, or r.Invalid if t is not untyped
+func untypedKind(t types.Type) r.Kind {
+	if basic, ok := t.(*types.Basic); ok {
+		switch basic.Kind() {
+		case types.UntypedBool:
+			return r.Bool
+		case types.UntypedInt:
+			return r.Int
+		case types.UntypedRune:
+			return r.Int32
+		case types.UntypedFloat:
+			return r.Float64
+		case types.UntypedComplex:
+			return r.Complex128
+		case types.UntypedString:
+			return r.String
+		}
+	}
+	return r.Invalid
+}
+
+func hasUntypedConsts(scope *types.Scope) bool {
+	for _, name := range scope.Names() {
+		if obj, ok := scope.Lookup(name).(*types.Const); ok && obj.Exported() && untypedKind(obj.Type()) != r.Invalid {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUntypedConsts writes the exact value and default kind of the untyped constants in scope.
+// format receives the capitalized kind name, as "Float64", and the exact value
+func writeUntypedConsts(out *bytes.Buffer, scope *types.Scope, format string) {
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !obj.Exported() {
+			continue
+		}
+		if kind := untypedKind(obj.Type()); kind != r.Invalid {
+			kindName := kind.String()
+			kindName = strings.ToUpper(kindName[:1]) + kindName[1:]
+			fmt.Fprintf(out, "\n\t\t%q:\t"+format+",", name, kindName, obj.Val().ExactString())
+		}
+	}
+}
+
+// writeEmptyImportPlugin writes a plugin that imports package 'path' without exporting anything
+func writeEmptyImportPlugin(out *bytes.Buffer, path string) {
+	fmt.Fprintf(out, `// this file was generated by gomacro command: import %q
+// DO NOT EDIT! Any change will be lost when the file is re-generated
+
+package main
+
+import (
+	. "reflect"
+	_ %q
+)
+
+func main() {
+}
+
+func Exports() (map[string]Value, map[string]Type, map[string]Type, map[string][2]string) {
+	return map[string]Value{}, map[string]Type{}, map[string]Type{}, map[string][2]string{}
+}
+`, path, path)
+}
+
 func (ir *InterpreterCommon) detectIntKind(path, name, str string) (string, string) {
 	i, err := strconv.ParseInt(str, 0, 64)
 	if err == nil {
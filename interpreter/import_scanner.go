@@ -166,8 +166,27 @@ func (ie *importExtractor) visitType(name string, t types.Type) bool {
 	}
 }
 
-func extractInterface(obj types.Object, requireAllMethodsExported bool) *types.Interface {
+// isExportable returns true if obj is exported and can be bound with reflect,
+// i.e. it is not a generic function or type
+func isExportable(obj types.Object) bool {
 	if obj == nil || !obj.Exported() {
+		return false
+	}
+	switch obj := obj.(type) {
+	case *types.Func:
+		if sig, ok := obj.Type().(*types.Signature); ok && sig.TypeParams().Len() != 0 {
+			return false
+		}
+	case *types.TypeName:
+		if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func extractInterface(obj types.Object, requireAllMethodsExported bool) *types.Interface {
+	if !isExportable(obj) {
 		return nil
 	}
 	switch obj.(type) {
@@ -0,0 +1,267 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * import_source.go
+ *
+ *  Created on Apr 05, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos72/gomacro/imports"
+)
+
+// importSourcePackage is the fallback importer, used when plugins are not available:
+// it interprets the source code of package 'path', as resolved by "go list" from directory dir
+func (ir *InterpreterCommon) importSourcePackage(name, path, dir string) *PackageRef {
+	list, err := goList(dir, path)
+	if err != nil {
+		ir.errorf("error loading package %q: %v", path, err)
+		return nil
+	}
+	pkg := list[0]
+	if len(pkg.CgoFiles) != 0 {
+		ir.errorf("cannot interpret package %q: it uses cgo", path)
+		return nil
+	}
+	// "go list" already applied build constraints and excluded tests
+	filenames := make([]string, len(pkg.GoFiles))
+	for i, file := range pkg.GoFiles {
+		filenames[i] = filepath.Join(pkg.Dir, file)
+	}
+	ir.debugf("interpreting package %q from directory %q ...", path, pkg.Dir)
+	ref := ir.importSourceFiles(name, path, filenames)
+	ir.importMacroLibrary(ref, pkg.Dir)
+	recordImport(path, importSource, dir, "", ref.Package)
+	return ref
+}
+
+// importSourceFiles evaluates the given files as package 'path' in a fresh Env,
+// and registers its exported declarations in imports.Packages
+func (ir *InterpreterCommon) importSourceFiles(name, path string, filenames []string) *PackageRef {
+	files := make([]*ast.File, len(filenames))
+	for i, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			ir.errorf("error reading file %q: %v", filename, err)
+			return nil
+		}
+		files[i] = ir.ParseFile(filename, src)
+	}
+	env := ir.newPackageEnv(path)
+	env.evalPackage(files)
+	return env.exportPackage(name, path)
+}
+
+// packages being imported by importLocalDir(), used to detect import cycles
+var importingLocalDirs = make(map[string]bool)
+
+// importLocalDir interprets the package in directory dir as 'path'.
+// As Cmd.EvalDir(), it evaluates the *.gomacro files if present, otherwise the *.go files
+func (ir *InterpreterCommon) importLocalDir(name, path, dir string) *PackageRef {
+	if pkg, ok := imports.Lookup(path); ok {
+		// imports.Packages does not contain the macro libraries, they are bound to each interpreter
+		ref := &PackageRef{Package: pkg, Name: name, Path: path}
+		ir.importMacroLibrary(ref, dir)
+		return ref
+	}
+	if importingLocalDirs[path] {
+		ir.errorf("import cycle not allowed: %q", path)
+		return nil
+	}
+	importingLocalDirs[path] = true
+	defer delete(importingLocalDirs, path)
+
+	gomacrofiles, gofiles, err := listDirSources(dir)
+	if err != nil {
+		ir.errorf("error importing %q: %v", path, err)
+		return nil
+	}
+	if len(gomacrofiles) == 0 && len(gofiles) == 0 && len(macroLibraryFiles(dir)) == 0 {
+		ir.errorf("error importing %q: no *.go or *.gomacro files in directory %q", path, dir)
+	}
+	var ref *PackageRef
+	if len(gomacrofiles) == 0 {
+		ref = ir.importSourceFiles(name, path, gofiles)
+	} else {
+		env := ir.newPackageEnv(path)
+		for _, filename := range gomacrofiles {
+			env.evalScriptFile(filename)
+		}
+		ref = env.exportPackage(name, path)
+	}
+	ir.importMacroLibrary(ref, dir)
+	recordImport(path, importLocalDir, dir, "", ref.Package)
+	return ref
+}
+
+// evalScriptFile evaluates a *.gomacro file top to bottom, as if typed at the REPL.
+// Package clauses only set the package name
+func (env *Env) evalScriptFile(filename string) {
+	env.forEachScriptChunk(filename, func(str string) {
+		if str == "package" || startsWith(str, "package ") {
+			env.Packagename = strings.TrimSpace(str[len("package"):])
+			return
+		}
+		mark := env.expansionMark()
+		form := env.ParseAst(str)
+		defer env.pruneExpansions(mark, form)
+		env.EvalAst(form)
+	})
+}
+
+// forEachScriptChunk reads a *.gomacro file and invokes visit on each top-level
+// declaration or statement, as ReadMultiline() splits them, with env.Filename set to filename
+func (env *Env) forEachScriptChunk(filename string, visit func(str string)) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		env.errorf("error reading file %q: %v", filename, err)
+	}
+	env.forEachSourceChunk(filename, src, visit)
+}
+
+// forEachSourceChunk is the same as forEachScriptChunk, for source code already in memory
+func (env *Env) forEachSourceChunk(filename string, src []byte, visit func(str string)) {
+	saveFilename, saveSource := env.Filename, env.source
+	env.Filename, env.source = filename, sourcePos{}
+	defer func() {
+		env.Filename, env.source = saveFilename, saveSource
+	}()
+	// ReadMultiline() needs a final '\n'
+	in := bufio.NewReader(bytes.NewReader(append(src, '\n')))
+	for {
+		str, err := ReadMultiline(in, false, env.Stdout, "")
+		if err != nil {
+			if err != io.EOF {
+				env.errorf("error reading file %q: %v", filename, err)
+			}
+			return
+		}
+		env.source.next(str)
+		visit(strings.TrimSpace(str))
+	}
+}
+
+// exportPackage registers the exported declarations of env in imports.Packages
+func (env *Env) exportPackage(name, path string) *PackageRef {
+	pkg := exportedPackage(env.Package)
+	imports.Packages[path] = pkg
+	return &PackageRef{Package: pkg, Name: name, Path: path}
+}
+
+// resolveLocalImport resolves the imports of interpreted local directories:
+// relative paths as "./util" or "../lib", resolved relative to the importing file,
+// and paths inside the module containing the importing file.
+// Returns the directory and the canonical import path, or "", "" for other imports
+func (env *Env) resolveLocalImport(path string, pos token.Pos) (dir string, canonical string) {
+	relative := isRelativeImport(path)
+	if !relative {
+		if _, ok := imports.Lookup(path); ok {
+			return "", ""
+		}
+	}
+	importer := env.Filename
+	if pos.IsValid() && env.Fileset != nil {
+		importer = env.Fileset.Position(pos).Filename
+	}
+	fromdir, err := filepath.Abs(filepath.Dir(importer))
+	if err != nil {
+		return "", ""
+	}
+	modpath, moddir := findGoModule(fromdir)
+	if relative {
+		dir = filepath.Join(fromdir, filepath.FromSlash(path))
+	} else if len(modpath) != 0 && (path == modpath || startsWith(path, modpath+"/")) {
+		dir = filepath.Join(moddir, filepath.FromSlash(path[len(modpath):]))
+	} else {
+		return "", ""
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		if relative {
+			env.errorf("cannot import %q: directory %q not found", path, dir)
+		}
+		return "", ""
+	}
+	canonical = filepath.ToSlash(dir)
+	if len(modpath) != 0 {
+		if rel, err := filepath.Rel(moddir, dir); err == nil {
+			rel = filepath.ToSlash(rel)
+			if rel == "." {
+				canonical = modpath
+			} else if rel != ".." && !startsWith(rel, "../") {
+				canonical = modpath + "/" + rel
+			}
+		}
+	}
+	return dir, canonical
+}
+
+// newPackageEnv creates an Env for interpreting package 'path' in isolation:
+// it shares output and options with ir, but not the declarations nor the package name
+func (ir *InterpreterCommon) newPackageEnv(path string) *Env {
+	top := NewEnv(nil, "builtin")
+	common := top.InterpreterCommon
+	common.output = ir.output
+	common.Options = ir.Options &^ (OptCollectDeclarations | OptCollectStatements)
+	common.Importer = ir.Importer
+	common.ParserMode = ir.ParserMode
+	common.SpecialChar = ir.SpecialChar
+
+	env := NewEnv(top, path)
+	env.Package.Init()
+	return env
+}
+
+// exportedPackage returns the exported declarations of pkg
+func exportedPackage(pkg imports.Package) imports.Package {
+	var exported imports.Package
+	exported.Init()
+	for k, v := range pkg.Binds {
+		if ast.IsExported(k) {
+			exported.Binds[k] = v
+		}
+	}
+	for k, t := range pkg.Types {
+		if ast.IsExported(k) {
+			exported.Types[k] = t
+		}
+	}
+	for k, t := range pkg.Proxies {
+		if ast.IsExported(k) {
+			exported.Proxies[k] = t
+		}
+	}
+	for k, c := range pkg.Untypeds {
+		if ast.IsExported(k) {
+			exported.Untypeds[k] = c
+		}
+	}
+	return exported
+}
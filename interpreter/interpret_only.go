@@ -0,0 +1,97 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * interpret_only.go
+ *
+ *  Created on Apr 13, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"go/ast"
+	r "reflect"
+
+	. "github.com/cosmos72/gomacro/ast2"
+	mt "github.com/cosmos72/gomacro/token"
+)
+
+// interpret_only { /*block*/ } contains code that runs only when interpreted or macroexpanded:
+// helper functions, macro support code, configuration.
+// The collected declarations and statements written by -w do not include it,
+// so a single file can mix generator logic with the code it emits.
+// Unlike normal blocks, it can contain imports, functions and macros,
+// and its declarations are visible after it: it does not create a new scope
+func (env *Env) evalInterpretOnly(node *ast.UnaryExpr) (r.Value, []r.Value) {
+	block := node.X.(*ast.FuncLit).Body
+	return env.evalStatements(block.List)
+}
+
+// isInterpretOnly returns true if node is interpret_only { /*block*/ },
+// possibly wrapped in an expression statement
+func isInterpretOnly(node ast.Node) bool {
+	if stmt, ok := node.(*ast.ExprStmt); ok {
+		node = stmt.X
+	}
+	expr, ok := node.(*ast.UnaryExpr)
+	return ok && expr.Op == mt.INTERPRET_ONLY
+}
+
+// hasInterpretOnly returns true if node contains interpret_only { /*block*/ }
+func hasInterpretOnly(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(node ast.Node) bool {
+		found = found || isInterpretOnly(node)
+		return !found
+	})
+	return found
+}
+
+// toPrintableWithoutInterpretOnly prints node without the interpret_only blocks it contains
+func (ir *InterpreterCommon) toPrintableWithoutInterpretOnly(node ast.Node) interface{} {
+	if hasInterpretOnly(node) {
+		return ir.toPrintable(withoutInterpretOnly(ToAst(node)))
+	}
+	return ir.toPrintable(node)
+}
+
+// withoutInterpretOnly returns a copy of form where the interpret_only blocks
+// inside statement lists are removed
+func withoutInterpretOnly(form Ast) Ast {
+	if form == nil || form.Size() == 0 {
+		return form
+	}
+	n := form.Size()
+	if slice, ok := form.(AstWithSlice); ok {
+		out := slice.New().(AstWithSlice)
+		for i := 0; i < n; i++ {
+			child := slice.Get(i)
+			if child, ok := child.(AstWithNode); ok && isInterpretOnly(child.Node()) {
+				continue
+			}
+			out = out.Append(withoutInterpretOnly(child))
+		}
+		return out
+	}
+	out := form.New()
+	for i := 0; i < n; i++ {
+		out.Set(i, withoutInterpretOnly(form.Get(i)))
+	}
+	return out
+}
@@ -30,6 +30,7 @@ import (
 	"go/token"
 	"io"
 	"os"
+	r "reflect"
 
 	. "github.com/cosmos72/gomacro/ast2"
 	mp "github.com/cosmos72/gomacro/parser"
@@ -41,11 +42,27 @@ type InterpreterCommon struct {
 	Importer     Importer
 	Packagename  string
 	Filename     string
+	source       sourcePos // position in Filename of the source being parsed
+	docComments  string    // comment-only lines read at the prompt, see ReadParseEvalPrint()
 	Imports      []*ast.GenDecl
 	Declarations []ast.Decl
 	Statements   []ast.Stmt
+	Macros       []ast.Node // macro declarations and interpret_only blocks, written to macro libraries
 	ParserMode   mp.Mode
 	SpecialChar  rune
+
+	goMod          string // see goModFile()
+	goModKnown     bool
+	gensymCounter  int
+	macroHygiene   bool                          // true while a hygienic macro is running
+	hygieneRenames map[*ast.Ident]string         // identifiers renamed by the quasiquote being evaluated
+	expansions     map[ast.Node]*macroExpansion  // provenance of the nodes generated by macros
+	expansionLog   []ast.Node                    // nodes added to expansions, see pruneExpansions()
+	panicValue     interface{}                   // panic raised by code generated by a macro...
+	panicExpansion *macroExpansion               // ...and the macro expansion that generated such code
+	macroStepping  bool                          // true while :expand and similar commands run
+	macroStep      *macroStep                    // macro call expanded by the current step
+	macroLibraries map[string]map[string]r.Value // exported macros of the imported macro libraries, by import path
 }
 
 func NewInterpreterCommon() *InterpreterCommon {
@@ -61,6 +78,7 @@ func NewInterpreterCommon() *InterpreterCommon {
 		Importer:    DefaultImporter(),
 		Packagename: "main",
 		Filename:    "main.go",
+		ParserMode:  mp.ParseComments, // needed by :doc
 		SpecialChar: '~',
 	}
 }
@@ -71,6 +89,7 @@ func (ir *InterpreterCommon) ParseBytes(src []byte) []ast.Node {
 	parser.Fileset = ir.Fileset
 	parser.Mode = mp.Mode(ir.ParserMode)
 	parser.SpecialChar = ir.SpecialChar
+	parser.Line, parser.Column = ir.source.line, ir.source.column
 
 	parser.Init(ir.Filename, src)
 
@@ -82,6 +101,31 @@ func (ir *InterpreterCommon) ParseBytes(src []byte) []ast.Node {
 	return nodes
 }
 
+// ParseFile parses a whole Go source file, i.e. a package clause followed by declarations.
+// No macroexpansion is performed
+func (ir *InterpreterCommon) ParseFile(filename string, src []byte) *ast.File {
+	var parser mp.Parser
+
+	parser.Fileset = ir.Fileset
+	parser.Mode = mp.Mode(ir.ParserMode)
+	parser.SpecialChar = ir.SpecialChar
+
+	parser.Init(filename, src)
+
+	nodes, err := parser.Parse()
+	if err != nil {
+		error_(err)
+		return nil
+	}
+	if len(nodes) == 1 {
+		if file, ok := nodes[0].(*ast.File); ok {
+			return file
+		}
+	}
+	errorf("%s: expecting a package clause followed by declarations", filename)
+	return nil
+}
+
 // collectAst accumulates declarations in ir.Decls and statements in ir.Stmts
 // allows generating a *.go file on user request
 func (ir *InterpreterCommon) collectAst(form Ast) {
@@ -104,6 +148,14 @@ func (ir *InterpreterCommon) collectNode(node ast.Node) {
 	collectDecl := ir.Options&OptCollectDeclarations != 0
 	collectStmt := ir.Options&OptCollectStatements != 0
 
+	if isInterpretOnly(node) {
+		// not written to *.go files. it may contain macro support code:
+		// write it to the macro library, together with the macros
+		if collectDecl {
+			ir.Macros = append(ir.Macros, node)
+		}
+		return
+	}
 	switch node := node.(type) {
 	case *ast.GenDecl:
 		if collectDecl {
@@ -117,8 +169,11 @@ func (ir *InterpreterCommon) collectNode(node ast.Node) {
 		if collectDecl {
 			if node.Recv == nil || len(node.Recv.List) != 0 {
 				// function or method declaration.
-				// skip macro declarations, Go compilers would choke on them
 				ir.Declarations = append(ir.Declarations, node)
+			} else {
+				// macro declaration: Go compilers would choke on it,
+				// it is written to a separate macro library file
+				ir.Macros = append(ir.Macros, node)
 			}
 		}
 	case ast.Decl:
@@ -186,10 +241,10 @@ func (ir *InterpreterCommon) writeDeclsToStream(out io.Writer) {
 		fmt.Fprintln(out, ir.toPrintable(imp))
 	}
 	if len(ir.Imports) != 0 {
-		fmt.Println()
+		fmt.Fprintln(out)
 	}
 	for _, decl := range ir.Declarations {
-		fmt.Fprintln(out, ir.toPrintable(decl))
+		fmt.Fprintln(out, ir.toPrintableWithoutInterpretOnly(decl))
 	}
 	if len(ir.Statements) != 0 {
 		fmt.Fprint(out, "\nfunc init() {\n")
@@ -198,7 +253,7 @@ func (ir *InterpreterCommon) writeDeclsToStream(out io.Writer) {
 			config.Indent = 0
 		}()
 		for _, stmt := range ir.Statements {
-			fmt.Fprintln(out, ir.toPrintable(stmt))
+			fmt.Fprintln(out, ir.toPrintableWithoutInterpretOnly(stmt))
 		}
 		fmt.Fprint(out, "}\n")
 	}
@@ -24,12 +24,22 @@
 package interpreter
 
 import (
+	"bufio"
+	"bytes"
+	"fmt"
 	"go/ast"
+	"go/build"
 	"go/token"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
 	r "reflect"
+	"strings"
 	"testing"
 
 	. "github.com/cosmos72/gomacro/ast2"
+	"github.com/cosmos72/gomacro/imports"
 )
 
 type TestCase struct {
@@ -49,6 +59,865 @@ func TestInterpreter(t *testing.T) {
 	}
 }
 
+func TestRangeFuncCompiled(t *testing.T) {
+	env := New()
+	env.defineFunc("compiledSeq", nil, r.ValueOf(func(yield func(string, int) bool) {
+		for i, s := range []string{"a", "b", "c"} {
+			if !yield(s, i) {
+				return
+			}
+		}
+	}))
+	c := TestCase{"range_func_compiled", `func joinUntil(stop string) string {
+			ret := ""
+			for s, i := range compiledSeq {
+				if s == stop || i > 5 {
+					return ret
+				}
+				ret += s
+			}
+			return "none"
+		}
+		Values(joinUntil("c"), joinUntil("z"))`, nil, []interface{}{"ab", "none"}}
+	c.run(t, env)
+}
+
+func TestPackageInit(t *testing.T) {
+	env := New()
+	file := env.ParseFile("package_init.go", []byte(`package main
+		var pa = pb + 1
+		var pb = pf()
+		func pf() int { return pc * 2 }
+		var pc = PN * 10
+		const PN = PM + 1
+		const PM = 1
+		var pinit []int
+		func init() { pinit = append(pinit, pa) }
+		func init() { pinit = append(pinit, pb) }
+		func main() { pinit = append(pinit, -1) }`))
+	env.Eval(file)
+
+	c := TestCase{"package_init", "Values(pa, pb, pc, pinit)", nil, []interface{}{41, 40, 20, []int{41, 40, -1}}}
+	c.run(t, env)
+}
+
+// the files of a package can import the same packages
+func TestPackageInitFiles(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"a.go": "package main\nimport \"fmt\"\nvar pa = fmt.Sprint(pb, 1)\n",
+		"b.go": "package main\nimport (\n\t\"fmt\"\n\t\"strings\"\n)\nvar pb = strings.ToUpper(fmt.Sprint(\"b\"))\n",
+	}
+	for name, src := range srcs {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	var buf bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &buf, &buf
+	if err := cmd.EvalFileOrDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expecting no warnings, found %q", buf.String())
+	}
+	c := TestCase{"package_init_files", "pa", "B1", nil}
+	c.run(t, cmd.Env)
+}
+
+func TestImportLazy(t *testing.T) {
+	const path = "container/ring"
+	if _, ok := imports.Packages[path]; ok {
+		t.Fatalf("package %q should be registered lazily", path)
+	}
+	env := New()
+	c := TestCase{"import_lazy", "import \"container/ring\"; ring.New(3).Len()", 3, nil}
+	c.run(t, env)
+	if _, ok := imports.Packages[path]; !ok {
+		t.Errorf("package %q should be initialized after its first import", path)
+	}
+}
+
+func TestDoc(t *testing.T) {
+	env := New()
+	var buf bytes.Buffer
+	env.Stdout = &buf
+	env.ParseEvalPrint("// Twice doubles n.\nfunc Twice(n int) int { return n * 2 }", nil)
+	env.showDoc("Twice")
+	env.showDoc("strings.ToUpper")
+	out := buf.String()
+	for _, expected := range []string{"func Twice(n int) int\n", "    Twice doubles n.\n", "func ToUpper(s string) string\n"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf(":doc output %q does not contain %q", out, expected)
+		}
+	}
+	// names that are neither defined nor import paths are reported as undefined
+	var errbuf bytes.Buffer
+	env.Stderr = &errbuf
+	env.ParseEvalPrint("undocumented := 1", nil)
+	for name, expected := range map[string]string{
+		"undefinedName":     "warning: doc: undefined identifier: undefinedName\n",
+		"undefinedName.Foo": "warning: doc: undefined identifier: undefinedName\n",
+		"undocumented":      "warning: doc: no documentation for undocumented\n",
+	} {
+		errbuf.Reset()
+		env.showDoc(name)
+		if errbuf.String() != expected {
+			t.Errorf(":doc %s: expecting %q, found %q", name, expected, errbuf.String())
+		}
+	}
+	buf.Reset()
+	env.showDoc("unicode/utf8.RuneLen")
+	if !strings.Contains(buf.String(), "func RuneLen(r rune) int\n") {
+		t.Errorf(":doc unicode/utf8.RuneLen: unexpected output %q", buf.String())
+	}
+	// at the prompt, as for piped input, comment-only lines document the declaration that follows
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options |= OptShowPrompt
+	buf.Reset()
+	cmd.Stdout, cmd.Stderr = &buf, &buf
+	if err := cmd.EvalReader(strings.NewReader("// Half halves n.\nfunc Half(n int) int { return n / 2 }\n// not a doc comment\n:doc Half\n")); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); !strings.Contains(out, "    Half halves n.\n") {
+		t.Errorf(":doc Half: expecting the doc comment read at the prompt, found %q", out)
+	}
+	// comment-only lines are joined with the declaration they document only when reading a file
+	src := "// Twice doubles n.\nfunc Twice(n int) int { return n * 2 }\n"
+	if str, err := ReadMultiline(bufio.NewReader(strings.NewReader(src)), false, nil, ""); err != nil || str != src {
+		t.Errorf("ReadMultiline: expecting the doc comment joined with its declaration, found %q, error %v", str, err)
+	}
+	buf.Reset()
+	if str, err := ReadMultiline(bufio.NewReader(strings.NewReader(src)), true, &buf, "gomacro> "); err != nil || str != "// Twice doubles n.\n" {
+		t.Errorf("ReadMultiline: expecting a comment-only line at the prompt to be complete, found %q, error %v", str, err)
+	}
+}
+
+func TestMacroHygiene(t *testing.T) {
+	env := New()
+	const swap = "(a, b interface{}) interface{} { return ~`{ tmp := ~,a; ~,a = ~,b; ~,b = tmp } }; 0"
+	tests := []TestCase{
+		TestCase{"macro_unhygienic", "macro swap" + swap, 0, nil},
+		TestCase{"macro_unhygienic_capture", "tmp, x := 1, 2; swap; tmp; x; Values(tmp, x)", nil, []interface{}{1, 2}},
+		TestCase{"macro_hygienic", "macro hswap" + swap, 0, nil},
+		TestCase{"macro_hygienic_swap", "hswap; tmp; x; Values(tmp, x)", nil, []interface{}{2, 1}},
+		TestCase{"gensym", "Gensym(\"tmp\").Name != Gensym(\"tmp\").Name", true, nil},
+		// field names and composite literal keys are not renamed, even if the template binds the same name
+		TestCase{"macro_hygienic_fields", "type Point struct { X, Y int }; macro hpoint(e interface{}) interface{} { return ~`{ X := ~,e; p := Point{X: X, Y: X}; p.X + p.Y } }; 0", 0, nil},
+		TestCase{"macro_hygienic_fields_call", "hpoint; 21", 42, nil},
+	}
+	for i, c := range tests {
+		if i == 2 {
+			// only macros declared from now on are hygienic
+			env.Options |= OptMacroHygiene
+		}
+		c.run(t, env)
+	}
+	// source code cannot contain the identifiers created by Gensym()
+	name := env.Gensym("tmp").Name
+	func() {
+		defer func() {
+			if rec := recover(); rec == nil || !strings.Contains(fmt.Sprint(rec), "reserved for Gensym()") {
+				t.Errorf("expecting an error parsing identifier %q, found: %v", name, rec)
+			}
+		}()
+		env.ParseAst(name + " := 1")
+	}()
+}
+
+func TestMacroArgs(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"macro_import_ast", `import "go/ast"`, "go/ast", nil},
+		TestCase{"macro_import_token", `import "go/token"`, "go/token", nil},
+		TestCase{"macro_variadic", "macro count(args ...ast.Node) ast.Node { return &ast.BasicLit{Kind: token.INT, Value: String(len(args))} }; 0", 0, nil},
+		TestCase{"macro_variadic_call", "count; 1; 2; 3", 3, nil},
+		TestCase{"macro_variadic_empty", "count; _", 0, nil},
+		TestCase{"macro_variadic_delimiter", "y := 0; { count; 1; 2; _; y = 7 }; y", 7, nil},
+		TestCase{"macro_expr", "macro twice(e ast.Expr) ast.Node { return ~`{~,e + ~,e} }; 0", 0, nil},
+		TestCase{"macro_expr_call", "twice; 21", 42, nil},
+		TestCase{"macro_ident", "macro name(id *ast.Ident) ast.Node { return &ast.BasicLit{Kind: token.STRING, Value: `\"` + id.Name + `\"`} }; 0", 0, nil},
+		TestCase{"macro_ident_call", "name; foo", "foo", nil},
+		TestCase{"macro_stmts", "macro progn(stmts ...ast.Stmt) *ast.BlockStmt { return &ast.BlockStmt{List: stmts} }; 0", 0, nil},
+		TestCase{"macro_stmts_call", "progn; a := 20; a + 2", 22, nil},
+		TestCase{"macro_stmt_list", `macro define2(a, b *ast.Ident) []ast.Stmt {
+			one := &ast.BasicLit{Kind: token.INT, Value: "1"}
+			return []ast.Stmt{
+				&ast.AssignStmt{Lhs: []ast.Expr{a}, Tok: token.DEFINE, Rhs: []ast.Expr{one}},
+				&ast.AssignStmt{Lhs: []ast.Expr{b}, Tok: token.DEFINE, Rhs: []ast.Expr{one}},
+			}
+		}; 0`, 0, nil},
+		TestCase{"macro_stmt_list_call", "define2; p; q; p + q", 2, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+	for _, src := range []string{"twice; { 1 }", "name; 1 + 2"} {
+		func() {
+			defer func() {
+				if rec := recover(); rec == nil || !strings.Contains(fmt.Sprint(rec), "must be") {
+					t.Errorf("%s: expecting a syntactic category error, found: %v", src, rec)
+				}
+			}()
+			env.EvalAst(env.ParseAst(src))
+		}()
+	}
+}
+
+func TestMatch(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"match_import_ast", `import "go/ast"`, "go/ast", nil},
+		TestCase{"match_for", "loop := ~'{for i := 0; i < 10; i++ { a(i); b(i); c }}; Match(loop, ~`{for ~,init; ~,cond; ~,post { ~,@body }})", true, nil},
+		TestCase{"match_for_vars", "Values(len(init.(*ast.AssignStmt).Lhs), cond.(*ast.BinaryExpr).Y.(*ast.BasicLit).Value, len(body))", nil, []interface{}{1, "10", 3}},
+		TestCase{"match_splice_middle", "Match(loop, ~`{for ~,_; ~,_; ~,_ { ~,first; ~,@rest; c }}) && len(rest) == 1", true, nil},
+		TestCase{"match_fail_op", "Match(~'{x + 1}, ~'{~,a * ~,b})", false, nil},
+		TestCase{"match_fail_literal", "Match(~'{x + 1}, ~'{~,a + 2})", false, nil},
+		TestCase{"match_call_args", "Match(~'{f(1, 2, 3)}, ~`{f(~,@args)}) && len(args) == 3", true, nil},
+		TestCase{"match_macro", "macro minus(e ast.Expr) ast.Node { if Match(e, ~`{~,a + ~,b}) { return ~`{~,a - ~,b} }; return e }; 0", 0, nil},
+		TestCase{"match_macro_call", "minus; 10 + 3", 7, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+}
+
+func TestMacroExpansionErrors(t *testing.T) {
+	dir := t.TempDir()
+	header := "import \"go/ast\"\n\nmacro bad(e ast.Expr) ast.Node { return ~`{~,e + undefinedThing} }\n" +
+		"macro setidx(e ast.Expr) ast.Node {\n\treturn ~`{s := []int{}; s[3] = ~,e}\n}\n"
+	tests := []struct {
+		name, src, expected string
+	}{
+		{"macro_error", "  bad; 1\n", "undefined identifier: undefinedThing, in expansion of macro bad at %s:7:3 (defined at %s:3:1)"},
+		{"macro_error_func", "func useBad() {\n\tbad; 1\n}\nuseBad()\n", "in expansion of macro bad at %s:8:2 (defined at %s:3:1)"},
+		{"macro_panic", "func useSetidx() {\n\tsetidx; 1\n}\nuseSetidx()\n", "index out of range, in expansion of macro setidx at %s:8:2 (defined at %s:4:1)"},
+	}
+	for _, test := range tests {
+		filename := filepath.Join(dir, test.name+".gomacro")
+		if err := ioutil.WriteFile(filename, []byte(header+test.src), 0600); err != nil {
+			t.Fatal(err)
+		}
+		var cmd Cmd
+		cmd.Init()
+		cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+		expected := fmt.Sprintf(test.expected, filename, filename)
+		if err := cmd.EvalFile(filename); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%s: expecting an error containing %q, found: %v", test.name, expected, err)
+		}
+	}
+	// interpreted code recovers the original panic, without the macro expansion
+	env := New()
+	for _, c := range []TestCase{
+		TestCase{"macro_import_ast", `import "go/ast"`, "go/ast", nil},
+		TestCase{"macro_boom", "macro boom(e ast.Expr) ast.Node { return ~`{panic(~,e)} }; 0", 0, nil},
+		TestCase{"macro_double", "macro double(e ast.Expr) ast.Node { return ~`{~,e * 2} }; 0", 0, nil},
+		TestCase{"macro_recover", `var rec interface{}; func g() { defer func() { rec = recover() }(); boom; "boom" }; g(); rec`, "boom", nil},
+	} {
+		c.run(t, env)
+	}
+	if env.panicExpansion != nil {
+		t.Errorf("a recovered panic should not keep its macro expansion")
+	}
+	// the provenance of top-level code is forgotten once evaluated, while function bodies keep it
+	n := len(env.expansions)
+	env.Stdout = ioutil.Discard
+	env.ParseEvalPrint("double; 7", nil)
+	if len(env.expansions) != n {
+		t.Errorf("expecting %d macro expansions to be kept, found %d", n, len(env.expansions))
+	}
+}
+
+func TestUnquoteLift(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"lift_import", `import ("go/ast"; "reflect"; "strings"; "time")`, "time", nil},
+		TestCase{"lift_named", "~`{~,{time.Duration(5)}}", &ast.CallExpr{
+			Fun:  &ast.SelectorExpr{X: &ast.Ident{Name: "time"}, Sel: &ast.Ident{Name: "Duration"}},
+			Args: []ast.Expr{&ast.BasicLit{Kind: token.INT, Value: "5"}},
+		}, nil},
+		TestCase{"lift_type", "~`{~,{reflect.TypeOf([]*int{})}}", &ast.ArrayType{Elt: &ast.StarExpr{X: &ast.Ident{Name: "int"}}}, nil},
+		TestCase{"lift_complex", "~`{~,{complex(1, 2)}}", &ast.CallExpr{
+			Fun:  &ast.Ident{Name: "complex"},
+			Args: []ast.Expr{&ast.BasicLit{Kind: token.FLOAT, Value: "1"}, &ast.BasicLit{Kind: token.FLOAT, Value: "2"}},
+		}, nil},
+		TestCase{"lift_splice", "~`{f(~,@{[]string{\"a\", \"b\"}})}", &ast.CallExpr{
+			Fun:  &ast.Ident{Name: "f"},
+			Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `"a"`}, &ast.BasicLit{Kind: token.STRING, Value: `"b"`}},
+		}, nil},
+		TestCase{"lift_macro_fields", "macro fields(lit *ast.BasicLit) ast.Node { return ~`{~,{strings.Fields(lit.Value[1 : len(lit.Value)-1])}} }; 0", 0, nil},
+		TestCase{"lift_macro_fields_call", `fields; "a b c"`, []string{"a", "b", "c"}, nil},
+		TestCase{"lift_macro_table", "macro squares(e ast.Expr) ast.Node { m := map[string]int{}; for _, s := range []string{\"one\", \"two\"} { m[s] = len(s) * len(s) }; return ~`{~,m} }; 0", 0, nil},
+		TestCase{"lift_macro_table_call", "squares; _", map[string]int{"one": 9, "two": 9}, nil},
+		TestCase{"lift_macro_splice", "func addAll(xs ...int) int { n := 0; for _, x := range xs { n += x }; return n }; macro sumSquares(e ast.Expr) ast.Node { var xs []int; for i := 1; i <= 4; i++ { xs = append(xs, i*i) }; return ~`{addAll(~,@xs)} }; 0", 0, nil},
+		TestCase{"lift_macro_splice_call", "sumSquares; _", 30, nil},
+		TestCase{"lift_macro_struct", "type Point struct { X, Y int; Tags []string }; macro origin(e ast.Expr) ast.Node { return ~`{*~,{&Point{X: 3, Tags: []string{\"x\"}}}} }; 0", 0, nil},
+		TestCase{"lift_macro_struct_call", "origin; _", struct {
+			X, Y int
+			Tags []string
+		}{3, 0, []string{"x"}}, nil},
+		TestCase{"lift_macro_type", "macro mkmap(e ast.Expr) ast.Node { return ~`{make(~,{reflect.TypeOf(map[string][]int{})})} }; 0", 0, nil},
+		TestCase{"lift_macro_type_call", "mkmap; _", map[string][]int{}, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+	for _, src := range []string{"~`{~,{func() {}}}", "~`{~,{make(chan int)}}"} {
+		func() {
+			defer func() {
+				if rec := recover(); rec == nil || !strings.Contains(fmt.Sprint(rec), "cannot convert") {
+					t.Errorf("%s: expecting a conversion error, found: %v", src, rec)
+				}
+			}()
+			env.EvalAst(env.ParseAst(src))
+		}()
+	}
+}
+
+func TestMacroStep(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"macro_import_ast", `import "go/ast"`, "go/ast", nil},
+		TestCase{"macro_twice", "macro twice(e ast.Expr) ast.Node { return ~`{~,e + ~,e} }; 0", 0, nil},
+		TestCase{"macro_outer", "macro outer(e ast.Expr) ast.Node { return ~`{ x := 1; twice; ~,e; println(x) } }; 0", 0, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+	var buf bytes.Buffer
+	env.Stdout = &buf
+	steps := []struct {
+		cmd   string
+		count int
+	}{
+		{":expand1 outer; 5", 1},
+		{":expand outer; 5; twice; 3", 2},
+		{":expandall func f() { outer; 5 }", 2},
+		{":expand func f() { outer; 5 }", 0},
+	}
+	for _, step := range steps {
+		buf.Reset()
+		env.ParseEvalPrint(step.cmd, nil)
+		str := buf.String()
+		if count := strings.Count(str, "// step "); count != step.count {
+			t.Errorf("%s: expecting %d steps, found %d:\n%s", step.cmd, step.count, count, str)
+		}
+		if step.count != 0 && !strings.Contains(str, "// => expansion of macro outer") {
+			t.Errorf("%s: expecting the expansion of macro outer to be marked:\n%s", step.cmd, str)
+		}
+	}
+	if str := buf.String(); !strings.Contains(str, "no macro calls") {
+		t.Errorf("expecting no macro calls to expand, found:\n%s", str)
+	}
+	buf.Reset()
+	env.ParseEvalPrint(":expandall outer; 5", nil)
+	if str := buf.String(); !strings.Contains(str, "// => expansion of macro twice") || !strings.Contains(str, "5 + 5") {
+		t.Errorf("expecting the nested expansion of macro twice to be marked:\n%s", str)
+	}
+	if n := len(env.expansions); n != 0 {
+		t.Errorf("expecting no macro expansions to be kept after :expand, found %d", n)
+	}
+
+	// positions are counted from the first line read
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	cmd.Stdout = &buf
+	buf.Reset()
+	src := "import \"go/ast\"\nmacro twice(e ast.Expr) ast.Node { return ~`{~,e + ~,e} }\n" +
+		"macro outer(e ast.Expr) ast.Node {\n\treturn ~`{twice; ~,e}\n}\n:expandall outer; 3\n"
+	if err := cmd.EvalReader(strings.NewReader(src)); err != nil {
+		t.Fatal(err)
+	}
+	str := buf.String()
+	for _, expected := range []string{
+		"// step 1: outer; 3\t(macro outer defined at main.go:3:1)\n// => expansion of macro outer at main.go:6:12\n",
+		"// step 2: twice; 3\t(macro twice defined at main.go:2:1)\n// => expansion of macro twice at main.go:6:12, generated by macro outer\n3 + 3\n",
+	} {
+		if !strings.Contains(str, expected) {
+			t.Errorf("expecting %q in the output of :expandall, found:\n%s", expected, str)
+		}
+	}
+}
+
+func TestImportSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"a.go": "package srcpkg\nvar Name = prefix + \"pkg\"\nfunc Double(n int) int { return twice(n) }\n",
+		"b.go": "package srcpkg\nconst prefix = \"src\"\nfunc twice(n int) int { return n * 2 }\n",
+	}
+	var filenames []string
+	for name, src := range srcs {
+		filename := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+		filenames = append(filenames, filename)
+	}
+	env := New()
+	ref := env.importSourceFiles("srcpkg", "example.com/srcpkg", filenames)
+	if _, ok := ref.Binds["twice"]; ok {
+		t.Errorf("unexported function twice should not be visible")
+	}
+	c := TestCase{"import_source", `import "example.com/srcpkg"; Values(srcpkg.Double(21), srcpkg.Name)`, nil, []interface{}{42, "srcpkg"}}
+	c.run(t, env)
+}
+
+// TestImportSourcePackage tests the fallback used when plugins are not available:
+// "go list" finds the package files, applying build constraints and excluding tests
+func TestImportSourcePackage(t *testing.T) {
+	gopath := t.TempDir()
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GO111MODULE", "off")
+	dir := filepath.Join(gopath, "src", "example.com", "listpkg")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	srcs := map[string]string{
+		"a.go":         "package listpkg\nfunc Triple(n int) int { return n * factor }\n",
+		"b.go":         "package listpkg\nconst factor = 3\n",
+		"c_plan9.go":   "package listpkg\nfunc Plan9() {}\n",
+		"a_test.go":    "package listpkg\nfunc TestTriple() {}\n",
+		"d_ignored.go": "// +build ignore\n\npackage listpkg\nfunc Ignored() {}\n",
+	}
+	for name, src := range srcs {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	env := New()
+	ref := env.importSourcePackage("listpkg", "example.com/listpkg", "")
+	for _, name := range []string{"Plan9", "TestTriple", "Ignored"} {
+		if _, ok := ref.Binds[name]; ok {
+			t.Errorf("function %s from a file excluded by \"go list\" should not be imported", name)
+		}
+	}
+	if origin := importOrigins["example.com/listpkg"]; origin == nil || origin.kind != importSource {
+		t.Errorf("expecting package example.com/listpkg to be recorded as interpreted from source")
+	}
+	c := TestCase{"import_source_package", `import "example.com/listpkg"; listpkg.Triple(5)`, 15, nil}
+	c.run(t, env)
+}
+
+// TestImportModule tests imports in module mode: the package is resolved through the requirements
+// and replacements of the current module, and built in the gomacro cache directory
+// chdir changes the current directory until the end of the test
+func chdir(t *testing.T, dir string) {
+	olddir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(olddir) })
+}
+
+func TestImportModule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building imported packages is slow")
+	}
+	// keep the Go build cache, but use an empty gomacro cache
+	t.Setenv("GOCACHE", goEnv("GOCACHE"))
+	usePluginCacheDir(t)
+	t.Setenv("GO111MODULE", "on")
+	moddir := t.TempDir()
+	srcs := map[string]string{
+		"go.mod":                "module example.com/modproj\n\ngo 1.21\n\nrequire example.com/modlib v0.0.0\n\nreplace example.com/modlib => ./modlib\n",
+		"proj.go":               "package modproj\n",
+		"modlib/go.mod":         "module example.com/modlib\n\ngo 1.21\n",
+		"modlib/greet/greet.go": "package greet\nfunc Hello() string { return \"hello\" }\n",
+	}
+	for name, src := range srcs {
+		filename := filepath.Join(moddir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chdir(t, moddir)
+	env := New()
+	gomod := env.goModFile()
+	if gomod != filepath.Join(moddir, "go.mod") {
+		t.Fatalf("expecting the current module %q, found %q", filepath.Join(moddir, "go.mod"), gomod)
+	}
+	// the current module is computed once per session
+	chdir(t, t.TempDir())
+	if str := env.goModFile(); str != gomod {
+		t.Errorf("expecting goModFile() to be computed once, found %q then %q", gomod, str)
+	}
+	c := TestCase{"import_module", `import "example.com/modlib/greet"; greet.Hello()`, "hello", nil}
+	c.run(t, env)
+	if origin := importOrigins["example.com/modlib/greet"]; origin == nil || !strings.HasPrefix(origin.dir, getGomacroCacheDir()) {
+		t.Errorf("expecting package example.com/modlib/greet to be built in the gomacro cache directory, found %+v", origin)
+	}
+	// the current module is not modified
+	if infos, err := ioutil.ReadDir(moddir); err != nil || len(infos) != 3 {
+		t.Errorf("expecting only the files go.mod, proj.go and modlib in the current module, found %d files, error %v", len(infos), err)
+	}
+	if data, err := ioutil.ReadFile(gomod); err != nil || string(data) != srcs["go.mod"] {
+		t.Errorf("expecting the go.mod of the current module to be unchanged, found %q, error %v", data, err)
+	}
+}
+
+// a module with a vendor directory imports its vendored dependencies,
+// without module cache and without network
+func TestImportVendor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building imported packages is slow")
+	}
+	t.Setenv("GOCACHE", goEnv("GOCACHE"))
+	usePluginCacheDir(t)
+	t.Setenv("GO111MODULE", "on")
+	t.Setenv("GOMODCACHE", t.TempDir())
+	t.Setenv("GOPROXY", "off")
+	// -mod=vendor must be used even if GOFLAGS says otherwise
+	t.Setenv("GOFLAGS", "-mod=mod")
+	moddir := t.TempDir()
+	srcs := map[string]string{
+		"go.mod":             "module example.com/vendproj\n\ngo 1.21\n\nrequire example.com/vendlib v1.0.0\n",
+		"proj.go":            "package vendproj\n",
+		"vendor/modules.txt": "# example.com/vendlib v1.0.0\n## explicit\nexample.com/vendlib/greet\n",
+		"vendor/example.com/vendlib/greet/greet.go": "package greet\nfunc Hello() string { return \"hello vendored\" }\n",
+	}
+	for name, src := range srcs {
+		filename := filepath.Join(moddir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chdir(t, moddir)
+	env := New()
+	c := TestCase{"import_vendor", `import "example.com/vendlib/greet"; greet.Hello()`, "hello vendored", nil}
+	c.run(t, env)
+	if origin := importOrigins["example.com/vendlib/greet"]; origin == nil || !strings.HasPrefix(origin.dir, getGomacroCacheDir()) {
+		t.Errorf("expecting package example.com/vendlib/greet to be built in the gomacro cache directory, found %+v", origin)
+	}
+	// the current module is not modified
+	if infos, err := ioutil.ReadDir(moddir); err != nil || len(infos) != 3 {
+		t.Errorf("expecting only the files go.mod, proj.go and vendor in the current module, found %d files, error %v", len(infos), err)
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(moddir, "go.mod")); err != nil || string(data) != srcs["go.mod"] {
+		t.Errorf("expecting the go.mod of the current module to be unchanged, found %q, error %v", data, err)
+	}
+}
+
+func TestImportLocalDir(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"go.mod":              "module example.com/proj\n",
+		"util/util.gomacro":   "package util\nfunc Twice(n int) int { return n * 2 }\nfunc hidden() {}\n",
+		"lib/lib.go":          "package lib\nimport \"../util\"\nfunc Hello() string { return \"hello\" }\nvar N = util.Twice(2)\n",
+		"cmd/main.gomacro":    "import \"../util\"\nimport \"example.com/proj/lib\"\nresult := []interface{}{util.Twice(21), lib.Hello(), lib.N}\n",
+		"cmd/cycle.gomacro":   "import \"./cyc\"\n",
+		"cmd/cyc/cyc.gomacro": "import \"../cyc\"\n",
+	}
+	for name, src := range srcs {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	if err := cmd.EvalFile(filepath.Join(dir, "cmd", "main.gomacro")); err != nil {
+		t.Fatal(err)
+	}
+	c := TestCase{"import_local_dir", "result", []interface{}{42, "hello", 4}, nil}
+	c.run(t, cmd.Env)
+	if _, ok := imports.Packages["example.com/proj/util"].Binds["hidden"]; ok {
+		t.Errorf("unexported function hidden should not be visible")
+	}
+	if err := cmd.EvalFile(filepath.Join(dir, "cmd", "cycle.gomacro")); err == nil {
+		t.Errorf("expecting an import cycle error, got none")
+	}
+}
+
+func TestReimport(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "greet", "greet.go")
+	write := func(src string) {
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("package greet\nfunc Hello() string { return \"hello\" }\n")
+	main := filepath.Join(dir, "main.gomacro")
+	if err := ioutil.WriteFile(main, []byte("import \"./greet\"\nimport . \"./greet\"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	if err := cmd.EvalFile(main); err != nil {
+		t.Fatal(err)
+	}
+	env := cmd.Env
+	c := TestCase{"reimport_before", "greet.Hello() + Hello()", "hellohello", nil}
+	c.run(t, env)
+
+	write("package greet\nfunc Hello() string { return \"ciao\" }\n")
+	env.ParseEvalPrint(":reimport greet", nil)
+	c = TestCase{"reimport_after", "greet.Hello() + Hello()", "ciaociao", nil}
+	c.run(t, env)
+}
+
+func TestReimportPlugin(t *testing.T) {
+	if !pluginSupported {
+		t.Skip("this gomacro cannot load plugins")
+	}
+	if testing.Short() {
+		t.Skip("compiling plugins is slow")
+	}
+	// keep the Go build cache, but use an empty plugin cache
+	t.Setenv("GOCACHE", goEnv("GOCACHE"))
+	usePluginCacheDir(t)
+	gopath := t.TempDir()
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GO111MODULE", "off")
+	// the importer uses go/build, which read $GOPATH at startup
+	saveGopath := build.Default.GOPATH
+	build.Default.GOPATH = gopath
+	defer func() {
+		build.Default.GOPATH = saveGopath
+	}()
+	path := "example.com/greetplugin"
+	filename := filepath.Join(gopath, "src", filepath.FromSlash(path), "greet.go")
+	write := func(greeting string) {
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		src := "package greetplugin\nfunc Hello() string { return \"" + greeting + "\" }\n"
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+		// in GOPATH mode, the importer reads the compiled package
+		if err := goCommand("", false, os.Stdout, os.Stderr, "install", path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	env := New()
+	// a previous session cached the plugin for the "ciao" version, compiled with the default plugin path
+	write("ciao")
+	cached := env.newPluginCacheEntry("", path)
+	filegen := env.createImportFile(path, env.loadImportTypes(env.goModFile(), "", path), "", false)
+	if _, err := cached.store(env.compilePlugin(filegen, "", env.Stdout, env.Stderr)); err != nil {
+		t.Fatal(err)
+	}
+	write("hello")
+	c := TestCase{"reimport_plugin_before", `import "example.com/greetplugin"; greetplugin.Hello()`, "hello", nil}
+	c.run(t, env)
+	if origin := importOrigins[path]; origin == nil || origin.kind != importPlugin {
+		t.Fatalf("expecting package %q to be imported as a plugin", path)
+	}
+	// the cached plugin cannot be loaded: its plugin path is already loaded
+	write("ciao")
+	env.Reimport(path)
+	c = TestCase{"reimport_plugin_after", "greetplugin.Hello()", "ciao", nil}
+	c.run(t, env)
+	if names, _ := readPluginCache(); len(names) != 2 {
+		t.Errorf("expecting reimported plugins not to be cached, found cache entries %v", names)
+	}
+}
+
+func TestMacroLibrary(t *testing.T) {
+	dir := t.TempDir()
+	srcs := map[string]string{
+		"go.mod":                 "module example.com/maclib\n",
+		"lib/lib.go":             "package lib\nfunc Double(n int) int { return n * 2 }\n",
+		"lib/lib_macros.gomacro": "package lib\nimport \"go/ast\"\npanic(\"runtime code\")\nmacro Twice(e ast.Expr) ast.Node { return ~`{~,e + ~,e} }\n",
+		"main.gomacro":           "import \"./lib\"\nimport . \"./lib\"\nimport \"./script\"\nimport \"./only\"\n",
+		"gen/gen.gomacro":        "package gen\nimport \"go/ast\"\nfunc Half(n int) int { return n / 2 }\nmacro Square(e ast.Expr) ast.Node { return ~`{~,e * ~,e} }\n",
+		// interpreted packages can have macro libraries too
+		"script/script.gomacro":        "package script\nfunc Triple(n int) int { return n * 3 }\n",
+		"script/script_macros.gomacro": "package script\nimport \"go/ast\"\nmacro Thrice(e ast.Expr) ast.Node { return ~`{~,e + ~,e + ~,e} }\n",
+		// a directory containing only a macro library is a valid package
+		"only/only_macros.gomacro": "package only\nimport \"go/ast\"\nmacro Negate(e ast.Expr) ast.Node { return ~`{-~,e} }\n",
+	}
+	for name, src := range srcs {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	if err := cmd.EvalFile(filepath.Join(dir, "main.gomacro")); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []TestCase{
+		TestCase{"macro_library_selector", "lib.Twice; 21", 42, nil},
+		TestCase{"macro_library_dot_import", "Twice; lib.Double(5)", 20, nil},
+		TestCase{"macro_library_gomacro", "script.Thrice; script.Triple(2)", 18, nil},
+		TestCase{"macro_library_only", "only.Negate; 5", -5, nil},
+	} {
+		c.run(t, cmd.Env)
+	}
+
+	// -w writes the macros to a separate macro library
+	cmd.WriteDeclsAndStmtsToFile = true
+	cmd.Options |= OptCollectDeclarations | OptCollectStatements
+	if err := cmd.EvalFile(filepath.Join(dir, "gen", "gen.gomacro")); err != nil {
+		t.Fatal(err)
+	}
+	cmd.WriteDeclsAndStmtsToFile = false
+	if err := os.Remove(filepath.Join(dir, "gen", "gen.gomacro")); err != nil {
+		t.Fatal(err)
+	}
+	env := New()
+	env.Filename = filepath.Join(dir, "main.gomacro")
+	env.ParseEvalPrint(`import "./gen"`, nil)
+	c := TestCase{"macro_library_generated", "gen.Square; gen.Half(14)", 49, nil}
+	c.run(t, env)
+}
+
+func TestStandardMacros(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"std_import", `import ("errors"; "fmt"; "strconv"; "time"; "github.com/cosmos72/gomacro/macros")`, "github.com/cosmos72/gomacro/macros", nil},
+		TestCase{"std_assert", "func checkPositive(n int) int { macros.Assert; n > 0; return n }; checkPositive(3)", 3, nil},
+		TestCase{"std_try", "func parseDouble(s string) (int, error) { macros.Try; n := strconv.Atoi(s); 0; _; return n * 2, nil }; parseDouble(\"21\")", nil, []interface{}{42, nil}},
+		TestCase{"std_try_error", "_, err := parseDouble(\"x\"); err != nil", true, nil},
+		TestCase{"std_try_assign", "func parseSum(a, b string) (int, error) { var x, y int; macros.Try; x = strconv.Atoi(a); 0; _; macros.Try; y = strconv.Atoi(b); 0; _; return x + y, nil }; parseSum(\"1\", \"2\")", nil, []interface{}{3, nil}},
+		TestCase{"std_try_call", "func identity(e error) error { return e }; func firstError(errs []error) error { for _, e := range errs { macros.Try; identity(e) }; return nil }; firstError([]error{nil, errors.New(\"second\")}).Error()", "second", nil},
+		TestCase{"std_foreach", "func dot(xs, ys []int) int { total := 0; macros.Foreach; x, y := xs, ys; { total += x * y }; return total }; dot([]int{1, 2, 3}, []int{4, 5})", 14, nil},
+		TestCase{"std_memoize", "calls := 0; macros.Memoize; func square(n int) int { calls++; return n * n }; 0", 0, nil},
+		TestCase{"std_memoize_call", "Values(square(4), square(4), calls)", nil, []interface{}{16, 16, 1}},
+		TestCase{"std_memoize_recursive", "macros.Memoize; func fib(n int) int { if n < 2 { return n }; return fib(n-1) + fib(n-2) }; fib(90)", 2880067194370816120, nil},
+		TestCase{"std_memoize_params", "macros.Memoize; func label(n int, s string) string { return s + strconv.Itoa(n) }; label(1, \"x\") + label(2, \"x\")", "x1x2", nil},
+		TestCase{"std_defer_err", "macros.DeferErr; \"loading\"; func load(s string) (int, error) { if s == \"\" { return 0, errors.New(\"empty\") }; return len(s), nil }; load(\"abc\")", nil, []interface{}{3, nil}},
+		TestCase{"std_defer_err_wrap", "_, loadErr := load(\"\"); loadErr.Error()", "loading: empty", nil},
+		TestCase{"std_defer_err_call", "func inner() (int, error) { return 0, errors.New(\"inner failed\") }; macros.DeferErr; \"ctx\"; func outer() (int, error) { return inner() }; _, outerErr := outer(); outerErr.Error()", "ctx: inner failed", nil},
+		TestCase{"std_defer_err_call_nil", "macros.DeferErr; \"ctx\"; func outerOk() (int, error) { if true { return strconv.Atoi(\"7\") }; return 0, nil }; outerOk()", nil, []interface{}{7, nil}},
+		TestCase{"std_defer_err_named", "macros.DeferErr; \"named\"; func parse(s string) (n int, err error) { n, err = strconv.Atoi(s); return }; _, parseErr := parse(\"x\"); parseErr.Error()", "named: strconv.Atoi: parsing \"x\": invalid syntax", nil},
+		TestCase{"std_timeit", "func sleepy() time.Duration { macros.Timeit; elapsed; { time.Sleep(time.Millisecond) }; return elapsed }; sleepy() >= time.Millisecond", true, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+	for _, src := range []string{"checkPositive(-1)", "func f() { macros.Assert; 1 > 2 }; f()"} {
+		func() {
+			defer func() {
+				if rec := recover(); rec == nil || !strings.Contains(fmt.Sprint(rec), "assertion failed: ") {
+					t.Errorf("%s: expecting an assertion failure, found: %v", src, rec)
+				}
+			}()
+			env.EvalAst(env.ParseAst(src))
+		}()
+	}
+	// with a dot import, the macros can be used without package name
+	env = New()
+	c := TestCase{"std_dot_import", `import . "github.com/cosmos72/gomacro/macros"`, "github.com/cosmos72/gomacro/macros", nil}
+	c.run(t, env)
+	c = TestCase{"std_dot_import_call", "func g(xs []string) string { s := \"\"; Foreach; x := xs; { s += x }; return s }; g([]string{\"a\", \"b\"})", "ab", nil}
+	c.run(t, env)
+}
+
+// the standard macro library is embedded in gomacro: importing it must not need its source directory,
+// and must not add the macros bound to an interpreter to the bindings shared by all interpreters
+func TestStandardMacrosEmbedded(t *testing.T) {
+	gopath := build.Default.GOPATH
+	build.Default.GOPATH = t.TempDir()
+	defer func() {
+		build.Default.GOPATH = gopath
+	}()
+	env := New()
+	for _, c := range []TestCase{
+		TestCase{"std_embedded_import", `import "github.com/cosmos72/gomacro/macros"`, "github.com/cosmos72/gomacro/macros", nil},
+		TestCase{"std_embedded_call", "func positive(n int) int { macros.Assert; n > 0; return n }; positive(1)", 1, nil},
+		TestCase{"std_embedded_reimport", `import m "github.com/cosmos72/gomacro/macros"`, "github.com/cosmos72/gomacro/macros", nil},
+		TestCase{"std_embedded_reimport_call", "func g(xs []int) int { s := 0; m.Foreach; x := xs; { s += x }; return s }; g([]int{1, 2})", 3, nil},
+	} {
+		c.run(t, env)
+	}
+	if _, ok := imports.Packages["github.com/cosmos72/gomacro/macros"].Binds["Assert"]; ok {
+		t.Errorf("macros.Assert was added to imports.Packages")
+	}
+	if n := len(env.macroLibraries); n != 1 {
+		t.Errorf("expecting 1 loaded macro library, found %d", n)
+	}
+}
+
+func TestInterpretOnly(t *testing.T) {
+	env := New()
+	tests := []TestCase{
+		TestCase{"interpret_only_block", "~{ import \"strings\"; func upper(s string) string { return strings.ToUpper(s) } }; upper(\"abc\")", "ABC", nil},
+		TestCase{"interpret_only_decl", "interpret_only var verbose = 3; verbose", 3, nil},
+		TestCase{"interpret_only_in_func", "func f() int { x := 1; ~{ x = 2 }; return x }; f()", 2, nil},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) { c.run(t, env) })
+	}
+	// ~{ may span multiple lines
+	in := bufio.NewReader(strings.NewReader("~{\n\tx := 1\n}\nfoo\n"))
+	if str, err := ReadMultiline(in, false, nil, ""); err != nil || str != "~{\n\tx := 1\n}\n" {
+		t.Errorf("ReadMultiline: expecting the whole interpret_only block, found %q, error %v", str, err)
+	}
+	// interpret_only blocks are not written to *.go files
+	env.Options |= OptCollectDeclarations | OptCollectStatements
+	env.ParseAst("~{ func helper() {} }; func g() { ~{ helper() }; println(1) }")
+	var buf bytes.Buffer
+	env.writeDeclsToStream(&buf)
+	if str := buf.String(); strings.Contains(str, "helper") || !strings.Contains(str, "println(1)") {
+		t.Errorf("expecting collected code without interpret_only blocks, found:\n%s", str)
+	}
+	if len(env.Macros) != 1 || !isInterpretOnly(env.Macros[0]) {
+		t.Errorf("expecting the interpret_only block to be collected with macros, found: %v", env.Macros)
+	}
+}
+
+func TestExpandFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "gen.gomacro")
+	src := "package gen\nimport \"go/ast\"\nconst N = 3\n" +
+		"func double(e ast.Expr) ast.Node { return ~`{~,e * 2} }\n" +
+		"macro twice(e ast.Expr) ast.Node { return double(e) }\n" +
+		"var X = 7\npanic(\"executed\")\nfunc Get() int { x := X; twice; x; return x }\n"
+	if err := ioutil.WriteFile(filename, []byte(src), 0600); err != nil {
+		t.Fatal(err)
+	}
+	var cmd Cmd
+	cmd.Init()
+	cmd.Options &^= OptShowPrompt | OptShowEval | OptTrapPanic
+	var buf bytes.Buffer
+	cmd.Stdout = &buf
+	if err := cmd.Main([]string{"-expand", filename}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, expected := range []string{"package gen", "var X = 7", "x * 2", `panic("executed")`} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expanded source should contain %q, found:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "macro") {
+		t.Errorf("expanded source should not contain macros, found:\n%s", out)
+	}
+	// variables are not evaluated
+	if _, found := cmd.Binds["X"]; found {
+		t.Errorf("-expand should not evaluate variable declarations")
+	}
+}
+
 func (c *TestCase) run(t *testing.T, env *Env) {
 	// parse + macroexpansion phase
 	form := env.ParseAst(c.program)
@@ -72,6 +941,15 @@ var testcases = []TestCase{
 	TestCase{"defer_1", "v = 0; func testdefer(x uint32) { if x != 0 { defer func() { v = x }() } }; testdefer(29); v", uint32(29), nil},
 	TestCase{"defer_2", "v = 12; testdefer(0); v", uint32(12), nil},
 	TestCase{"import", "import \"fmt\"", "fmt", nil},
+	TestCase{"import_dot", "import . \"strconv\"; Itoa(42) + Quote(\"x\")", "42\"x\"", nil},
+	TestCase{"import_dot_type", "var doterr NumError; doterr.Func = \"Atoi\"; doterr.Func", "Atoi", nil},
+	TestCase{"import_blank", "import _ \"image/png\"", "image/png", nil},
+	TestCase{"untyped_float", "import \"math\"; var f float64 = math.MaxInt64 + 1; f", float64(1 << 63), nil},
+	TestCase{"untyped_shift", "float64(1 << math.MaxInt8)", float64(1 << 127), nil},
+	TestCase{"untyped_pi", "math.Pi * 2", math.Pi * 2, nil},
+	TestCase{"untyped_quo", "Values(7 / 2, 7.0 / 2)", nil, []interface{}{3, 3.5}},
+	TestCase{"untyped_const", "const bigconst = 1 << 100; bigconst >> 98", 4, nil},
+	TestCase{"untyped_mixed", "var umax uint64 = math.MaxUint64; umax - math.MaxUint32", uint64(math.MaxUint64 - math.MaxUint32), nil},
 	TestCase{"literal_struct", "Pair{A: 73, B: 94}", struct{ A, B int }{A: 73, B: 94}, nil},
 	TestCase{"literal_array", "[3]int{1,2:3}", [3]int{1, 0, 3}, nil},
 	TestCase{"literal_map", "map[int]string{1: \"foo\", 2: \"bar\"}", map[int]string{1: "foo", 2: "bar"}, nil},
@@ -98,6 +976,16 @@ var testcases = []TestCase{
 		}
 		test_recover(true, -3)
 		vpanic`, -3, nil},
+	TestCase{"recover_block", `func test_recover_block(panick interface{}) {
+			defer func() {
+				vpanic = recover()
+			}()
+			{
+				panic(panick)
+			}
+		}
+		test_recover_block(-4)
+		vpanic`, -4, nil},
 	TestCase{"recover_nested_1", `var vpanic2, vpanic3 interface{}
 		func test_nested_recover(repanic bool, panick interface{}) {
 			defer func() {
@@ -161,12 +1049,56 @@ var testcases = []TestCase{
 		&ast.ExprStmt{X: &ast.Ident{Name: "b"}},
 		&ast.ExprStmt{X: &ast.Ident{Name: "c"}},
 	}}, nil},
+	TestCase{"unquote_define", "vname := quote{z}; ~`{~,vname := 1}", &ast.AssignStmt{
+		Lhs: []ast.Expr{&ast.Ident{Name: "z"}},
+		Tok: token.DEFINE,
+		Rhs: []ast.Expr{&ast.BasicLit{Kind: token.INT, Value: "1"}},
+	}, nil},
 	TestCase{"macro", "macro second_arg(a,b,c interface{}) interface{} { return b }; 0", 0, nil},
 	TestCase{"macro_call", "v = 98; second_arg;1;v;3", uint32(98), nil},
 	TestCase{"macro_nested", "second_arg;1;{second_arg;2;3;4};5", 3, nil},
 	TestCase{"values", "Values(3,4,5)", nil, []interface{}{3, 4, 5}},
 	TestCase{"eval", "Eval(Values(3,4,5))", 3, nil},
 	TestCase{"eval_quote", "Eval(quote{Values(3,4,5)})", nil, []interface{}{3, 4, 5}},
+
+	TestCase{"literal_binary", "0b1010", 10, nil},
+	TestCase{"literal_octal", "0o17", 15, nil},
+	TestCase{"literal_separator", "1_000_000", 1000000, nil},
+	TestCase{"literal_hex_float", "0x1.8p1", 3.0, nil},
+	TestCase{"type_alias", "type IntAlias = int; var ia IntAlias = 7; ia", 7, nil},
+	TestCase{"for_range_int", "j := 0; for i := range 10 { j += i }; j", 45, nil},
+	TestCase{"builtin_min", "min(3, 1, 2)", 1, nil},
+	TestCase{"builtin_max", "max(1, 2.5)", 2.5, nil},
+	TestCase{"builtin_min_string", "min(\"foo\", \"bar\", \"baz\")", "bar", nil},
+	TestCase{"builtin_clear", "cm := map[int]bool{1: true, 2: false}; clear(cm); len(cm)", 0, nil},
+
+	TestCase{"array_define", "va := [3]int{1, 2, 3}; vb := va; vb[0] = 9; va", [3]int{1, 2, 3}, nil},
+	TestCase{"array_assign", "vb = [3]int{}; vb = va; vb[1] = 9; va", [3]int{1, 2, 3}, nil},
+	TestCase{"array_swap", "vb = [3]int{4, 5, 6}; va, vb = vb, va; Values(va, vb)", nil, []interface{}{[3]int{4, 5, 6}, [3]int{1, 2, 3}}},
+	TestCase{"array_param", "func setFirst(a [3]int) [3]int { a[0] = 0; return a }; Values(setFirst(va), va)", nil, []interface{}{[3]int{0, 5, 6}, [3]int{4, 5, 6}}},
+	TestCase{"array_range", "vs := 0; for i, e := range va { va[2] = 100; if i == 2 { vs = e } }; vs", 6, nil},
+	TestCase{"array_return", "func getArray() [3]int { return va }; vc := getArray(); vc[0] = 9; va[0]", 4, nil},
+	TestCase{"struct_define", "type Triple struct { A, B, C int }; ta := Triple{1, 2, 3}; tb := ta; tb.A = 9; ta", struct{ A, B, C int }{1, 2, 3}, nil},
+	TestCase{"struct_swap", "tb = Triple{4, 5, 6}; ta, tb = tb, ta; Values(ta, tb)", nil, []interface{}{struct{ A, B, C int }{4, 5, 6}, struct{ A, B, C int }{1, 2, 3}}},
+	TestCase{"struct_param", "func setA(t Triple) int { t.A = 0; return t.A }; Values(setA(ta), ta.A)", nil, []interface{}{0, 4}},
+	TestCase{"struct_return", `func deferStruct() Triple { defer func() { ta.A = 7 }(); return ta }
+		Values(deferStruct().A, ta.A)`, nil, []interface{}{4, 7}},
+	TestCase{"int_swap", "si, sj := 1, 2; si, sj = sj, si; Values(si, sj)", nil, []interface{}{2, 1}},
+
+	TestCase{"range_func", "func seq3(yield func(int, int) bool) { for i := 1; i <= 3; i++ { if !yield(i, i*i) { return } } }; rf := 0; for k, v := range seq3 { rf += k*10 + v }; rf", 74, nil},
+	TestCase{"range_func_break", "rf = 0; for k := range seq3 { if k == 2 { break }; rf += k }; rf", 1, nil},
+	TestCase{"range_func_continue", "rf = 0; for k := range seq3 { if k == 2 { continue }; rf += k }; rf", 4, nil},
+	TestCase{"range_func_return", "func firstSquareAbove(n int) int { for _, v := range seq3 { if v > n { return v } }; return -1 }; firstSquareAbove(3)", 4, nil},
+	TestCase{"range_func_defer", `rfd := 0
+		func deferAdd(k int) { rfd = rfd*10 + k }
+		func deferInRange() { rfd = 0; for k := range seq3 { defer deferAdd(k) }; rfd = 5 }
+		deferInRange()
+		rfd`, 5321, nil},
+
+	TestCase{"return_nil", "func retNil() (int, error) { return 1, nil }; retNil()", nil, []interface{}{1, nil}},
+	TestCase{"equal_nil", "_, errNil := retNil(); vi := 5; var pnil *int; Values(errNil == nil, pnil != nil, pnil == &vi)", nil, []interface{}{true, false, false}},
+	TestCase{"equal_pointer", "pi := &vi; Values(pi == &vi, pi != pi)", nil, []interface{}{true, false}},
+	TestCase{"typeswitch_nil", "func kind(x interface{}) int { switch x.(type) { case int: return 1; case nil: return 2 }; return 3 }; Values(kind(nil), kind(7))", nil, []interface{}{2, 1}},
 }
 
 func (c *TestCase) compareResults(t *testing.T, actual []r.Value) {
@@ -87,7 +87,12 @@ func (env *Env) evalLiteral0(node *ast.BasicLit) interface{} {
 		}
 		im, err := strconv.ParseFloat(str, 64)
 		if err != nil {
-			return error_(err)
+			// 0b, 0o and 0x integer imaginary literals are not accepted by ParseFloat
+			u64, err2 := strconv.ParseUint(str, 0, 64)
+			if err2 != nil {
+				return error_(err)
+			}
+			im = float64(u64)
 		}
 		ret = complex(0.0, im)
 		// env.Debugf("evalLiteral(): parsed IMAG %s -> %T %#v -> %T %#v", str, im, im, ret, ret)
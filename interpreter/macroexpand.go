@@ -134,6 +134,7 @@ Recurse:
 		}
 		out.Set(i, child)
 	}
+	env.copyExpansion(in, out)
 	if env.Options&OptDebugMacroExpand != 0 {
 		env.debugf("MacroExpandCodewalk: qq = %d, expanded to %v", quasiquoteDepth, out)
 	}
@@ -184,6 +185,91 @@ func (env *Env) MacroExpand1(in ast.Node) (out ast.Node, expanded bool) {
 }
 
 //
+// isMacroDelimiter returns true if form is the identifier _ used as a statement,
+// which ends the arguments of a variadic macro call
+func isMacroDelimiter(form Ast) bool {
+	ident, ok := unwrapTrivialAst(form).(Ident)
+	return ok && ident.X.Name == "_"
+}
+
+// macroArg converts the j-th argument of a macro call to the type of the corresponding parameter:
+// expression statements are unwrapped to ast.Expr, expressions are wrapped into ast.Stmt
+// and declaration statements are unwrapped to ast.Decl.
+// Arguments of the wrong syntactic category are an error
+func (env *Env) macroArg(call Ast, macro Macro, j int, form Ast) r.Value {
+	node := ToNode(form)
+	t := macro.Type
+	if t == nil {
+		return r.ValueOf(node)
+	}
+	if t.IsVariadic() && j >= t.NumIn()-1 {
+		t = t.In(t.NumIn() - 1).Elem()
+	} else {
+		t = t.In(j)
+	}
+	candidates := []ast.Node{node}
+	switch n := node.(type) {
+	case *ast.ExprStmt:
+		candidates = append(candidates, n.X)
+	case *ast.DeclStmt:
+		candidates = append(candidates, n.Decl)
+	case ast.Expr:
+		candidates = append(candidates, &ast.ExprStmt{X: n})
+	}
+	for _, candidate := range candidates {
+		if candidate != nil && r.TypeOf(candidate).AssignableTo(t) {
+			return r.ValueOf(candidate)
+		}
+	}
+	env.errorf("macro %v: argument %d must be %s <%v>, found %s: %v",
+		call.Interface(), j+1, syntacticCategory(t), t, syntacticCategoryOf(node), node)
+	return Nil
+}
+
+var (
+	typeOfAstExpr = r.TypeOf((*ast.Expr)(nil)).Elem()
+	typeOfAstStmt = r.TypeOf((*ast.Stmt)(nil)).Elem()
+	typeOfAstDecl = r.TypeOf((*ast.Decl)(nil)).Elem()
+)
+
+// syntacticCategory describes the AST nodes accepted by type t
+func syntacticCategory(t r.Type) string {
+	switch t {
+	case typeOfAstExpr:
+		return "an expression"
+	case typeOfAstStmt:
+		return "a statement"
+	case typeOfAstDecl:
+		return "a declaration"
+	case r.TypeOf((*ast.Ident)(nil)):
+		return "an identifier"
+	case r.TypeOf((*ast.BlockStmt)(nil)):
+		return "a block"
+	}
+	return "a node"
+}
+
+// syntacticCategoryOf describes the AST node n
+func syntacticCategoryOf(node ast.Node) string {
+	switch n := node.(type) {
+	case *ast.Ident:
+		return "identifier"
+	case *ast.BlockStmt:
+		return "block"
+	case *ast.ExprStmt:
+		return syntacticCategoryOf(n.X)
+	case *ast.DeclStmt:
+		return "declaration"
+	case ast.Expr:
+		return "expression"
+	case ast.Stmt:
+		return "statement"
+	case ast.Decl:
+		return "declaration"
+	}
+	return "node"
+}
+
 func (env *Env) extractMacroCall(form Ast) Macro {
 	form = unwrapTrivialAst(form)
 	switch form := form.(type) {
@@ -195,10 +281,30 @@ func (env *Env) extractMacroCall(form Ast) Macro {
 				return value
 			}
 		}
+	case SelectorExpr:
+		// macro exported by an imported package: pkg.Macro
+		if ident, ok := form.X.X.(*ast.Ident); ok {
+			bind, found := env.resolveIdentifier(ident)
+			if found && bind.IsValid() && bind.CanInterface() {
+				if pkg, ok := bind.Interface().(*PackageRef); ok {
+					bind := pkg.Binds[form.X.Sel.Name]
+					if bind.IsValid() && bind.Kind() == r.Struct {
+						if value, ok := bind.Interface().(Macro); ok {
+							return value
+						}
+					}
+				}
+			}
+		}
 	}
 	return Macro{}
 }
 
+func isAstWithNode(form Ast) bool {
+	_, ok := form.(AstWithNode)
+	return ok
+}
+
 func (env *Env) macroExpandAstOnce(in Ast) (out Ast, expanded bool) {
 	if in == nil {
 		return nil, false
@@ -222,11 +328,16 @@ func (env *Env) macroExpandAstOnce(in Ast) (out Ast, expanded bool) {
 	for i := 0; i < n; i++ {
 		elt := ins.Get(i)
 		macro := env.extractMacroCall(elt)
-		if macro.Closure == nil {
+		// while stepping, expand only one macro call at time
+		if macro.Closure == nil || (env.macroStepping && env.macroStep != nil) {
 			outs = outs.Append(elt)
 			continue
 		}
 		argn := macro.ArgNum
+		variadic := macro.Type != nil && macro.Type.IsVariadic()
+		if variadic {
+			argn-- // the variadic parameter can be empty
+		}
 		leftn := n - i - 1
 		var args []r.Value
 		if argn > leftn {
@@ -234,16 +345,30 @@ func (env *Env) macroExpandAstOnce(in Ast) (out Ast, expanded bool) {
 			for j := 0; j <= leftn; j++ {
 				args[j] = r.ValueOf(ins.Get(i + j).Interface())
 			}
-			env.errorf("not enough arguments for macroexpansion of %v: expecting %d, found %d", args, macro.ArgNum, leftn)
+			env.errorf("not enough arguments for macroexpansion of %v: expecting %d, found %d", args, argn, leftn)
 			return in, false
 		}
 		if env.Options&OptDebugMacroExpand != 0 {
 			env.debugf("MacroExpand1: found macro call %v at %d-th position of %v", elt.Interface(), i, ins.Interface())
 		}
 		// wrap each ast.Node into a reflect.Value
-		args = make([]r.Value, argn)
+		args = make([]r.Value, argn, macro.ArgNum)
 		for j := 0; j < argn; j++ {
-			args[j] = r.ValueOf(ToNode(ins.Get(i + j + 1)))
+			args[j] = env.macroArg(elt, macro, j, ins.Get(i+j+1))
+		}
+		if variadic {
+			// the variadic parameter collects the remaining arguments,
+			// up to the end of the enclosing list or up to the delimiter _
+			rest := r.MakeSlice(macro.Type.In(argn), 0, leftn-argn)
+			for j := argn; j < leftn; j++ {
+				form := ins.Get(i + j + 1)
+				argn++
+				if isMacroDelimiter(form) {
+					break
+				}
+				rest = r.Append(rest, env.macroArg(elt, macro, j, form))
+			}
+			args = append(args, rest)
 		}
 		// invoke the macro
 		results := macro.Closure(args)
@@ -266,17 +391,34 @@ func (env *Env) macroExpandAstOnce(in Ast) (out Ast, expanded bool) {
 			fallthrough
 		case 0:
 			// do not insert nil nodes... they would wreak havok, convert them to the identifier nil
-			out = Ident{&ast.Ident{Name: "nil"}}
+			out = Ident{X: &ast.Ident{Name: "nil"}}
+		}
+		exp := env.recordExpansion(elt, macro, args, out)
+		if env.macroStepping {
+			call := make([]Ast, argn+1)
+			for j := range call {
+				call[j] = ins.Get(i + j)
+			}
+			env.macroStep = &macroStep{call: call, expansion: exp}
+		}
+		if slice, ok := out.(AstWithSlice); ok && !isAstWithNode(out) {
+			// the macro returned a list, for example []ast.Stmt: splice it into the enclosing list,
+			// so that the declarations it contains are visible after the macro call
+			for j, nj := 0, slice.Size(); j < nj; j++ {
+				outs = outs.Append(slice.Get(j))
+			}
+		} else {
+			outs = outs.Append(out)
 		}
-		outs = outs.Append(out)
 		i += argn
 		expanded = true
 	}
 	if !expanded {
 		return in, false
 	}
+	env.copyExpansion(ins, outs)
 	if outs.Size() == 0 {
-		return EmptyStmt{&ast.EmptyStmt{}}, true
+		return EmptyStmt{X: &ast.EmptyStmt{}}, true
 	}
 	return unwrapTrivialAst(outs), true
 }
@@ -0,0 +1,211 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * macrolib.go
+ *
+ *  Created on Apr 10, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	r "reflect"
+	"sort"
+	"strings"
+
+	. "github.com/cosmos72/gomacro/ast2"
+)
+
+// macro libraries are the files *_macros.gomacro in a package directory.
+// Importing the package only evaluates their imports and macro declarations,
+// and adds the exported macros to the package
+const macroLibrarySuffix = "_macros.gomacro"
+
+func isMacroLibraryFile(filename string) bool {
+	return endsWith(filename, macroLibrarySuffix)
+}
+
+// macroLibraryFiles returns the macro libraries in directory dir, in alphabetical order
+func macroLibraryFiles(dir string) []string {
+	filenames, _ := filepath.Glob(filepath.Join(dir, "*"+macroLibrarySuffix))
+	return filenames
+}
+
+// isMacroDecl returns true if node is a macro declaration,
+// i.e. a function declaration with an empty receiver list
+func isMacroDecl(node ast.Node) bool {
+	decl, ok := node.(*ast.FuncDecl)
+	return ok && decl.Recv != nil && len(decl.Recv.List) == 0
+}
+
+// importMacroLibrary adds to ref the exported macros of its macro libraries: the sources in ref.MacroSources
+// if the package ships them, otherwise the files *_macros.gomacro in directory dir.
+// If dir is "", it is searched with go/build only when needed.
+// Each library is evaluated once per interpreter: later imports of the same path reuse its macros
+func (ir *InterpreterCommon) importMacroLibrary(ref *PackageRef, dir string) {
+	if ref == nil {
+		return
+	}
+	macros, ok := ir.macroLibraries[ref.Path]
+	if !ok {
+		macros = ir.loadMacroLibrary(ref, dir)
+		if ir.macroLibraries == nil {
+			ir.macroLibraries = make(map[string]map[string]r.Value)
+		}
+		ir.macroLibraries[ref.Path] = macros
+	}
+	if len(macros) == 0 {
+		return
+	}
+	// ref.Binds can be shared with imports.Packages, which outlives this interpreter:
+	// add the macros, which are closures bound to this interpreter, to a copy
+	binds := make(map[string]r.Value, len(ref.Binds)+len(macros))
+	for name, bind := range ref.Binds {
+		binds[name] = bind
+	}
+	for name, bind := range macros {
+		binds[name] = bind
+	}
+	ref.Binds = binds
+}
+
+// loadMacroLibrary evaluates the macro libraries of package ref.Path and returns their exported macros
+func (ir *InterpreterCommon) loadMacroLibrary(ref *PackageRef, dir string) map[string]r.Value {
+	var env *Env
+	if len(ref.MacroSources) != 0 {
+		env = ir.newPackageEnv(ref.Path)
+		names := make([]string, 0, len(ref.MacroSources))
+		for name := range ref.MacroSources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			env.evalMacroSource(ref.Path+"/"+name, []byte(ref.MacroSources[name]))
+		}
+	} else {
+		if len(dir) == 0 {
+			dir = packageDir(ref.Path, "")
+		}
+		if len(dir) == 0 {
+			return nil
+		}
+		filenames := macroLibraryFiles(dir)
+		if len(filenames) == 0 {
+			return nil
+		}
+		env = ir.newPackageEnv(ref.Path)
+		for _, filename := range filenames {
+			env.evalMacroFile(filename)
+		}
+	}
+	macros := make(map[string]r.Value)
+	for name, bind := range env.Binds {
+		if !bind.IsValid() || !bind.CanInterface() || !ast.IsExported(name) {
+			continue
+		}
+		if _, ok := bind.Interface().(Macro); ok {
+			macros[name] = bind
+		}
+	}
+	return macros
+}
+
+// packageDir returns the directory containing the source code of package 'path',
+// as resolved from directory dir, or "" if not found
+func packageDir(path, dir string) string {
+	if len(importVendorModule(dir)) != 0 {
+		// go/build does not know about the current module of dir
+		list, err := goList(dir, path)
+		if err != nil || len(list) == 0 {
+			return ""
+		}
+		return list[0].Dir
+	}
+	if len(dir) == 0 {
+		dir, _ = os.Getwd()
+	}
+	pkg, err := build.Import(path, dir, build.FindOnly)
+	if err != nil {
+		return ""
+	}
+	return pkg.Dir
+}
+
+// evalMacroFile evaluates only the imports, macro declarations and interpret_only blocks of a *.gomacro file,
+// skipping all other declarations and statements: they are the library's runtime code
+func (env *Env) evalMacroFile(filename string) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		env.errorf("error reading file %q: %v", filename, err)
+	}
+	env.evalMacroSource(filename, src)
+}
+
+// evalMacroSource is the same as evalMacroFile, for source code already in memory
+func (env *Env) evalMacroSource(filename string, src []byte) {
+	env.forEachSourceChunk(filename, src, func(str string) {
+		if str == "package" || startsWith(str, "package ") {
+			return
+		}
+		for _, node := range env.ParseBytes([]byte(str)) {
+			if decl, ok := node.(*ast.GenDecl); (ok && decl.Tok == token.IMPORT) || isMacroDecl(node) || isInterpretOnly(node) {
+				// do not use ParseAst(): it would also collect the declarations for -w
+				mark := env.expansionMark()
+				form, _ := env.MacroExpandAstCodewalk(ToAst(node))
+				env.EvalAst(form)
+				env.pruneExpansions(mark, form)
+			}
+		}
+	})
+}
+
+// writeMacrosToFile writes the collected imports and macro declarations to a macro library file
+func (ir *InterpreterCommon) writeMacrosToFile(filename string) {
+	f, err := os.Create(filename)
+	if err != nil {
+		ir.errorf("failed to create file %q: %v", filename, err)
+	}
+	defer f.Close()
+	fmt.Fprintf(f, "package %s\n\n", ir.Packagename)
+	for _, imp := range ir.Imports {
+		fmt.Fprintln(f, ir.toPrintable(imp))
+	}
+	for _, node := range ir.Macros {
+		if decl, ok := node.(*ast.FuncDecl); ok {
+			fmt.Fprintf(f, "\n%s\n", ir.macroDeclToPrintable(decl))
+		} else {
+			fmt.Fprintf(f, "\n%v\n", ir.toPrintable(node))
+		}
+	}
+}
+
+// macroDeclToPrintable prints a macro declaration with the syntax "macro NAME(PARAMS) RESULTS { BODY }"
+func (ir *InterpreterCommon) macroDeclToPrintable(decl *ast.FuncDecl) string {
+	fun := *decl
+	fun.Doc, fun.Recv = nil, nil
+	str := fmt.Sprint(ir.toPrintable(&fun))
+	return "macro" + strings.TrimPrefix(str, "func")
+}
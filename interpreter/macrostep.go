@@ -0,0 +1,236 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * macrostep.go
+ *
+ *  Created on Apr 12, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	. "github.com/cosmos72/gomacro/ast2"
+)
+
+type macroStepMode int
+
+const (
+	macroStepOnce macroStepMode = iota // :expand1  expands the first macro call at top level
+	macroStepTop                       // :expand   expands the macro calls at top level
+	macroStepAll                       // :expandall expands all macro calls, including nested ones
+)
+
+// macroStep is a single macro call expanded while stepping
+type macroStep struct {
+	call      []Ast // the macro followed by its arguments
+	expansion *macroExpansion
+}
+
+// macroStepCommand implements the REPL commands :expand1, :expand and :expandall:
+// it expands the macro calls in src one at a time, and prints the code after each step
+func (env *Env) macroStepCommand(src string, mode macroStepMode) {
+	trimmed := strings.TrimLeftFunc(src, unicode.IsSpace)
+	env.source.skip(src[:len(src)-len(trimmed)])
+	src = strings.TrimSpace(trimmed)
+	if len(src) == 0 {
+		fmt.Fprint(env.Stdout, "// expand: missing argument\n")
+		return
+	}
+	// the expanded code is never evaluated: forget its provenance when done
+	defer env.pruneExpansions(env.expansionMark(), nil)
+	var form Ast
+	switch nodes := env.ParseBytes([]byte(src)); len(nodes) {
+	case 0:
+		return
+	case 1:
+		form = ToAst(nodes[0])
+	default:
+		form = NodeSlice{X: nodes}
+	}
+	saved := env.macroStepping
+	env.macroStepping = true
+	defer func() {
+		env.macroStepping = saved
+		env.macroStep = nil
+	}()
+	out := env.Stdout
+	for n := 1; ; n++ {
+		env.macroStep = nil
+		if mode == macroStepAll {
+			form, _ = env.MacroExpandAstCodewalk(form)
+		} else {
+			form, _ = env.macroExpandAstOnce(form)
+		}
+		step := env.macroStep
+		if step == nil {
+			if n == 1 {
+				fmt.Fprint(out, "// no macro calls to expand\n")
+			}
+			return
+		}
+		exp := step.expansion
+		fmt.Fprintf(out, "// step %d: %s", n, env.macroCallToPrintable(step.call))
+		if exp.Def.IsValid() {
+			fmt.Fprintf(out, "\t(macro %s defined at %v)", exp.Name, exp.Def)
+		}
+		fmt.Fprintln(out)
+		env.fprintExpansion(out, form, exp)
+		if mode == macroStepOnce {
+			return
+		}
+	}
+}
+
+// macroCallToPrintable prints a macro call on a single line, as "macro; arg1; arg2..."
+func (env *Env) macroCallToPrintable(call []Ast) string {
+	strs := make([]string, len(call))
+	for i, form := range call {
+		str := fmt.Sprint(env.toPrintable(form))
+		strs[i] = strings.Join(strings.Fields(str), " ")
+	}
+	return strings.Join(strs, "; ")
+}
+
+// fprintExpansion prints form, preceding each fragment generated by a macro with a comment
+// that shows the macro call which produced it. The fragment produced by 'current' is marked with =>
+func (env *Env) fprintExpansion(out io.Writer, form Ast, current *macroExpansion) {
+	var exps []*macroExpansion
+	placeholder := func(exp *macroExpansion) ast.Stmt {
+		exps = append(exps, exp)
+		return &ast.ExprStmt{X: &ast.Ident{Name: expansionPlaceholder(len(exps) - 1)}}
+	}
+	var restore []func()
+	defer func() {
+		for _, f := range restore {
+			f()
+		}
+	}()
+	// covered contains the nodes inside a fragment already marked by a comment
+	covered := make(map[ast.Node]*macroExpansion)
+	// temporarily insert the placeholders in the statement lists.
+	// Consecutive statements generated by the same macro call get a single comment
+	annotate := func(list []ast.Stmt, outer *macroExpansion) []ast.Stmt {
+		var ret []ast.Stmt
+		prev := outer
+		for i, stmt := range list {
+			exp := env.expansionOf(stmt)
+			if exp != nil && exp != prev {
+				if ret == nil {
+					ret = append(make([]ast.Stmt, 0, len(list)+1), list[:i]...)
+				}
+				ret = append(ret, placeholder(exp))
+			}
+			if ret != nil {
+				ret = append(ret, stmt)
+			}
+			if exp == nil {
+				exp = outer
+			}
+			covered[stmt], prev = exp, exp
+		}
+		if ret == nil {
+			return list
+		}
+		return ret
+	}
+	var nodes []ast.Node
+	if slice, ok := form.(NodeSlice); ok {
+		nodes = slice.X
+	} else if form != nil {
+		nodes = []ast.Node{ToNode(form)}
+	}
+	for _, node := range nodes {
+		if exp := env.expansionOf(node); exp != nil {
+			covered[node] = exp
+		}
+		stack := []*macroExpansion{nil}
+		ast.Inspect(node, func(node ast.Node) bool {
+			if node == nil {
+				stack = stack[:len(stack)-1]
+				return true
+			}
+			outer := stack[len(stack)-1]
+			if exp, ok := covered[node]; ok {
+				outer = exp
+			}
+			stack = append(stack, outer)
+			switch node := node.(type) {
+			case *ast.BlockStmt:
+				list := node.List
+				node.List = annotate(list, outer)
+				restore = append(restore, func() { node.List = list })
+			case *ast.CaseClause:
+				list := node.Body
+				node.Body = annotate(list, outer)
+				restore = append(restore, func() { node.Body = list })
+			case *ast.CommClause:
+				list := node.Body
+				node.Body = annotate(list, outer)
+				restore = append(restore, func() { node.Body = list })
+			}
+			return true
+		})
+	}
+	for _, node := range nodes {
+		str := fmt.Sprint(env.toPrintable(node))
+		if exp := env.expansionOf(node); exp != nil {
+			str = expansionPlaceholder(len(exps)) + "\n" + str
+			exps = append(exps, exp)
+		}
+		for _, line := range strings.Split(str, "\n") {
+			fmt.Fprintln(out, replaceExpansionPlaceholder(line, exps, current))
+		}
+	}
+}
+
+func expansionPlaceholder(i int) string {
+	return fmt.Sprintf("gomacro_expansion_%d_", i)
+}
+
+// replaceExpansionPlaceholder replaces a line containing an expansion placeholder
+// with a comment describing the corresponding macro call
+func replaceExpansionPlaceholder(line string, exps []*macroExpansion, current *macroExpansion) string {
+	const prefix = "gomacro_expansion_"
+	trimmed := strings.TrimLeft(line, "\t ")
+	if !strings.HasPrefix(trimmed, prefix) || !strings.HasSuffix(trimmed, "_") {
+		return line
+	}
+	i, err := strconv.Atoi(trimmed[len(prefix) : len(trimmed)-1])
+	if err != nil || i < 0 || i >= len(exps) {
+		return line
+	}
+	exp := exps[i]
+	mark := "  "
+	if exp == current {
+		mark = "=>"
+	}
+	indent := line[:len(line)-len(trimmed)]
+	line = fmt.Sprintf("%s// %s expansion of macro %s at %v", indent, mark, exp.Name, exp.Call)
+	if exp.Outer != nil {
+		line += ", generated by macro " + exp.Outer.Name
+	}
+	return line
+}
@@ -0,0 +1,209 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * match.go
+ *
+ *  Created on Apr 11, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"go/ast"
+	"go/token"
+	r "reflect"
+	"sort"
+
+	. "github.com/cosmos72/gomacro/ast2"
+	mt "github.com/cosmos72/gomacro/token"
+)
+
+var typeOfAstNode = r.TypeOf((*ast.Node)(nil)).Elem()
+
+// builtinMatch implements Match(node, pattern): if node matches pattern, it defines
+// the pattern variables in the current scope and returns true.
+// The pattern is a quote or quasiquote: ~,x matches any node and binds it to x as ast.Node,
+// ~,@xs matches any number of elements in a list and binds them to xs as []ast.Node,
+// while ~,_ matches any node without binding it. Patterns written as quasiquote are not evaluated
+func builtinMatch(env *Env, args []ast.Expr) (r.Value, []r.Value) {
+	form := AnyToAst(env.evalExpr1(args[0]).Interface(), "Match")
+	var pattern ast.Node
+	if expr, ok := args[1].(*ast.UnaryExpr); ok && (expr.Op == mt.QUOTE || expr.Op == mt.QUASIQUOTE) {
+		pattern = simplifyNodeForQuote(expr.X.(*ast.FuncLit).Body, true)
+	} else {
+		value := env.evalExpr1(args[1])
+		pattern, ok = value.Interface().(ast.Node)
+		if !ok {
+			return env.errorf("Match: pattern is not an ast.Node: %v <%v>", value, typeOf(value))
+		}
+	}
+	binds := make(map[string]r.Value)
+	if !env.matchAst(ToAst(pattern), form, binds) {
+		return r.ValueOf(false), nil
+	}
+	// define the pattern variables in a predictable order
+	names := make([]string, 0, len(binds))
+	for name := range binds {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		env.defineMatchVar(name, binds[name])
+	}
+	return r.ValueOf(true), nil
+}
+
+// defineMatchVar defines a pattern variable, or sets it if already defined in the current scope:
+// a macro can try several patterns that bind the same variables
+func (env *Env) defineMatchVar(name string, value r.Value) {
+	t := value.Type()
+	if bind, ok := env.Binds[name]; ok && bind.IsValid() && bind.CanSet() && bind.Type() == t {
+		bind.Set(value)
+		return
+	}
+	env.defineVar(name, t, value)
+}
+
+// matchAst returns true if form matches pattern, and stores the pattern variables in binds.
+// Parentheses, expression statements and declaration statements are ignored on both sides
+func (env *Env) matchAst(pattern Ast, form Ast, binds map[string]r.Value) bool {
+	pattern = unwrapTrivialAstKeepBlocks(pattern)
+	if isNilAst(pattern) {
+		return isNilAst(unwrapTrivialAstKeepBlocks(form))
+	}
+	if name, ok := env.patternVar(pattern, mt.UNQUOTE); ok {
+		if name != "_" {
+			value := r.Zero(typeOfAstNode)
+			if !isNilAst(form) {
+				node := ToNode(form)
+				value = r.ValueOf(&node).Elem()
+			}
+			binds[name] = value
+		}
+		return true
+	}
+	form = unwrapTrivialAstKeepBlocks(form)
+	if isNilAst(form) {
+		return false
+	}
+	if r.TypeOf(pattern) != r.TypeOf(form) || pattern.Op() != form.Op() {
+		return false
+	}
+	switch pattern := pattern.(type) {
+	case Ident:
+		return pattern.X.Name == form.(Ident).X.Name
+	case BasicLit:
+		return pattern.X.Value == form.(BasicLit).X.Value
+	case AstWithSlice:
+		return env.matchSlice(pattern, form.(AstWithSlice), binds)
+	}
+	n := pattern.Size()
+	if n != form.Size() {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		if !env.matchAst(pattern.Get(i), form.Get(i), binds) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchSlice matches a list, where at most one pattern element can be ~,@name
+func (env *Env) matchSlice(pattern AstWithSlice, form AstWithSlice, binds map[string]r.Value) bool {
+	np, nf := pattern.Size(), form.Size()
+	splice := -1
+	var spliceName string
+	for i := 0; i < np; i++ {
+		if name, ok := env.patternVar(unwrapTrivialAstKeepBlocks(pattern.Get(i)), mt.UNQUOTE_SPLICE); ok {
+			if splice >= 0 {
+				env.errorf("Match: at most one unquote_splice is allowed in each list: %v", pattern.Interface())
+			}
+			splice, spliceName = i, name
+		}
+	}
+	if splice < 0 {
+		if np != nf {
+			return false
+		}
+		for i := 0; i < np; i++ {
+			if !env.matchAst(pattern.Get(i), form.Get(i), binds) {
+				return false
+			}
+		}
+		return true
+	}
+	after := np - splice - 1
+	if nf < splice+after {
+		return false
+	}
+	for i := 0; i < splice; i++ {
+		if !env.matchAst(pattern.Get(i), form.Get(i), binds) {
+			return false
+		}
+	}
+	for i := 0; i < after; i++ {
+		if !env.matchAst(pattern.Get(splice+1+i), form.Get(nf-after+i), binds) {
+			return false
+		}
+	}
+	if spliceName != "_" {
+		nodes := make([]ast.Node, 0, nf-after-splice)
+		for i := splice; i < nf-after; i++ {
+			nodes = append(nodes, ToNode(form.Get(i)))
+		}
+		binds[spliceName] = r.ValueOf(nodes)
+	}
+	return true
+}
+
+// patternVar returns the name of the pattern variable if pattern is op{name}
+func (env *Env) patternVar(pattern Ast, op token.Token) (string, bool) {
+	expr, ok := pattern.(UnaryExpr)
+	if !ok || expr.X.Op != op {
+		return "", false
+	}
+	body := expr.X.X.(*ast.FuncLit).Body
+	var ident *ast.Ident
+	if len(body.List) == 1 {
+		if stmt, ok := body.List[0].(*ast.ExprStmt); ok {
+			ident, _ = stmt.X.(*ast.Ident)
+		}
+	}
+	if ident == nil {
+		env.errorf("Match: %s in a pattern must contain only an identifier, found: %v", mt.String(op), body)
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// isNilAst returns true if form is nil, wraps a nil node or wraps an empty list
+func isNilAst(form Ast) bool {
+	if form == nil {
+		return true
+	}
+	v := r.ValueOf(form.Interface())
+	switch v.Kind() {
+	case r.Ptr, r.Interface:
+		return v.IsNil()
+	case r.Slice:
+		return v.Len() == 0
+	}
+	return false
+}
@@ -33,8 +33,10 @@ import (
 	"io"
 	r "reflect"
 	"sort"
+	"strings"
 
 	. "github.com/cosmos72/gomacro/ast2"
+	mt "github.com/cosmos72/gomacro/token"
 )
 
 type fileSet struct {
@@ -49,12 +51,17 @@ type output struct {
 
 type runtimeError struct {
 	fileSet
-	format string
-	args   []interface{}
+	format    string
+	args      []interface{}
+	expansion *macroExpansion // if the error happened in code generated by a macro
 }
 
 func (err runtimeError) Error() string {
-	return fmt.Sprintf(err.format, err.toPrintables(err.args)...)
+	msg := fmt.Sprintf(err.format, err.toPrintables(err.args)...)
+	if err.expansion != nil {
+		msg = fmt.Sprintf("%s, %v", msg, err.expansion)
+	}
+	return msg
 }
 
 func error_(err error) interface{} {
@@ -62,15 +69,15 @@ func error_(err error) interface{} {
 }
 
 func errorf(format string, args ...interface{}) {
-	panic(runtimeError{fileSet{nil}, format, args})
+	panic(runtimeError{fileSet{nil}, format, args, nil})
 }
 
 func (f fileSet) errorf(format string, args ...interface{}) (r.Value, []r.Value) {
-	panic(runtimeError{f, format, args})
+	panic(runtimeError{f, format, args, nil})
 }
 
 func (f fileSet) packErrorf(format string, args ...interface{}) []r.Value {
-	panic(runtimeError{f, format, args})
+	panic(runtimeError{f, format, args, nil})
 }
 
 func (o *output) warnf(format string, args ...interface{}) {
@@ -97,6 +104,9 @@ func (env *Env) showStack() {
 		} else {
 			env.debugf("%d:\t     %v, runningDefers = %v, panic is nil", i, name, frame.runningDefers)
 		}
+		if exp := env.expansionOf(frame.CurrentCall); exp != nil {
+			env.debugf("%d:\t     calling %v, %v", i, frame.CurrentCall, exp)
+		}
 	}
 }
 
@@ -226,22 +236,118 @@ func (f fileSet) nodeToPrintable(node ast.Node) interface{} {
 	if fset == nil {
 		fset = token.NewFileSet()
 	}
+	var quotes []UnaryExpr
+	if hasQuotes(node) {
+		node = ToNode(printableQuotes(ToAst(node), &quotes))
+	}
 	var buf bytes.Buffer
 	err := config.Fprint(&buf, fset, node)
 	if err != nil {
 		return err
 	}
-	return buf.String()
+	str := buf.String()
+	for i, quote := range quotes {
+		str = f.replaceQuotePlaceholder(str, i, quote)
+	}
+	return printableMacroDecls(node, str)
+}
+
+// printableMacroDecls replaces "func () NAME(" with "macro NAME(" in str for each macro declared inside node:
+// go/printer prints macro declarations as methods with an empty receiver list
+func printableMacroDecls(node ast.Node, str string) string {
+	ast.Inspect(node, func(node ast.Node) bool {
+		if isMacroDecl(node) {
+			name := node.(*ast.FuncDecl).Name.Name
+			str = strings.Replace(str, "func () "+name+"(", "macro "+name+"(", 1)
+		}
+		return true
+	})
+	return str
+}
+
+// isQuoteOp returns true for quote, quasiquote, unquote and unquote_splice,
+// and for interpret_only: they all wrap a block, and go/printer cannot print them
+func isQuoteOp(op token.Token) bool {
+	return op == mt.QUOTE || op == mt.QUASIQUOTE || op == mt.UNQUOTE || op == mt.UNQUOTE_SPLICE || op == mt.INTERPRET_ONLY
+}
+
+func quotePlaceholder(i int) string {
+	return fmt.Sprintf("gomacro_quote_%d_", i)
+}
+
+// hasQuotes returns true if node contains quote, quasiquote, unquote or unquote_splice
+func hasQuotes(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(node ast.Node) bool {
+		if expr, ok := node.(*ast.UnaryExpr); ok && isQuoteOp(expr.Op) {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// printableQuotes returns a copy of form where quote, quasiquote, unquote and unquote_splice
+// are replaced by placeholder identifiers, and appends them to quotes: go/printer cannot print them
+func printableQuotes(form Ast, quotes *[]UnaryExpr) Ast {
+	if form == nil || form.Size() == 0 {
+		return form
+	}
+	if expr, ok := form.(UnaryExpr); ok && isQuoteOp(expr.X.Op) {
+		*quotes = append(*quotes, expr)
+		return Ident{X: &ast.Ident{NamePos: expr.X.OpPos, Name: quotePlaceholder(len(*quotes) - 1)}}
+	}
+	n := form.Size()
+	if slice, ok := form.(AstWithSlice); ok {
+		out := slice.New().(AstWithSlice)
+		for i := 0; i < n; i++ {
+			out = out.Append(printableQuotes(slice.Get(i), quotes))
+		}
+		return out
+	}
+	out := form.New()
+	for i := 0; i < n; i++ {
+		out.Set(i, printableQuotes(form.Get(i), quotes))
+	}
+	return out
+}
+
+// replaceQuotePlaceholder replaces the i-th placeholder in str with the source code of quote,
+// indented as the line containing the placeholder
+func (f fileSet) replaceQuotePlaceholder(str string, i int, quote UnaryExpr) string {
+	placeholder := quotePlaceholder(i)
+	pos := strings.Index(str, placeholder)
+	if pos < 0 {
+		return str
+	}
+	body := fmt.Sprint(f.nodeToPrintable(quote.Get(0).Get(1).(AstWithNode).Node()))
+	if lines := strings.Split(body, "\n"); len(lines) == 3 {
+		// single statement: print it on one line
+		body = "{" + strings.TrimSpace(lines[1]) + "}"
+	} else {
+		linestart := strings.LastIndexByte(str[:pos], '\n') + 1
+		indent := str[linestart : linestart+len(str[linestart:])-len(strings.TrimLeft(str[linestart:], "\t"))]
+		body = strings.Replace(body, "\n", "\n"+indent, -1)
+	}
+	return str[:pos] + mt.String(quote.X.Op) + body + str[pos+len(placeholder):]
 }
 
 func (f fileSet) showHelp(out io.Writer) {
 	fmt.Fprint(out, `// interpreter commands:
+:cache [CMD]    manage the cache of compiled import plugins. CMD is one of:
+                list (default), verify, purge [stale|PATH]
+:doc NAME       show the signature and doc comment of an interpreted declaration,
+                an imported package, or a symbol PKG.NAME or method PKG.TYPE.METHOD
 :env [name]     show available functions, variables and constants
                 in current package, or from imported package "name"
+:expand1 CODE   expand the first macro call in CODE and show the result
+:expand CODE    expand the macro calls at top level of CODE, showing each step
+:expandall CODE expand all macro calls in CODE, including nested ones, showing each step
 :help           print this help
 :inspect EXPR   inspect expression interactively
 :options [OPTS] show or toggle interpreter options
 :quit           quit the interpreter
+:reimport PATH  rebuild and reload imported package PATH after its source code changed
 :write [FILE]   write collected declarations and/or statements to standard output or to file
                 use :o Declarations and/or :o Statements to start collecting them
 `)
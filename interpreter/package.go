@@ -60,7 +60,9 @@ func (env *Env) ChangePackage(name string) *Env {
 
 	nenv := NewEnv(fenv.TopEnv(), name)
 	nenv.Package.Init()
-	nenv.Package.Merge(imports.Packages[name])
+	if pkg, ok := imports.Lookup(name); ok {
+		nenv.Package.Merge(pkg)
+	}
 	nenv.InterpreterCommon.Packagename = name
 
 	return nenv
@@ -27,12 +27,10 @@
 package interpreter
 
 import (
-	"fmt"
 	"io"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"plugin"
-	"strings"
 )
 
 func getGoPath() string {
@@ -51,32 +49,30 @@ func getGoSrcPath() string {
 	return getGoPath() + "/src"
 }
 
-func (o *output) compilePlugin(filename string, stdout io.Writer, stderr io.Writer) string {
-	gosrcdir := getGoSrcPath()
-	gosrclen := len(gosrcdir)
-	filelen := len(filename)
-	if filelen < gosrclen || filename[0:gosrclen] != gosrcdir {
-		errorf("source %q is in unsupported directory, cannot compile it: should be inside %q", filename, gosrcdir)
-	}
-
-	cmd := exec.Command("go", "build", "-buildmode=plugin")
-	cmd.Dir = filename[0 : 1+strings.LastIndexByte(filename, '/')]
-	cmd.Stdin = nil
-	cmd.Stdout = stdout
-	cmd.Stderr = stderr
+// compilePlugin compiles the directory containing filename with "go build -buildmode=plugin".
+// The directory can be either in $GOPATH/src or a directory generated by prepareImportModule().
+// If pluginpath is not empty, it overrides the plugin path: plugins cannot be unloaded,
+// and loading again a plugin with the same path returns the already loaded one
+func (o *output) compilePlugin(filename string, pluginpath string, stdout io.Writer, stderr io.Writer) string {
+	dirname := filepath.Dir(filename)
+	// use innermost directory name as shared object name,
+	// i.e.	foo/bar/main.go is compiled to foo/bar/bar.so
+	soname := filepath.Join(dirname, filepath.Base(dirname)+".so")
 
 	o.debugf("compiling %q ...", filename)
-	err := cmd.Run()
+	args := []string{"build", "-buildmode=plugin", "-o", soname}
+	if len(pluginpath) != 0 {
+		args = append(args, "-ldflags=-pluginpath="+pluginpath)
+	}
+	if len(importVendorModule(dirname)) != 0 {
+		// the build runs in the current module, not in dirname
+		args = append(args, filename)
+	}
+	err := goCommand(dirname, false, stdout, stderr, args...)
 	if err != nil {
-		errorf("error executing \"go build -buildmode=plugin\" in directory %q: %v", cmd.Dir, err)
+		errorf("%v", err)
 	}
-
-	dirname := filename[:strings.LastIndexByte(filename, '/')]
-	// go build uses innermost directory name as shared object name,
-	// i.e.	foo/bar/main.go is compiled to foo/bar/bar.so
-	filename = dirname[1+strings.LastIndexByte(dirname, '/'):]
-
-	return fmt.Sprintf("%s/%s.so", dirname, filename)
+	return soname
 }
 
 func loadPlugin(soname string, symbolName string) interface{} {
@@ -0,0 +1,346 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * plugin_cache.go
+ *
+ *  Created on Apr 03, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// pluginCacheEntry describes a compiled import plugin stored in the plugin cache.
+// Entries are content-addressed: Key is a hash of all the other fields except SoHash and Created
+type pluginCacheEntry struct {
+	Key            string
+	Path           string // import path
+	Module         string // module containing the package, empty in GOPATH mode
+	Version        string // module version, empty if the package is not versioned
+	SourceHash     string // hash of the sources of unversioned packages, including dependencies
+	GoVersion      string
+	GomacroVersion string
+	SoName         string // shared object file name, relative to the entry directory
+	SoHash         string // hash of the shared object
+	Created        time.Time
+}
+
+const pluginCacheEntryFile = "entry.json"
+
+var gomacroVersionCache string
+
+// gomacroVersion returns a string identifying the running gomacro executable:
+// the module version or the VCS revision if available,
+// otherwise the size and modification time of the executable
+func gomacroVersion() string {
+	if len(gomacroVersionCache) != 0 {
+		return gomacroVersionCache
+	}
+	version := ""
+	if info, ok := debug.ReadBuildInfo(); ok {
+		if v := info.Main.Version; len(v) != 0 && v != "(devel)" {
+			version = v
+		}
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if len(version) == 0 {
+					version = setting.Value
+				}
+			case "vcs.modified":
+				if setting.Value == "true" {
+					version = ""
+				}
+			}
+		}
+	}
+	if len(version) == 0 {
+		version = "unknown"
+		if exe, err := os.Executable(); err == nil {
+			if info, err := os.Stat(exe); err == nil {
+				version = fmt.Sprintf("exe-%d-%d", info.Size(), info.ModTime().UnixNano())
+			}
+		}
+	}
+	gomacroVersionCache = version
+	return version
+}
+
+func getPluginCacheDir() string {
+	return filepath.Join(getGomacroCacheDir(), "plugins")
+}
+
+// newPluginCacheEntry computes the cache key of the plugin that imports package 'path',
+// as resolved by "go list" from directory dir
+func (ir *InterpreterCommon) newPluginCacheEntry(dir string, path string) *pluginCacheEntry {
+	list, err := goList(dir, "-deps", path)
+	if err != nil {
+		ir.errorf("error listing dependencies of package %q: %v", path, err)
+		return nil
+	}
+	e := &pluginCacheEntry{
+		Path:           path,
+		GoVersion:      runtime.Version(),
+		GomacroVersion: gomacroVersion(),
+	}
+	keyhash := sha256.New()
+	srchash := sha256.New()
+	hashSources := false
+	for _, pkg := range list {
+		if pkg.Standard {
+			// covered by GoVersion
+			continue
+		}
+		mod := pkg.Module
+		if mod != nil && mod.Replace != nil {
+			mod = mod.Replace
+		}
+		if pkg.ImportPath == path && mod != nil {
+			e.Module = mod.Path
+			e.Version = mod.Version
+		}
+		if mod != nil && len(mod.Version) != 0 {
+			fmt.Fprintf(keyhash, "%s %s@%s\n", pkg.ImportPath, mod.Path, mod.Version)
+			continue
+		}
+		// not versioned: use its sources
+		hashSources = true
+		fmt.Fprintf(srchash, "%s\n", pkg.ImportPath)
+		for _, name := range append(pkg.GoFiles, pkg.CgoFiles...) {
+			filename := filepath.Join(pkg.Dir, name)
+			if err := hashFile(srchash, filename); err != nil {
+				ir.errorf("error reading file %q: %v", filename, err)
+			}
+		}
+	}
+	if hashSources {
+		e.SourceHash = hex.EncodeToString(srchash.Sum(nil))
+	}
+	fmt.Fprintf(keyhash, "path %s\nsource %s\ngo %s %s/%s\ngomacro %s\n",
+		e.Path, e.SourceHash, e.GoVersion, runtime.GOOS, runtime.GOARCH, e.GomacroVersion)
+	e.Key = hex.EncodeToString(keyhash.Sum(nil))[:32]
+	return e
+}
+
+func hashFile(h hash.Hash, filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fmt.Fprintf(h, "%s\n", filepath.Base(filename))
+	_, err = io.Copy(h, f)
+	return err
+}
+
+func (e *pluginCacheEntry) dir() string {
+	return filepath.Join(getPluginCacheDir(), e.Key)
+}
+
+// lookup returns the shared object of the cache entry,
+// or "" if it is not in the cache or fails verification
+func (e *pluginCacheEntry) lookup() string {
+	stored, err := readPluginCacheEntry(e.dir())
+	if err != nil {
+		return ""
+	}
+	if stored.verify() != nil {
+		return ""
+	}
+	return filepath.Join(e.dir(), stored.SoName)
+}
+
+// store copies the compiled shared object soname into the cache entry
+func (e *pluginCacheEntry) store(soname string) (string, error) {
+	dir := e.dir()
+	tmpdir := dir + ".tmp"
+	os.RemoveAll(tmpdir)
+	if err := os.MkdirAll(tmpdir, 0700); err != nil {
+		return "", err
+	}
+	data, err := ioutil.ReadFile(soname)
+	if err != nil {
+		return "", err
+	}
+	e.SoName = filepath.Base(soname)
+	if err = ioutil.WriteFile(filepath.Join(tmpdir, e.SoName), data, 0600); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	e.SoHash = hex.EncodeToString(sum[:])
+	e.Created = time.Now()
+	data, err = json.MarshalIndent(e, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	if err = ioutil.WriteFile(filepath.Join(tmpdir, pluginCacheEntryFile), data, 0600); err != nil {
+		return "", err
+	}
+	os.RemoveAll(dir)
+	if err = os.Rename(tmpdir, dir); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, e.SoName), nil
+}
+
+// verify checks that the shared object of a cache entry exists and matches its hash
+func (e *pluginCacheEntry) verify() error {
+	if len(e.SoName) == 0 || e.SoName != filepath.Base(e.SoName) {
+		return fmt.Errorf("invalid shared object name %q", e.SoName)
+	}
+	f, err := os.Open(filepath.Join(getPluginCacheDir(), e.Key, e.SoName))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != e.SoHash {
+		return fmt.Errorf("shared object hash mismatch: have %s, want %s", sum, e.SoHash)
+	}
+	return nil
+}
+
+// stale returns true if the cache entry was created by a different Go or gomacro version
+func (e *pluginCacheEntry) stale() bool {
+	return e.GoVersion != runtime.Version() || e.GomacroVersion != gomacroVersion()
+}
+
+func readPluginCacheEntry(dir string) (*pluginCacheEntry, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, pluginCacheEntryFile))
+	if err != nil {
+		return nil, err
+	}
+	e := &pluginCacheEntry{}
+	if err = json.Unmarshal(data, e); err != nil {
+		return nil, err
+	}
+	if e.Key != filepath.Base(dir) {
+		return nil, fmt.Errorf("entry key %q does not match its directory", e.Key)
+	}
+	return e, nil
+}
+
+// readPluginCache returns the names of all the directories in the plugin cache
+// and the corresponding entries. Unreadable entries are nil
+func readPluginCache() ([]string, []*pluginCacheEntry) {
+	infos, err := ioutil.ReadDir(getPluginCacheDir())
+	if err != nil {
+		return nil, nil
+	}
+	var names []string
+	var entries []*pluginCacheEntry
+	for _, info := range infos {
+		if !info.IsDir() || strings.HasSuffix(info.Name(), ".tmp") {
+			continue
+		}
+		e, _ := readPluginCacheEntry(filepath.Join(getPluginCacheDir(), info.Name()))
+		names = append(names, info.Name())
+		entries = append(entries, e)
+	}
+	return names, entries
+}
+
+func (e *pluginCacheEntry) String() string {
+	version := e.Version
+	if len(e.SourceHash) != 0 {
+		if len(version) != 0 {
+			version += " "
+		}
+		version += "source " + e.SourceHash[:12]
+	}
+	return fmt.Sprintf("%s  %s %s  %s  %s", e.Key, e.Path, version, e.GoVersion, e.Created.Format("2006-01-02 15:04"))
+}
+
+// pluginCacheCommand implements the REPL command ":cache [list|verify|purge [stale|PATH]]"
+func (ir *InterpreterCommon) pluginCacheCommand(arg string) {
+	args := strings.Fields(arg)
+	if len(args) == 0 {
+		args = []string{"list"}
+	}
+	out := ir.Stdout
+	names, entries := readPluginCache()
+	switch args[0] {
+	case "list":
+		fmt.Fprintf(out, "// plugin cache %q: %d entries\n", getPluginCacheDir(), len(names))
+		for i, e := range entries {
+			if e == nil {
+				fmt.Fprintf(out, "%s  <invalid>\n", names[i])
+			} else if e.stale() {
+				fmt.Fprintf(out, "%v  <stale>\n", e)
+			} else {
+				fmt.Fprintf(out, "%v\n", e)
+			}
+		}
+	case "verify":
+		bad := 0
+		for i, e := range entries {
+			err := fmt.Errorf("cannot read %s", pluginCacheEntryFile)
+			if e != nil {
+				err = e.verify()
+			}
+			if err != nil {
+				fmt.Fprintf(out, "%s  <invalid>: %v\n", names[i], err)
+				bad++
+			} else if e.stale() {
+				fmt.Fprintf(out, "%s  <stale>: created by %s, gomacro %s\n", names[i], e.GoVersion, e.GomacroVersion)
+				bad++
+			}
+		}
+		fmt.Fprintf(out, "// plugin cache: %d entries verified, %d invalid or stale\n", len(names), bad)
+	case "purge":
+		purged := 0
+		for i, e := range entries {
+			if len(args) > 1 {
+				if args[1] == "stale" {
+					if e != nil && e.verify() == nil && !e.stale() {
+						continue
+					}
+				} else if e == nil || e.Path != args[1] {
+					continue
+				}
+			}
+			dir := filepath.Join(getPluginCacheDir(), names[i])
+			if err := os.RemoveAll(dir); err != nil {
+				ir.warnf("error removing %q: %v", dir, err)
+				continue
+			}
+			purged++
+		}
+		fmt.Fprintf(out, "// plugin cache: %d entries purged\n", purged)
+	default:
+		ir.warnf("unknown :cache command %q, expecting one of: list verify purge", args[0])
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * plugin_cache_test.go
+ *
+ *  Created on Apr 03, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// usePluginCacheDir redirects the plugin cache to a temporary directory
+func usePluginCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	if !strings.HasPrefix(getPluginCacheDir(), dir) {
+		t.Skipf("cannot redirect the plugin cache on %s", runtime.GOOS)
+	}
+}
+
+// storePluginCacheEntry stores in the plugin cache a fake shared object with the given contents
+func storePluginCacheEntry(t *testing.T, key, path, goversion, contents string) *pluginCacheEntry {
+	soname := filepath.Join(t.TempDir(), "gomacro_import.so")
+	if err := ioutil.WriteFile(soname, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	e := &pluginCacheEntry{Key: key, Path: path, GoVersion: goversion, GomacroVersion: gomacroVersion()}
+	cachedname, err := e.store(soname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cachedname != filepath.Join(getPluginCacheDir(), key, "gomacro_import.so") {
+		t.Errorf("store: unexpected shared object name %q", cachedname)
+	}
+	return e
+}
+
+func TestPluginCacheKey(t *testing.T) {
+	gopath := t.TempDir()
+	t.Setenv("GOPATH", gopath)
+	t.Setenv("GO111MODULE", "off")
+	dir := filepath.Join(gopath, "src", "example.com", "cachepkg")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(dir, "a.go")
+	if err := ioutil.WriteFile(filename, []byte("package cachepkg\nconst N = 1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	env := New()
+	e1 := env.newPluginCacheEntry("", "example.com/cachepkg")
+	e2 := env.newPluginCacheEntry("", "example.com/cachepkg")
+	if len(e1.Key) != 32 || e1.Key != e2.Key {
+		t.Errorf("expecting the same 32-character key for the same sources, found %q and %q", e1.Key, e2.Key)
+	}
+	if len(e1.SourceHash) == 0 || len(e1.Version) != 0 || e1.GoVersion != runtime.Version() {
+		t.Errorf("unexpected cache entry for an unversioned package: %+v", e1)
+	}
+	if err := ioutil.WriteFile(filename, []byte("package cachepkg\nconst N = 2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if e3 := env.newPluginCacheEntry("", "example.com/cachepkg"); e3.Key == e1.Key || e3.SourceHash == e1.SourceHash {
+		t.Errorf("expecting a different key after the sources changed, found %q", e3.Key)
+	}
+}
+
+func TestPluginCacheStore(t *testing.T) {
+	usePluginCacheDir(t)
+	e := &pluginCacheEntry{Key: "0123456789abcdef0123456789abcdef"}
+	if soname := e.lookup(); len(soname) != 0 {
+		t.Errorf("lookup: expecting a miss on an empty cache, found %q", soname)
+	}
+	e = storePluginCacheEntry(t, e.Key, "example.com/foo", runtime.Version(), "fake shared object")
+	soname := e.lookup()
+	if soname != filepath.Join(e.dir(), "gomacro_import.so") {
+		t.Errorf("lookup: expecting a hit after store, found %q", soname)
+	}
+	stored, err := readPluginCacheEntry(e.dir())
+	if err != nil || stored.Path != e.Path || stored.SoHash != e.SoHash || stored.stale() {
+		t.Errorf("readPluginCacheEntry: unexpected entry %+v, error %v", stored, err)
+	}
+	// a corrupted shared object fails verification, and lookup ignores it
+	if err := ioutil.WriteFile(soname, []byte("corrupted"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := stored.verify(); err == nil || !strings.Contains(err.Error(), "hash mismatch") {
+		t.Errorf("verify: expecting a hash mismatch, found %v", err)
+	}
+	if soname := e.lookup(); len(soname) != 0 {
+		t.Errorf("lookup: expecting a miss on a corrupted entry, found %q", soname)
+	}
+	// an entry whose shared object name escapes its directory is invalid
+	stored.SoName = "../gomacro_import.so"
+	if err := stored.verify(); err == nil {
+		t.Errorf("verify: expecting an error for shared object name %q", stored.SoName)
+	}
+}
+
+func TestPluginCachePurge(t *testing.T) {
+	usePluginCacheDir(t)
+	storePluginCacheEntry(t, "00000000000000000000000000000001", "example.com/foo", runtime.Version(), "foo")
+	storePluginCacheEntry(t, "00000000000000000000000000000002", "example.com/bar", runtime.Version(), "bar")
+	storePluginCacheEntry(t, "00000000000000000000000000000003", "example.com/old", "go1.8", "old")
+	if err := os.MkdirAll(filepath.Join(getPluginCacheDir(), "invalid"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	env := New()
+	var buf bytes.Buffer
+	env.Stdout = &buf
+	run := func(cmd string, expected ...string) {
+		buf.Reset()
+		env.pluginCacheCommand(cmd)
+		for _, str := range expected {
+			if !strings.Contains(buf.String(), str) {
+				t.Errorf(":cache %s: expecting %q in the output, found:\n%s", cmd, str, buf.String())
+			}
+		}
+	}
+	run("list", "4 entries", "invalid  <invalid>", "example.com/old", "<stale>")
+	run("verify", "4 entries verified, 2 invalid or stale")
+	run("purge stale", "2 entries purged")
+	if names, _ := readPluginCache(); len(names) != 2 {
+		t.Errorf("expecting 2 entries after purging stale ones, found %v", names)
+	}
+	run("purge example.com/foo", "1 entries purged")
+	if names, _ := readPluginCache(); len(names) != 1 || names[0] != "00000000000000000000000000000002" {
+		t.Errorf("expecting only the entry of example.com/bar to remain, found %v", names)
+	}
+	run("purge", "1 entries purged")
+	if names, _ := readPluginCache(); len(names) != 0 {
+		t.Errorf("expecting an empty cache, found %v", names)
+	}
+}
@@ -0,0 +1,31 @@
+// +build !go1.8 !cgo !linux,!darwin,!freebsd
+
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * plugin_unsupported.go
+ *
+ *  Created on Apr 10, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+// pluginSupported is true if this gomacro can load plugins,
+// otherwise imported packages are interpreted, see importSourcePackage()
+const pluginSupported = false
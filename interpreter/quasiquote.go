@@ -76,6 +76,13 @@ func (env *Env) evalQuasiquote(node *ast.BlockStmt) ast.Node {
 	// reason: to support quasiquote{unquote_splice ...}
 	toUnwrap := node != simplifyNodeForQuote(node, true)
 
+	if env.macroHygiene {
+		saved := env.hygieneRenames
+		env.hygieneRenames = env.hygienicRenames(node)
+		defer func() {
+			env.hygieneRenames = saved
+		}()
+	}
 	in := ToAst(node)
 	out := env.evalQuasiquoteAst(in, 1)
 	ret := ToNode(out)
@@ -93,6 +100,11 @@ func (env *Env) evalQuasiquoteAst(in Ast, depth int) (out Ast) {
 	if !canSplice {
 		in = unwrapTrivialAst(in) // drill through DeclStmt, ExprStmt, ParenExpr, one-element BlockStmt
 	}
+	if ident, ok := in.(Ident); ok && env.hygieneRenames != nil {
+		if name, ok := env.hygieneRenames[ident.X]; ok {
+			return Ident{X: &ast.Ident{NamePos: ident.X.NamePos, Name: name}}
+		}
+	}
 	if in == nil || in.Size() == 0 {
 		return in
 	}
@@ -126,8 +138,14 @@ func (env *Env) evalQuasiquoteAst(in Ast, depth int) (out Ast) {
 
 		out := in.New()
 		ni := in.Size()
+		_, isSelector := in.(SelectorExpr)
 		for i := 0; i < ni; i++ {
 			child := in.Get(i)
+			if isSelector && i == 1 {
+				// field and method names are not renamed by hygiene
+				out.Set(i, child)
+				continue
+			}
 			if child == nil {
 				env.debugQuasiQuote("child is nil", depth, canSplice, child)
 			} else {
@@ -183,7 +201,12 @@ func (env *Env) evalQuasiquoteAst(in Ast, depth int) (out Ast) {
 					outSlice = outSlice.Append(child)
 				} else {
 					env.debugQuasiQuote("calling unquote on", depth-unquoteDepth, canSplice, lastUnquote.Interface())
-					toInsert := AnyToAst(env.evalUnquote(lastUnquote), mt.String(op))
+					var toInsert Ast
+					if op == mt.UNQUOTE {
+						toInsert = AnyToAst(env.evalUnquote(lastUnquote), mt.String(op))
+					} else {
+						toInsert = AnyToAstSplice(env.evalUnquote(lastUnquote), mt.String(op))
+					}
 					env.debugQuasiQuote("unquote returned", depth-unquoteDepth, canSplice, toInsert.Interface())
 					if op == mt.UNQUOTE {
 						stack := duplicateNestedUnquotes(child, unquoteDepth-1, toInsert)
@@ -328,7 +351,7 @@ func duplicateNestedUnquotes(src UnaryExpr, depth int, content Ast) Ast {
 // which represents quote{<form>}, into an Ast struct
 func makeQuote(form UnaryExpr) (UnaryExpr, BlockStmt) {
 	expr, block := mp.MakeQuote(nil, form.X.Op, form.X.OpPos, nil)
-	return UnaryExpr{expr}, BlockStmt{block}
+	return UnaryExpr{X: expr}, BlockStmt{X: block}
 }
 
 // MakeQuote2 invokes parser.MakeQuote() and wraps the resulting ast.Node,
@@ -342,5 +365,5 @@ func makeQuote2(form UnaryExpr, toQuote AstWithNode) UnaryExpr {
 	fmt.Printf("form   = %#v\n", form)
 	fmt.Printf("form.X = %#v\n", form.X)
 	expr, _ := mp.MakeQuote(nil, form.X.Op, form.X.OpPos, node)
-	return UnaryExpr{expr}
+	return UnaryExpr{X: expr}
 }
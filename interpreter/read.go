@@ -31,6 +31,8 @@ import (
 	"fmt"
 	"io"
 	r "reflect"
+	"strings"
+	"unicode"
 )
 
 func ReadBytes(src interface{}) []byte {
@@ -85,6 +87,61 @@ func ReadString(src interface{}) string {
 	return ""
 }
 
+// sourcePos tracks the position in the current file of the source being parsed:
+// ReadMultiline() splits a file in chunks, and each chunk is parsed separately
+type sourcePos struct {
+	lines  int // number of lines read so far
+	line   int // line where the chunk being parsed starts
+	column int // column where the chunk being parsed starts
+}
+
+// next records that chunk is the next one read from the current file.
+// The chunk starts at its first non-space character, since it is parsed after strings.TrimSpace()
+func (p *sourcePos) next(chunk string) {
+	p.line, p.column = p.lines+1, 1
+	p.skip(chunk[:len(chunk)-len(strings.TrimLeftFunc(chunk, unicode.IsSpace))])
+	p.lines += strings.Count(chunk, "\n")
+}
+
+// skip moves the start of the chunk being parsed after prefix
+func (p *sourcePos) skip(prefix string) {
+	for i := 0; i < len(prefix); i++ {
+		if prefix[i] == '\n' {
+			p.line++
+			p.column = 1
+		} else {
+			p.column++
+		}
+	}
+}
+
+// isCommentOnly returns true if src contains at least one comment, and nothing else except spaces
+func isCommentOnly(src string) bool {
+	hasComments := false
+	for {
+		src = strings.TrimLeftFunc(src, unicode.IsSpace)
+		switch {
+		case len(src) == 0:
+			return hasComments
+		case strings.HasPrefix(src, "//") || strings.HasPrefix(src, "#!"):
+			if end := strings.IndexByte(src, '\n'); end >= 0 {
+				src = src[end+1:]
+			} else {
+				src = ""
+			}
+		case strings.HasPrefix(src, "/*"):
+			end := strings.Index(src[2:], "*/")
+			if end < 0 {
+				return false
+			}
+			src = src[2+end+2:]
+		default:
+			return false
+		}
+		hasComments = true
+	}
+}
+
 func ReadMultiline(in *bufio.Reader, showPrompt bool, out io.Writer, prompt string) (string, error) {
 	var buf []byte
 	type Mode int
@@ -103,6 +160,10 @@ func ReadMultiline(in *bufio.Reader, showPrompt bool, out io.Writer, prompt stri
 	)
 	mode := mNormal
 	paren := 0
+	// when reading a file, comments followed only by other comments are joined with the next line,
+	// in order to keep doc comments together with the declaration they document.
+	// At the prompt, a line containing only comments is complete: the user expects an answer
+	onlyComments, hasComments := true, false
 
 	if showPrompt {
 		fmt.Fprint(out, prompt)
@@ -131,6 +192,9 @@ func ReadMultiline(in *bufio.Reader, showPrompt bool, out io.Writer, prompt stri
 				case '~':
 					mode = mTilde
 				}
+				if ch > ' ' && ch != '/' {
+					onlyComments = false
+				}
 			case mRune:
 				switch ch {
 				case '\\':
@@ -172,10 +236,13 @@ func ReadMultiline(in *bufio.Reader, showPrompt bool, out io.Writer, prompt stri
 				switch ch {
 				case '/':
 					mode = mLineComment
+					hasComments = true
 				case '*':
 					mode = mComment
+					hasComments = true
 				default:
 					mode = mNormal
+					onlyComments = false
 				}
 			case mLineComment:
 				switch ch {
@@ -195,11 +262,21 @@ func ReadMultiline(in *bufio.Reader, showPrompt bool, out io.Writer, prompt stri
 					mode = mComment
 				}
 			case mTilde:
+				// ~' and ~` are quote and quasiquote, not the start of a rune or raw string.
+				// other characters after ~ are parsed normally, as the block in ~{ ... }
 				mode = mNormal
+				switch ch {
+				case '(', '[', '{':
+					paren++
+				case ')', ']', '}':
+					paren--
+				case '"':
+					mode = mString
+				}
 			}
 		}
 		buf = append(buf, line...)
-		if paren <= 0 && mode == mNormal {
+		if paren <= 0 && mode == mNormal && !(onlyComments && hasComments && !showPrompt) {
 			break
 		}
 		if showPrompt {
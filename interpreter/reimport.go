@@ -0,0 +1,234 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * reimport.go
+ *
+ *  Created on Apr 09, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	r "reflect"
+	"sort"
+	"strings"
+
+	"github.com/cosmos72/gomacro/imports"
+)
+
+type importKind int
+
+const (
+	importPlugin   importKind = iota // compiled as a plugin and loaded
+	importSource                     // source code interpreted by importSourcePackage()
+	importLocalDir                   // local directory interpreted by importLocalDir()
+)
+
+// importOrigin remembers how a package was imported, so that Reimport() can repeat it
+type importOrigin struct {
+	kind       importKind
+	dir        string // directory passed to "go list", or the local directory
+	key        string // plugin cache key of the loaded plugin
+	version    int    // number of times the package was reimported
+	pkg        imports.Package
+	dotImports []*Env // environments where the package was imported with import . "path"
+}
+
+// packages imported as plugins or from source, by import path
+var importOrigins = make(map[string]*importOrigin)
+
+func recordImport(path string, kind importKind, dir string, key string, pkg imports.Package) {
+	origin := importOrigins[path]
+	if origin == nil {
+		origin = &importOrigin{}
+		importOrigins[path] = origin
+	}
+	origin.kind, origin.dir, origin.key, origin.pkg = kind, dir, key, pkg
+}
+
+// reimportCommand implements the REPL command ":reimport PATH",
+// where PATH is an import path or the name of an imported package
+func (env *Env) reimportCommand(arg string) {
+	arg = strings.TrimSpace(arg)
+	if len(arg) == 0 {
+		env.warnf("reimport: missing argument")
+		return
+	}
+	path := arg
+	if _, found := importOrigins[path]; !found {
+		if bind, found := env.resolveIdentifier(&ast.Ident{Name: arg}); found && bind.IsValid() && bind.CanInterface() {
+			if pkg, ok := bind.Interface().(*PackageRef); ok {
+				path = pkg.Path
+			}
+		}
+	}
+	env.Reimport(path)
+}
+
+// Reimport rebuilds and reloads package 'path' after its source code changed,
+// then rebinds the imports of 'path' visible from env.
+// Interpreted packages are evaluated again. Plugins cannot be unloaded,
+// so the new version of a plugin is loaded under a different plugin path, or interpreted
+// if Go refuses to load it because it contains a different version of an already loaded package.
+// Values created from the previous version cannot be updated: a warning lists them
+func (env *Env) Reimport(path string) *PackageRef {
+	origin := importOrigins[path]
+	if origin == nil {
+		if _, ok := imports.Lookup(path); ok {
+			env.errorf("cannot reimport package %q: it is linked into gomacro", path)
+		}
+		env.errorf("cannot reimport package %q: not imported", path)
+		return nil
+	}
+	old := origin.pkg
+	origin.version++
+	// the macro libraries may have changed too
+	delete(env.macroLibraries, path)
+	name := path[1+strings.LastIndexByte(path, '/'):]
+	var ref *PackageRef
+	switch origin.kind {
+	case importLocalDir:
+		delete(imports.Packages, path)
+		ref = env.importLocalDir(name, path, origin.dir)
+	case importSource:
+		delete(imports.Packages, path)
+		ref = env.importSourcePackage(name, path, origin.dir)
+	default:
+		ref = env.reimportPlugin(name, path, origin)
+	}
+	if ref == nil {
+		return nil
+	}
+	env.rebindImport(ref, old, origin.dotImports)
+	env.warnStaleValues(path, old, ref.Package)
+	return ref
+}
+
+// reimportPlugin compiles again the plugin for package 'path' under a versioned plugin path,
+// because loading a plugin with the same path would return the one already loaded.
+// The plugin cache is bypassed: a cached plugin may have been built with a plugin path
+// already loaded in this process, and a versioned plugin path must not be reused by later sessions
+func (env *Env) reimportPlugin(name, path string, origin *importOrigin) *PackageRef {
+	cached := env.newPluginCacheEntry(origin.dir, path)
+	if cached.Key == origin.key {
+		env.warnf("reimport: package %q is unchanged", path)
+		ref := &PackageRef{Package: origin.pkg, Name: name, Path: path}
+		env.importMacroLibrary(ref, packageDir(path, origin.dir))
+		return ref
+	}
+	pkg := env.loadImportTypes(env.goModFile(), origin.dir, path)
+	filename := env.createImportFile(path, pkg, origin.dir, false)
+	pluginpath := fmt.Sprintf("gomacro_reimport/%s/r%d", path, origin.version)
+	ref, rec := env.compileImportPlugin(name, path, filename, pluginpath, nil)
+	if rec != nil {
+		env.warnf("cannot reimport package %q as a plugin, interpreting its source code instead: %v", path, rec)
+		return env.importSourcePackage(name, path, origin.dir)
+	}
+	env.importMacroLibrary(ref, packageDir(path, origin.dir))
+	recordImport(path, importPlugin, origin.dir, cached.Key, ref.Package)
+	return ref
+}
+
+// rebindImport replaces the imports of ref.Path visible from env, and the declarations
+// copied by import . "path", with the new version of the package
+func (env *Env) rebindImport(ref *PackageRef, old imports.Package, dotImports []*Env) {
+	for e := env; e != nil; e = e.Outer {
+		for name, bind := range e.Binds {
+			if !bind.IsValid() || !bind.CanInterface() {
+				continue
+			}
+			if pkg, ok := bind.Interface().(*PackageRef); ok && pkg.Path == ref.Path {
+				newpkg := &PackageRef{Package: ref.Package, Name: pkg.Name, Path: pkg.Path}
+				e.Binds[name] = r.ValueOf(newpkg)
+			}
+		}
+	}
+	for _, e := range dotImports {
+		for name := range old.Binds {
+			if _, ok := ref.Binds[name]; !ok {
+				env.warnf("reimport: %s.%s no longer exists, removing it", ref.Path, name)
+				delete(e.Binds, name)
+			}
+		}
+		for name := range old.Types {
+			if _, ok := ref.Types[name]; !ok {
+				env.warnf("reimport: type %s.%s no longer exists, removing it", ref.Path, name)
+				delete(e.Types, name)
+			}
+		}
+		for name := range old.Untypeds {
+			delete(e.Untypeds, name)
+		}
+		for name, bind := range ref.Binds {
+			e.Binds[name] = bind
+		}
+		for name, t := range ref.Types {
+			e.Types[name] = t
+		}
+		for name, c := range ref.Untypeds {
+			e.Untypeds[name] = c
+		}
+	}
+}
+
+// warnStaleValues warns about the variables visible from env whose type
+// comes from the previous version of package 'path'
+func (env *Env) warnStaleValues(path string, old imports.Package, pkg imports.Package) {
+	staleTypes := make(map[r.Type]string)
+	for name, t := range old.Types {
+		// predeclared types, as int or string, are not stale even if an interpreted
+		// type declaration now uses a different one
+		if t != pkg.Types[name] && (len(t.Name()) == 0 || len(t.PkgPath()) != 0) {
+			staleTypes[t] = name
+		}
+	}
+	if len(staleTypes) == 0 {
+		return
+	}
+	var stale []string
+	for e := env; e != nil; e = e.Outer {
+		for name, bind := range e.Binds {
+			if !bind.IsValid() {
+				continue
+			}
+			if typename, ok := staleTypes[elemType(bind.Type())]; ok {
+				stale = append(stale, fmt.Sprintf("%s <%s.%s>", name, path, typename))
+			}
+		}
+	}
+	if len(stale) != 0 {
+		sort.Strings(stale)
+		env.warnf("reimport: these variables still hold values from the previous version of package %q: %s",
+			path, strings.Join(stale, ", "))
+	}
+}
+
+// elemType strips pointers, slices, arrays and maps from t
+func elemType(t r.Type) r.Type {
+	for {
+		switch t.Kind() {
+		case r.Ptr, r.Slice, r.Array, r.Map, r.Chan:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
@@ -74,6 +74,13 @@ func (env *Env) evalStatements(list []ast.Stmt) (r.Value, []r.Value) {
 }
 
 func (env *Env) evalStatement(node ast.Stmt) (r.Value, []r.Value) {
+	if exp := env.expansionOf(node); exp != nil {
+		defer func() {
+			if rec := recover(); rec != nil {
+				panic(env.annotateExpansion(rec, exp))
+			}
+		}()
+	}
 	switch node := node.(type) {
 	case *ast.AssignStmt:
 		return env.evalAssignments(node)
@@ -184,5 +191,9 @@ func (env *Env) evalReturn(node *ast.ReturnStmt) (r.Value, []r.Value) {
 	} else {
 		rets = env.evalExprs(node.Results)
 	}
+	// return copies: deferred functions may modify the returned variables
+	for i := range rets {
+		rets[i] = copyValue(rets[i])
+	}
 	panic(eReturn{rets})
 }
@@ -25,6 +25,7 @@
 package interpreter
 
 import (
+	"bytes"
 	"strconv"
 )
 
@@ -107,6 +108,20 @@ func findFirstToken(src []byte) int {
 	return n
 }
 
+// hasPackageClause returns true if the first token in src,
+// ignoring comments and an initial #! line, is the keyword "package"
+func hasPackageClause(src []byte) bool {
+	if bytes.HasPrefix(src, []byte("#!")) {
+		nl := bytes.IndexByte(src, '\n')
+		if nl < 0 {
+			return false
+		}
+		src = src[nl+1:]
+	}
+	src = src[findFirstToken(src):]
+	return string(extractFirstIdentifier(src)) == "package"
+}
+
 func extractFirstIdentifier(src []byte) []byte {
 	n := len(src)
 	for i := 0; i < n; i++ {
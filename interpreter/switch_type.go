@@ -46,7 +46,10 @@ func (env *Env) evalTypeSwitch(node *ast.TypeSwitchStmt) (ret r.Value, rets []r.
 	if val != None && val != Nil {
 		// go through interface{} to obtain actual concrete type
 		val = r.ValueOf(val.Interface())
-		vt = val.Type()
+		if val.IsValid() {
+			// a nil interface has no concrete type
+			vt = val.Type()
+		}
 	}
 	var default_ *ast.CaseClause
 	for _, stmt := range node.Body.List {
@@ -104,7 +107,7 @@ func (env *Env) typecaseMatches(vt r.Type, list []ast.Expr) (r.Type, bool) {
 			if vt == nil {
 				return typeOfInterface, true
 			}
-		} else if vt.AssignableTo(t) {
+		} else if vt != nil && vt.AssignableTo(t) {
 			return t, true
 		}
 	}
@@ -292,6 +292,18 @@ func (env *Env) valueToType(value r.Value, t r.Type) r.Value {
 	return newValue
 }
 
+// copyValue returns a copy of value if it is addressable, i.e. if it may alias
+// a variable, an array element or a struct field. Needed to preserve Go value semantics
+// for arrays and structs, which reflect.Value otherwise happily shares
+func copyValue(value r.Value) r.Value {
+	if value.CanAddr() && value.CanInterface() {
+		ret := r.New(value.Type()).Elem()
+		ret.Set(value)
+		return ret
+	}
+	return value
+}
+
 func differentIntegerValues(v1 r.Value, v2 r.Value) bool {
 	k1, k2 := v1.Kind(), v2.Kind()
 	switch k1 {
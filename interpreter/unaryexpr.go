@@ -78,6 +78,9 @@ func (env *Env) evalUnaryExpr(node *ast.UnaryExpr) (r.Value, []r.Value) {
 		ret := env.evalQuasiquote(block)
 		return r.ValueOf(ret), nil
 
+	case mt.INTERPRET_ONLY:
+		return env.evalInterpretOnly(node)
+
 	case mt.UNQUOTE, mt.UNQUOTE_SPLICE:
 		return env.errorf("%s not inside quasiquote: %v <%v>", mt.String(op), node, r.TypeOf(node))
 	}
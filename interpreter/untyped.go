@@ -0,0 +1,396 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http//www.gnu.org/licenses/>.
+ *
+ * untyped.go
+ *
+ *  Created on Apr 08, 2017
+ *      Author Massimiliano Ghilardi
+ */
+
+package interpreter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"math"
+	r "reflect"
+
+	"github.com/cosmos72/gomacro/imports"
+)
+
+// evalUntyped evaluates node as an untyped constant expression, with exact arithmetic as the Go compiler.
+// Returns false if node is not an untyped constant expression.
+// Operands can be literals, iota, untyped constants declared with "const"
+// and untyped constants of imported packages, as math.Pi or math.MaxUint64
+func (env *Env) evalUntyped(in ast.Expr) (imports.UntypedConst, bool) {
+	switch node := in.(type) {
+	case *ast.BasicLit:
+		return untypedLiteral(node)
+	case *ast.ParenExpr:
+		return env.evalUntyped(node.X)
+	case *ast.Ident:
+		return env.resolveUntyped(node)
+	case *ast.SelectorExpr:
+		pkgident, ok := node.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		bind, found := env.resolveIdentifier(pkgident)
+		if !found || bind.Kind() != r.Ptr {
+			break
+		}
+		if pkg, ok := bind.Interface().(*PackageRef); ok {
+			c, ok := pkg.Untypeds[node.Sel.Name]
+			return c, ok
+		}
+	case *ast.UnaryExpr:
+		switch node.Op {
+		case token.ADD, token.SUB, token.XOR, token.NOT:
+			x, ok := env.evalUntyped(node.X)
+			if !ok {
+				break
+			}
+			return env.evalUntypedUnaryExpr(node.Op, x), true
+		}
+	case *ast.BinaryExpr:
+		x, ok := env.evalUntyped(node.X)
+		if !ok {
+			break
+		}
+		y, ok := env.evalUntyped(node.Y)
+		if !ok {
+			break
+		}
+		return env.evalUntypedBinaryExpr(x, node.Op, y), true
+	}
+	return imports.UntypedConst{}, false
+}
+
+func untypedLiteral(node *ast.BasicLit) (imports.UntypedConst, bool) {
+	var kind r.Kind
+	switch node.Kind {
+	case token.INT:
+		kind = r.Int
+	case token.FLOAT:
+		kind = r.Float64
+	case token.IMAG:
+		kind = r.Complex128
+	case token.CHAR:
+		kind = r.Int32
+	case token.STRING:
+		kind = r.String
+	default:
+		return imports.UntypedConst{}, false
+	}
+	val := constant.MakeFromLiteral(node.Value, node.Kind, 0)
+	if val.Kind() == constant.Unknown {
+		return imports.UntypedConst{}, false
+	}
+	return imports.UntypedConst{Kind: kind, Value: val}, true
+}
+
+// resolveUntyped searches the untyped constant 'ident' in env and its outer Envs.
+// It stops at the first Env that declares 'ident', in order to honour shadowing
+func (env *Env) resolveUntyped(ident *ast.Ident) (imports.UntypedConst, bool) {
+	name := ident.Name
+	if name == "iota" {
+		pos := env.Fileset.Position(ident.NamePos)
+		return imports.UntypedConst{Kind: r.Int, Value: constant.MakeInt64(int64(pos.Line - env.iotaOffset))}, true
+	}
+	for e := env; e != nil; e = e.Outer {
+		if c, ok := e.Untypeds[name]; ok {
+			return c, true
+		}
+		if _, ok := e.Binds[name]; ok {
+			break
+		}
+	}
+	return imports.UntypedConst{}, false
+}
+
+func (env *Env) evalUntypedUnaryExpr(op token.Token, x imports.UntypedConst) imports.UntypedConst {
+	switch op {
+	case token.NOT:
+		if x.Kind != r.Bool {
+			env.errorf("invalid operation: operator ! not defined on untyped constant %v", x.Value)
+		}
+	case token.XOR:
+		if !isUntypedInteger(x) {
+			env.errorf("invalid operation: operator ^ not defined on untyped constant %v", x.Value)
+		}
+	default:
+		if !isUntypedNumber(x) {
+			env.errorf("invalid operation: operator %v not defined on untyped constant %v", op, x.Value)
+		}
+	}
+	// prec = 0: untyped constants have unlimited precision
+	x.Value = constant.UnaryOp(op, x.Value, 0)
+	return x
+}
+
+func (env *Env) evalUntypedBinaryExpr(x imports.UntypedConst, op token.Token, y imports.UntypedConst) imports.UntypedConst {
+	switch op {
+	case token.SHL, token.SHR:
+		if !isUntypedInteger(x) {
+			x.Value = constant.ToInt(x.Value)
+			if x.Value.Kind() != constant.Int {
+				env.errorf("invalid shift: untyped constant %v truncated to integer", x.Value)
+			}
+			x.Kind = r.Int
+		}
+		s, exact := constant.Uint64Val(constant.ToInt(y.Value))
+		if !exact || !isUntypedNumber(y) || s > 10000 {
+			env.errorf("invalid shift count: %v", y.Value)
+		}
+		x.Value = constant.Shift(x.Value, op, uint(s))
+		return x
+
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		env.checkUntypedOperands(x, op, y)
+		return imports.UntypedConst{Kind: r.Bool, Value: constant.MakeBool(constant.Compare(x.Value, op, y.Value))}
+
+	case token.LAND, token.LOR:
+		if x.Kind != r.Bool || y.Kind != r.Bool {
+			env.errorf("invalid operation: operator %v not defined on untyped constants %v and %v", op, x.Value, y.Value)
+		}
+	}
+	kind := env.checkUntypedOperands(x, op, y)
+	if op == token.QUO {
+		if constant.Sign(y.Value) == 0 {
+			env.errorf("division by zero: %v / %v", x.Value, y.Value)
+		}
+		if kind == r.Int || kind == r.Int32 {
+			// integer division
+			op = token.QUO_ASSIGN
+		}
+	} else if op == token.REM && constant.Sign(y.Value) == 0 {
+		env.errorf("division by zero: %v %% %v", x.Value, y.Value)
+	}
+	return imports.UntypedConst{Kind: kind, Value: constant.BinaryOp(x.Value, op, y.Value)}
+}
+
+// checkUntypedOperands checks that x and y are compatible, and returns the kind of the result
+func (env *Env) checkUntypedOperands(x imports.UntypedConst, op token.Token, y imports.UntypedConst) r.Kind {
+	if isUntypedNumber(x) && isUntypedNumber(y) {
+		// the result has the "largest" kind among: Int, Int32, Float64, Complex128
+		kind := x.Kind
+		if untypedRank(y.Kind) > untypedRank(kind) {
+			kind = y.Kind
+		}
+		if (op == token.REM || op == token.AND || op == token.OR || op == token.XOR || op == token.AND_NOT) &&
+			(kind != r.Int && kind != r.Int32) {
+			env.errorf("invalid operation: operator %v not defined on untyped constants %v and %v", op, x.Value, y.Value)
+		}
+		return kind
+	}
+	if x.Kind != y.Kind || (x.Kind == r.String && op != token.ADD && !isComparison(op)) ||
+		(x.Kind == r.Bool && op != token.EQL && op != token.NEQ && op != token.LAND && op != token.LOR) {
+		env.errorf("invalid operation: mismatched untyped constants %v %v %v", x.Value, op, y.Value)
+	}
+	return x.Kind
+}
+
+func isComparison(op token.Token) bool {
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+func untypedRank(kind r.Kind) int {
+	switch kind {
+	case r.Int:
+		return 0
+	case r.Int32:
+		return 1
+	case r.Float64:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func isUntypedNumber(c imports.UntypedConst) bool {
+	switch c.Kind {
+	case r.Int, r.Int32, r.Float64, r.Complex128:
+		return true
+	}
+	return false
+}
+
+func isUntypedInteger(c imports.UntypedConst) bool {
+	return c.Kind == r.Int || c.Kind == r.Int32
+}
+
+// untypedDefault converts an untyped constant to its default type
+func (env *Env) untypedDefault(c imports.UntypedConst) r.Value {
+	v, err := untypedToDefault(c)
+	if err != nil {
+		env.errorf("%v", err)
+	}
+	return v
+}
+
+// untypedToDefault converts an untyped constant to its default type.
+// As evalLiteral0(), integer constants that do not fit an int use int64 or uint64
+func untypedToDefault(c imports.UntypedConst) (r.Value, error) {
+	var t r.Type
+	switch c.Kind {
+	case r.Bool:
+		t = typeOfBool
+	case r.Int:
+		if v, err := untypedToType(c, typeOfInt); err == nil {
+			return v, nil
+		} else if v, err := untypedToType(c, typeOfInt64); err == nil {
+			return v, nil
+		} else if _, err := untypedToType(c, typeOfUint64); err != nil {
+			return Nil, fmt.Errorf("constant %v overflows <int>", c.Value)
+		}
+		t = typeOfUint64
+	case r.Int32:
+		t = typeOfRune
+	case r.Float64:
+		t = typeOfFloat64
+	case r.Complex128:
+		t = typeOfComplex128
+	case r.String:
+		t = typeOfString
+	}
+	return untypedToType(c, t)
+}
+
+// evalExprTo evaluates node and converts the result to type t.
+// Untyped constant expressions are converted exactly, as the Go compiler does
+func (env *Env) evalExprTo(node ast.Expr, t r.Type) r.Value {
+	if c, ok := env.evalUntyped(node); ok {
+		if t.Kind() == r.Interface {
+			return env.valueToType(env.untypedDefault(c), t)
+		}
+		v, err := untypedToType(c, t)
+		if err != nil {
+			env.errorf("%v", err)
+		}
+		return v
+	}
+	return env.valueToType(env.evalExpr1(node), t)
+}
+
+// evalBinaryOperands evaluates the operands of a binary expression that is not constant.
+// As in compiled Go, an untyped constant operand is converted to the type of the other operand,
+// except for shift counts
+func (env *Env) evalBinaryOperands(node *ast.BinaryExpr) (xv r.Value, yv r.Value) {
+	shift := node.Op == token.SHL || node.Op == token.SHR
+	if !shift {
+		if x, ok := env.evalUntyped(node.X); ok {
+			yv = env.evalExpr1(node.Y)
+			return env.untypedToTypeOf(x, yv), yv
+		}
+	}
+	xv = env.evalExpr1(node.X)
+	if y, ok := env.evalUntyped(node.Y); ok {
+		if shift {
+			yv = env.untypedDefault(y)
+		} else {
+			yv = env.untypedToTypeOf(y, xv)
+		}
+	} else {
+		yv = env.evalExpr1(node.Y)
+	}
+	return xv, yv
+}
+
+// untypedToTypeOf converts the untyped constant c to the type of the other operand v
+// of a binary expression. Falls back on the default type if the conversion is not possible
+func (env *Env) untypedToTypeOf(c imports.UntypedConst, v r.Value) r.Value {
+	if v.IsValid() && v.Kind() != r.Interface {
+		if ret, err := untypedToType(c, v.Type()); err == nil {
+			return ret
+		}
+	}
+	return env.untypedDefault(c)
+}
+
+// untypedToType converts the untyped constant c to type t, failing if c is not exactly representable by t
+func untypedToType(c imports.UntypedConst, t r.Type) (r.Value, error) {
+	val := c.Value
+	ret := r.New(t).Elem()
+	switch t.Kind() {
+	case r.Bool:
+		if val.Kind() == constant.Bool {
+			ret.SetBool(constant.BoolVal(val))
+			return ret, nil
+		}
+	case r.Int, r.Int8, r.Int16, r.Int32, r.Int64:
+		if !isUntypedNumber(c) {
+			break
+		}
+		if ival := constant.ToInt(val); ival.Kind() != constant.Int {
+			return Nil, fmt.Errorf("constant %v truncated to integer", val)
+		} else if i, exact := constant.Int64Val(ival); !exact || ret.OverflowInt(i) {
+			return Nil, fmt.Errorf("constant %v overflows <%v>", val, t)
+		} else {
+			ret.SetInt(i)
+			return ret, nil
+		}
+	case r.Uint, r.Uint8, r.Uint16, r.Uint32, r.Uint64, r.Uintptr:
+		if !isUntypedNumber(c) {
+			break
+		}
+		if ival := constant.ToInt(val); ival.Kind() != constant.Int {
+			return Nil, fmt.Errorf("constant %v truncated to integer", val)
+		} else if u, exact := constant.Uint64Val(ival); !exact || constant.Sign(ival) < 0 || ret.OverflowUint(u) {
+			return Nil, fmt.Errorf("constant %v overflows <%v>", val, t)
+		} else {
+			ret.SetUint(u)
+			return ret, nil
+		}
+	case r.Float32, r.Float64:
+		if !isUntypedNumber(c) {
+			break
+		}
+		if fval := constant.ToFloat(val); fval.Kind() != constant.Float && fval.Kind() != constant.Int {
+			return Nil, fmt.Errorf("constant %v truncated to real", val)
+		} else if f, _ := constant.Float64Val(fval); math.IsInf(f, 0) || ret.OverflowFloat(f) {
+			return Nil, fmt.Errorf("constant %v overflows <%v>", val, t)
+		} else {
+			ret.SetFloat(f)
+			return ret, nil
+		}
+	case r.Complex64, r.Complex128:
+		if !isUntypedNumber(c) {
+			break
+		}
+		cval := constant.ToComplex(val)
+		re, _ := constant.Float64Val(constant.Real(cval))
+		im, _ := constant.Float64Val(constant.Imag(cval))
+		if math.IsInf(re, 0) || math.IsInf(im, 0) || ret.OverflowComplex(complex(re, im)) {
+			return Nil, fmt.Errorf("constant %v overflows <%v>", val, t)
+		}
+		ret.SetComplex(complex(re, im))
+		return ret, nil
+	case r.String:
+		if val.Kind() == constant.String {
+			ret.SetString(constant.StringVal(val))
+			return ret, nil
+		}
+	}
+	return Nil, fmt.Errorf("cannot convert untyped constant %v to <%v>", val, t)
+}
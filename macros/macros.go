@@ -0,0 +1,45 @@
+/*
+ * gomacro - A Go intepreter with Lisp-like macros
+ *
+ * Copyright (C) 2017 Massimiliano Ghilardi
+ *
+ *     This program is free software: you can redistribute it and/or modify
+ *     it under the terms of the GNU General Public License as published by
+ *     the Free Software Foundation, either version 3 of the License, or
+ *     (at your option) any later version.
+ *
+ *     This program is distributed in the hope that it will be useful,
+ *     but WITHOUT ANY WARRANTY; without even the implied warranty of
+ *     MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ *     GNU General Public License for more details.
+ *
+ *     You should have received a copy of the GNU General Public License
+ *     along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * macros.go
+ *
+ *  Created on: Apr 15, 2017
+ *      Author: Massimiliano Ghilardi
+ */
+
+// Package macros is the standard macro library of gomacro.
+// The macros are declared in macros_macros.gomacro and become available with
+//
+//	import "github.com/cosmos72/gomacro/macros"
+//
+// as macros.Assert, macros.Try, macros.Foreach, macros.DeferErr, macros.Memoize and macros.Timeit.
+// Their expansions are plain Go code that does not depend on this package:
+// when generating Go code with gomacro -w, import it inside an interpret_only block
+//
+//	~{ import "github.com/cosmos72/gomacro/macros" }
+//
+// so that the generated code does not import it
+package macros
+
+import _ "embed"
+
+// Source is the content of macros_macros.gomacro. The interpreter evaluates it
+// when importing this package, without looking for its source directory
+//
+//go:embed macros_macros.gomacro
+var Source string
@@ -53,5 +53,6 @@ func main() {
 	err := cmd.Main(args)
 	if err != nil {
 		fmt.Fprintln(cmd.Stderr, err)
+		os.Exit(1)
 	}
 }
@@ -89,6 +89,8 @@ func (p *parser) parseAny() ast.Node {
 		node = p.parseFile()
 	case token.IMPORT:
 		node = p.parseGenDecl(token.IMPORT, p.parseImportSpec)
+	case mt.INTERPRET_ONLY:
+		node = p.parseInterpretOnly()
 	case token.CONST, token.TYPE, token.VAR, token.FUNC, mt.MACRO:
 		// a "func" at top level can be either a function declaration: func foo(args) /*...*/
 		// or a method declaration: func (receiver) foo(args) /*...*/
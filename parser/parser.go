@@ -43,6 +43,7 @@ type parser struct {
 	quote           bool // patch: relax keywords syntax inside quotes
 	SpecialChar     rune // patch: prefix for quote operators ' ` , ,@
 	Fileset         *token.FileSet
+	Line, Column    int // patch: position in filename where src starts, if it is a fragment of a larger file
 
 	// Comments
 	comments    []*ast.CommentGroup
@@ -87,6 +88,9 @@ func (p *parser) Init(filename string, src []byte) {
 		p.Fileset = token.NewFileSet()
 	}
 	p.file = p.Fileset.AddFile(filename, -1, len(src))
+	if p.Line > 1 || p.Column > 1 {
+		p.file.AddLineColumnInfo(0, filename, p.Line, p.Column)
+	}
 	p.errors = nil
 
 	mode := p.Mode
@@ -203,6 +207,8 @@ func (p *parser) shortVarDecl(decl *ast.AssignStmt, list []ast.Expr) {
 					n++ // new declaration
 				}
 			}
+		} else if unary, ok := x.(*ast.UnaryExpr); ok && unary.Op == mt.UNQUOTE {
+			n++ // inside quasiquote, unquote computes the name of the new variable
 		} else {
 			p.errorExpected(x.Pos(), "identifier on left side of :=")
 		}
@@ -585,6 +591,10 @@ func (p *parser) parseIdent() *ast.Ident {
 	name := "_"
 	if p.tok == token.IDENT {
 		name = p.lit
+		if strings.ContainsRune(name, mt.GensymMark) {
+			// patch: identifiers created by Gensym() cannot appear in source code
+			p.error(pos, fmt.Sprintf("identifier %q contains the character %q reserved for Gensym()", name, mt.GensymMark))
+		}
 		p.next()
 	} else {
 		p.expect(token.IDENT) // use expect() error handling
@@ -1210,6 +1220,12 @@ func (p *parser) parseOperand(lhs bool) ast.Expr {
 	case mt.QUOTE, mt.QUASIQUOTE, mt.UNQUOTE, mt.UNQUOTE_SPLICE:
 		return p.parseQuote()
 
+	// patch: interpret_only { /*block*/ } inside functions
+	case mt.INTERPRET_ONLY:
+		pos := p.pos
+		p.next()
+		return p.parseInterpretOnlyBlock(pos)
+
 	// patch: accept block statements inside expressions. allows to nest macro calls,
 	// to write { if a { b } else { c } } inside an expression, and many other things
 	case token.LBRACE:
@@ -2396,6 +2412,11 @@ func (p *parser) parseTypeSpec(doc *ast.CommentGroup, _ token.Token, _ int) ast.
 	spec := &ast.TypeSpec{Doc: doc, Name: ident}
 	p.declare(spec, nil, p.topScope, ast.Typ, ident)
 
+	if p.tok == token.ASSIGN {
+		// type alias: type A = B
+		spec.Assign = p.pos
+		p.next()
+	}
 	spec.Type = p.parseType()
 	p.expectSemi() // call before accessing p.linecomment
 	spec.Comment = p.lineComment
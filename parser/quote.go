@@ -111,7 +111,7 @@ func MakeQuote(p_or_nil *parser, op token.Token, pos token.Pos, node ast.Node) (
 	case ast.Expr:
 		stmt = &ast.ExprStmt{X: node}
 	default:
-		msg := fmt.Sprintf("%v: expecting statement or expression, found %T %#v", op, node)
+		msg := fmt.Sprintf("%v: expecting statement or expression, found %T %#v", op, node, node)
 		if p_or_nil != nil {
 			p_or_nil.error(node.Pos(), msg)
 		} else {
@@ -173,3 +173,51 @@ func (p *parser) parseExprBlock() ast.Expr {
 	fun := &ast.FuncLit{Type: typ, Body: block}
 	return &ast.UnaryExpr{OpPos: pos, Op: mt.MACRO, X: fun}
 }
+
+// patch: interpret_only
+// parseInterpretOnly parses, at top level, either "interpret_only { /*declarations and statements*/ }"
+// or "interpret_only /*declaration or statement*/", and returns the unary expression
+// INTERPRET_ONLY func() { /*block*/ }
+func (p *parser) parseInterpretOnly() ast.Node {
+	if p.trace {
+		defer un(trace(p, "InterpretOnly"))
+	}
+	pos := p.pos
+	p.next()
+	if p.tok == token.LBRACE {
+		expr := p.parseInterpretOnlyBlock(pos)
+		p.expectSemiOrSpace()
+		return expr
+	}
+	expr, _ := MakeQuote(p, mt.INTERPRET_ONLY, pos, toStmt(p.parseAny()))
+	return expr
+}
+
+// parseInterpretOnlyBlock parses the block { /*declarations and statements*/ } after interpret_only.
+// Unlike normal blocks, it can contain imports, functions and macros declarations
+func (p *parser) parseInterpretOnlyBlock(pos token.Pos) *ast.UnaryExpr {
+	if p.trace {
+		defer un(trace(p, "InterpretOnlyBlock"))
+	}
+	lbrace := p.expect(token.LBRACE)
+	list := make([]ast.Stmt, 0)
+	for p.tok != token.RBRACE && p.tok != token.EOF {
+		list = append(list, toStmt(p.parseAny()))
+	}
+	rbrace := p.expect(token.RBRACE)
+	expr, _ := MakeQuote(p, mt.INTERPRET_ONLY, pos, &ast.BlockStmt{Lbrace: lbrace, List: list, Rbrace: rbrace})
+	return expr
+}
+
+// toStmt wraps a declaration or expression parsed by parseAny() into a statement
+func toStmt(node ast.Node) ast.Stmt {
+	switch node := node.(type) {
+	case ast.Stmt:
+		return node
+	case ast.Decl:
+		return &ast.DeclStmt{Decl: node}
+	case ast.Expr:
+		return &ast.ExprStmt{X: node}
+	}
+	return &ast.BadStmt{From: node.Pos(), To: node.End()}
+}
@@ -13,6 +13,7 @@ import (
 func init() {
 	imports.Packages["github.com/cosmos72/gomacro/token"] = imports.Package{
 		Binds: map[string]r.Value{
+			"GensymMark":     r.ValueOf(GensymMark),
 			"INTERPRET_ONLY": r.ValueOf(INTERPRET_ONLY),
 			"IsKeyword":      r.ValueOf(IsKeyword),
 			"IsLiteral":      r.ValueOf(IsLiteral),
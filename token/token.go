@@ -21,6 +21,11 @@ const (
 	UNQUOTE_SPLICE
 )
 
+// GensymMark is contained in the identifiers created by Gensym().
+// It is a Unicode letter, thus such identifiers are valid Go, for example when written by gomacro -w,
+// but the parser rejects it in source code: user code cannot write them
+const GensymMark = '\U00012035'
+
 var tokens map[base.Token]string
 
 var keywords map[string]base.Token